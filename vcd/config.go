@@ -262,6 +262,27 @@ func (cli *VCDClient) unLockParentEdgeGtw(d *schema.ResourceData) {
 	vcdMutexKV.kvUnlock(key)
 }
 
+// lockParentNsxtEdgeGtw locks using edge_gateway_id existing in resource parameters. NSX-T edge gateways can only be
+// referenced by ID therefore the key differs from lockParentEdgeGtw.
+// Parent means the resource belongs to the edge gateway being locked
+func (cli *VCDClient) lockParentNsxtEdgeGtw(d *schema.ResourceData) {
+	edgeGtwId := d.Get("edge_gateway_id").(string)
+	if edgeGtwId == "" {
+		panic("edge gateway ID not found")
+	}
+	key := fmt.Sprintf("org:%s|vdc:%s|edge:%s", cli.getOrgName(d), cli.getVdcName(d), edgeGtwId)
+	vcdMutexKV.kvLock(key)
+}
+
+func (cli *VCDClient) unLockParentNsxtEdgeGtw(d *schema.ResourceData) {
+	edgeGtwId := d.Get("edge_gateway_id").(string)
+	if edgeGtwId == "" {
+		panic("edge gateway ID not found")
+	}
+	key := fmt.Sprintf("org:%s|vdc:%s|edge:%s", cli.getOrgName(d), cli.getVdcName(d), edgeGtwId)
+	vcdMutexKV.kvUnlock(key)
+}
+
 func (cli *VCDClient) getOrgName(d *schema.ResourceData) string {
 	orgName := d.Get("org").(string)
 	if orgName == "" {
@@ -118,9 +118,21 @@ type TestConfig struct {
 		} `json:"peer"`
 	} `json:"networking"`
 	Nsxt struct {
-		Manager        string `json:"manager"`
-		Tier0router    string `json:"tier0router"`
-		Tier0routerVrf string `json:"tier0routervrf"`
+		Manager           string `json:"manager"`
+		Tier0router       string `json:"tier0router"`
+		Tier0routerVrf    string `json:"tier0routervrf"`
+		Vdc               string `json:"vdc"`
+		ExternalNetwork   string `json:"externalNetwork"`
+		EdgeGateway       string `json:"edgeGateway"`
+		NsxtImportSegment string `json:"nsxtImportSegment"`
+		EdgeCluster       string `json:"edgeCluster"`
+		TransportZone     string `json:"transportZone"`
+
+		NsxtAlbControllerUrl      string `json:"nsxtAlbControllerUrl"`
+		NsxtAlbControllerUser     string `json:"nsxtAlbControllerUser"`
+		NsxtAlbControllerPassword string `json:"nsxtAlbControllerPassword"`
+		NsxtAlbImportableCloud    string `json:"nsxtAlbImportableCloud"`
+		NsxtAlbServiceEngineGroup string `json:"nsxtAlbServiceEngineGroup"`
 	} `json:"nsxt"`
 	Logging struct {
 		Enabled         bool   `json:"enabled,omitempty"`
@@ -912,6 +924,50 @@ func importStateIdOrgVdcObject(vcd TestConfig, objectName string) resource.Impor
 	}
 }
 
+// Used by all entities that depend on Org + NSX-T VDC (such as NSX-T edge gateway, networks)
+func importStateIdOrgNsxtVdcObject(vcd TestConfig, objectName string) resource.ImportStateIdFunc {
+	return func(*terraform.State) (string, error) {
+		if testConfig.VCD.Org == "" || testConfig.Nsxt.Vdc == "" || objectName == "" {
+			return "", fmt.Errorf("missing information to generate import path")
+		}
+		return testConfig.VCD.Org +
+			ImportSeparator +
+			testConfig.Nsxt.Vdc +
+			ImportSeparator +
+			objectName, nil
+	}
+}
+
+// Used by all entities that depend on Org + NSX-T VDC + NSX-T edge gateway (such as NAT rules)
+func importStateIdNsxtEdgeGatewayObject(vcd TestConfig, edgeGatewayName, objectName string) resource.ImportStateIdFunc {
+	return func(*terraform.State) (string, error) {
+		if testConfig.VCD.Org == "" || testConfig.Nsxt.Vdc == "" || edgeGatewayName == "" || objectName == "" {
+			return "", fmt.Errorf("missing information to generate import path")
+		}
+		return testConfig.VCD.Org +
+			ImportSeparator +
+			testConfig.Nsxt.Vdc +
+			ImportSeparator +
+			edgeGatewayName +
+			ImportSeparator +
+			objectName, nil
+	}
+}
+
+// Used by NSX-V edge gateway entities that are imported by their ID, which is taken from resource in state (such as
+// DHCP static binding, certificate, SSL VPN-Plus objects)
+func importStateIdNsxvEdgeGatewayObject(vcd TestConfig, edgeGatewayName, resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return vcd.VCD.Org + ImportSeparator + vcd.VCD.Vdc + ImportSeparator + edgeGatewayName + ImportSeparator +
+			rs.Primary.ID, nil
+	}
+}
+
 // Used by all entities that depend on Org + Catalog (such as catalog item, media item)
 func importStateIdOrgCatalogObject(vcd TestConfig, objectName string) resource.ImportStateIdFunc {
 	return func(*terraform.State) (string, error) {
@@ -1135,3 +1191,41 @@ func skipNoNsxtConfiguration(t *testing.T) {
 		t.Skip(generalMessage + "No VRF NSX-T Tier-0 specified")
 	}
 }
+
+// skipNoNsxtVdcConfiguration allows to skip a test if pre-created NSX-T VDC or its external network is missing
+func skipNoNsxtVdcConfiguration(t *testing.T) {
+	skipNoNsxtConfiguration(t)
+	generalMessage := "Missing NSX-T config: "
+	if testConfig.Nsxt.Vdc == "" {
+		t.Skip(generalMessage + "No NSX-T VDC specified")
+	}
+	if testConfig.Nsxt.ExternalNetwork == "" {
+		t.Skip(generalMessage + "No NSX-T external network specified")
+	}
+}
+
+// skipNoNsxtEdgeGatewayConfiguration allows to skip a test if pre-created NSX-T edge gateway is missing
+func skipNoNsxtEdgeGatewayConfiguration(t *testing.T) {
+	skipNoNsxtVdcConfiguration(t)
+	if testConfig.Nsxt.EdgeGateway == "" {
+		t.Skip("Missing NSX-T config: No NSX-T edge gateway specified")
+	}
+}
+
+// skipNoNsxtAlbConfiguration allows to skip a test if NSX-T ALB (Avi) controller configuration is missing
+func skipNoNsxtAlbConfiguration(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+	generalMessage := "Missing NSX-T ALB config: "
+	if testConfig.Nsxt.NsxtAlbControllerUrl == "" {
+		t.Skip(generalMessage + "No NSX-T ALB controller URL specified")
+	}
+	if testConfig.Nsxt.NsxtAlbControllerUser == "" || testConfig.Nsxt.NsxtAlbControllerPassword == "" {
+		t.Skip(generalMessage + "No NSX-T ALB controller credentials specified")
+	}
+	if testConfig.Nsxt.NsxtAlbImportableCloud == "" {
+		t.Skip(generalMessage + "No NSX-T ALB importable cloud specified")
+	}
+	if testConfig.Nsxt.NsxtAlbServiceEngineGroup == "" {
+		t.Skip(generalMessage + "No NSX-T ALB service engine group specified")
+	}
+}
@@ -0,0 +1,64 @@
+// +build ALL nsxt functional
+
+package vcd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVcdDatasourceNsxtEdgeCluster(t *testing.T) {
+
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtVdcConfiguration(t)
+	if testConfig.Nsxt.EdgeCluster == "" {
+		t.Skip(t.Name() + " requires NSX-T edge cluster name in configuration")
+	}
+
+	var params = StringMap{
+		"FuncName":        t.Name(),
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeCluster": testConfig.Nsxt.EdgeCluster,
+		"Tags":            "nsxt",
+	}
+
+	configText := templateFill(testAccCheckVcdNsxtEdgeCluster, params)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vcd_nsxt_edge_cluster.ec", "id", regexp.MustCompile(`^\S+$`)),
+					resource.TestCheckResourceAttr("data.vcd_nsxt_edge_cluster.ec", "name", params["NsxtEdgeCluster"].(string)),
+					resource.TestMatchResourceAttr("data.vcd_nsxt_edge_cluster.ec", "node_count", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.vcd_nsxt_edge_cluster.ec", "node_type", regexp.MustCompile(`^\S+$`)),
+					resource.TestMatchResourceAttr("data.vcd_nsxt_edge_cluster.ec", "deployment_type", regexp.MustCompile(`^\S+$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckVcdNsxtEdgeCluster = `
+data "vcd_nsxt_edge_cluster" "ec" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeCluster}}"
+}
+`
@@ -0,0 +1,64 @@
+// +build ALL nsxt functional
+
+package vcd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVcdDatasourceNsxtTransportZone(t *testing.T) {
+
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtConfiguration(t)
+	if testConfig.Nsxt.TransportZone == "" {
+		t.Skip(t.Name() + " requires NSX-T transport zone name in configuration")
+	}
+
+	var params = StringMap{
+		"FuncName":          t.Name(),
+		"NsxtManager":       testConfig.Nsxt.Manager,
+		"NsxtTransportZone": testConfig.Nsxt.TransportZone,
+		"Tags":              "nsxt",
+	}
+
+	configText := templateFill(testAccCheckVcdNsxtTransportZone, params)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vcd_nsxt_transport_zone.tz", "id", regexp.MustCompile(`^\S+$`)),
+					resource.TestCheckResourceAttr("data.vcd_nsxt_transport_zone.tz", "name", params["NsxtTransportZone"].(string)),
+					resource.TestMatchResourceAttr("data.vcd_nsxt_transport_zone.tz", "type", regexp.MustCompile(`^(OVERLAY|VLAN)_BACKED$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckVcdNsxtTransportZone = `
+data "vcd_nsxt_manager" "nsxt" {
+  name = "{{.NsxtManager}}"
+}
+
+data "vcd_nsxt_transport_zone" "tz" {
+  name            = "{{.NsxtTransportZone}}"
+  nsxt_manager_id = data.vcd_nsxt_manager.nsxt.id
+}
+`
@@ -40,7 +40,8 @@ func testSpecificDataSourceNotFound(t *testing.T, dataSourceName string, vcdClie
 		case dataSourceName == "vcd_external_network_v2" && vcdClient.Client.APIVCDMaxVersionIs("< 33") &&
 			!usingSysAdmin():
 			t.Skip("External network V2 requires at least API version 33 (VCD 10.0+)")
-		case (dataSourceName == "vcd_nsxt_tier0_router" || dataSourceName == "vcd_external_network_v2" || dataSourceName == "vcd_nsxt_manager") &&
+		case (dataSourceName == "vcd_nsxt_tier0_router" || dataSourceName == "vcd_external_network_v2" || dataSourceName == "vcd_nsxt_manager" ||
+			dataSourceName == "vcd_nsxt_edge_cluster" || dataSourceName == "vcd_nsxt_transport_zone") &&
 			(testConfig.Nsxt.Manager == "" || testConfig.Nsxt.Tier0router == "") || !usingSysAdmin():
 			t.Skip(`No NSX-T configuration detected`)
 		}
@@ -100,6 +101,10 @@ func getMandatoryDataSourceRuntimeFields(dataSourceName string) []string {
 	if dataSourceName == "vcd_independent_disk" {
 		return []string{"name"}
 	}
+	// vcd_nsxt_edge_cluster only works in NSX-T VDC which is not the default one
+	if dataSourceName == "vcd_nsxt_edge_cluster" {
+		return []string{"vdc"}
+	}
 	return []string{}
 }
 
@@ -107,8 +112,10 @@ func addMandatoryParams(dataSourceName string, mandatoryFields []string, t *test
 	var templateFields string
 	for fieldIndex := range mandatoryFields {
 
-		// A special case for DHCP relay where only invalid edge_gateway makes sense
-		if dataSourceName == "vcd_nsxv_dhcp_relay" && mandatoryFields[fieldIndex] == "edge_gateway" {
+		// A special case for DHCP relay, DHCP leases and LB statistics where only invalid edge_gateway makes sense
+		if (dataSourceName == "vcd_nsxv_dhcp_relay" || dataSourceName == "vcd_nsxv_dhcp_leases" ||
+			dataSourceName == "vcd_lb_statistics") &&
+			mandatoryFields[fieldIndex] == "edge_gateway" {
 			templateFields = templateFields + `edge_gateway = "non-existing"` + "\n"
 			return templateFields
 		}
@@ -119,8 +126,16 @@ func addMandatoryParams(dataSourceName string, mandatoryFields []string, t *test
 			return templateFields
 		}
 
+		// vcd_nsxt_app_port_profile requires scope. 'TENANT' scope searches for profile in default VDC
+		if dataSourceName == "vcd_nsxt_app_port_profile" && mandatoryFields[fieldIndex] == "scope" {
+			templateFields = templateFields + `scope = "TENANT"` + "\n"
+			continue
+		}
+
 		switch mandatoryFields[fieldIndex] {
 		// Fields, which must be valid to satisfy a data source
+		case "vdc": // Only NSX-T data sources have 'vdc' as mandatory runtime field
+			templateFields = templateFields + `vdc = "` + testConfig.Nsxt.Vdc + `"` + "\n"
 		case "org": // Some data sources require org - fill it from testConfig
 			templateFields = templateFields + `org = "` + testConfig.VCD.Org + `"` + "\n"
 		case "edge_gateway":
@@ -149,6 +164,10 @@ func addMandatoryParams(dataSourceName string, mandatoryFields []string, t *test
 			// Invalid fields which are required for some resources for search (usually they are used instead of `name`)
 		case "rule_id":
 			templateFields = templateFields + `rule_id = "347928347234"` + "\n"
+		case "edge_gateway_id":
+			templateFields = templateFields + `edge_gateway_id = "urn:vcloud:gateway:00000000-0000-0000-0000-000000000000"` + "\n"
+		case "vdc_group_id":
+			templateFields = templateFields + `vdc_group_id = "urn:vcloud:vdcGroup:00000000-0000-0000-0000-000000000000"` + "\n"
 		case "name":
 			templateFields = templateFields + `name = "does-not-exist"` + "\n"
 		case "org_network_name":
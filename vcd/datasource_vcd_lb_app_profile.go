@@ -92,6 +92,45 @@ func datasourceVcdLBAppProfile() *schema.Resource {
 				Description: "Enable to define the certificate, CAs, or CRLs used to authenticate" +
 					" the load balancer from the server side",
 			},
+			"service_certificate_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of service certificate which is presented to clients for SSL offload",
+			},
+			"service_ca_certificate_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CA certificates used to verify client certificates",
+			},
+			"service_crl_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CRLs used to verify client certificates",
+			},
+			"client_auth": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Client authentication mode. One of 'ignore' or 'required'",
+			},
+			"pool_certificate_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of service certificate which the load balancer presents to pool members",
+			},
+			"pool_ca_certificate_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CA certificates used to verify pool member certificates",
+			},
+			"pool_crl_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CRLs used to verify pool member certificates",
+			},
 		},
 	}
 }
@@ -103,7 +142,7 @@ func datasourceVcdLBAppProfileRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
 	}
 
-	readLBAppProfile, err := edgeGateway.GetLbAppProfileByName(d.Get("name").(string))
+	readLBAppProfile, err := getNsxvLbAppProfileByName(vcdClient, edgeGateway, d.Get("name").(string))
 	if err != nil {
 		return fmt.Errorf("unable to find load balancer application profile with Name %s: %s",
 			d.Get("name").(string), err)
@@ -0,0 +1,234 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lbCountersSchema returns traffic counters which are reported for virtual servers, pools and pool members
+func lbCountersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bytes_in": {
+			Computed:    true,
+			Type:        schema.TypeInt,
+			Description: "Number of bytes received",
+		},
+		"bytes_out": {
+			Computed:    true,
+			Type:        schema.TypeInt,
+			Description: "Number of bytes sent",
+		},
+		"current_sessions": {
+			Computed:    true,
+			Type:        schema.TypeInt,
+			Description: "Number of current sessions",
+		},
+		"total_sessions": {
+			Computed:    true,
+			Type:        schema.TypeInt,
+			Description: "Total number of sessions",
+		},
+	}
+}
+
+// lbStatisticsElem builds element schema from 'fields' and traffic counters
+func lbStatisticsElem(fields map[string]*schema.Schema) *schema.Resource {
+	for name, counterSchema := range lbCountersSchema() {
+		fields[name] = counterSchema
+	}
+	return &schema.Resource{Schema: fields}
+}
+
+func datasourceVcdLbStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdLbStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for load balancer statistics",
+			},
+			"timestamp": {
+				Computed:    true,
+				Type:        schema.TypeInt,
+				Description: "Time when statistics were collected (Unix time)",
+			},
+			"virtual_server": {
+				Computed:    true,
+				Type:        schema.TypeList,
+				Description: "Runtime status of load balancer virtual servers",
+				Elem: lbStatisticsElem(map[string]*schema.Schema{
+					"id": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Virtual server ID",
+					},
+					"name": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Virtual server name",
+					},
+					"ip_address": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "IP address on which virtual server listens",
+					},
+					"status": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Virtual server status (e.g. 'OPEN', 'CLOSED')",
+					},
+				}),
+			},
+			"pool": {
+				Computed:    true,
+				Type:        schema.TypeList,
+				Description: "Runtime status of load balancer server pools and their members",
+				Elem: lbStatisticsElem(map[string]*schema.Schema{
+					"id": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Server pool ID",
+					},
+					"name": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Server pool name",
+					},
+					"status": {
+						Computed:    true,
+						Type:        schema.TypeString,
+						Description: "Server pool status (e.g. 'UP', 'DOWN')",
+					},
+					"member": {
+						Computed:    true,
+						Type:        schema.TypeList,
+						Description: "Runtime status of pool members",
+						Elem: lbStatisticsElem(map[string]*schema.Schema{
+							"id": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Pool member ID",
+							},
+							"name": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Pool member name",
+							},
+							"ip_address": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Pool member IP address",
+							},
+							"status": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Pool member status as reported by health checks (e.g. 'UP', 'DOWN')",
+							},
+							"failure_cause": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Message of the last failed health check",
+							},
+							"last_state_change_time": {
+								Computed:    true,
+								Type:        schema.TypeString,
+								Description: "Time when pool member status last changed",
+							},
+						}),
+					},
+				}),
+			},
+		},
+	}
+}
+
+func datasourceVcdLbStatisticsRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V load balancer statistics read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	statistics, err := getNsxvLbStatistics(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[lb statistics read] unable to read load balancer statistics: %s", err)
+	}
+
+	virtualServers := make([]interface{}, len(statistics.VirtualServers))
+	for index, virtualServer := range statistics.VirtualServers {
+		virtualServerMap := getLbCountersData(virtualServer.nsxvLbCounters)
+		virtualServerMap["id"] = virtualServer.VirtualServerId
+		virtualServerMap["name"] = virtualServer.Name
+		virtualServerMap["ip_address"] = virtualServer.IpAddress
+		virtualServerMap["status"] = virtualServer.Status
+		virtualServers[index] = virtualServerMap
+	}
+
+	pools := make([]interface{}, len(statistics.Pools))
+	for index, pool := range statistics.Pools {
+		members := make([]interface{}, len(pool.Members))
+		for memberIndex, member := range pool.Members {
+			memberMap := getLbCountersData(member.nsxvLbCounters)
+			memberMap["id"] = member.MemberId
+			memberMap["name"] = member.Name
+			memberMap["ip_address"] = member.IpAddress
+			memberMap["status"] = member.Status
+			memberMap["failure_cause"] = member.FailureCause
+			memberMap["last_state_change_time"] = member.LastStateChangeTime
+			members[memberIndex] = memberMap
+		}
+
+		poolMap := getLbCountersData(pool.nsxvLbCounters)
+		poolMap["id"] = pool.PoolId
+		poolMap["name"] = pool.Name
+		poolMap["status"] = pool.Status
+		poolMap["member"] = members
+		pools[index] = poolMap
+	}
+
+	_ = d.Set("timestamp", int(statistics.TimeStamp))
+
+	err = d.Set("virtual_server", virtualServers)
+	if err != nil {
+		return fmt.Errorf("[lb statistics read] error setting 'virtual_server': %s", err)
+	}
+
+	err = d.Set("pool", pools)
+	if err != nil {
+		return fmt.Errorf("[lb statistics read] error setting 'pool': %s", err)
+	}
+
+	d.SetId(edgeGateway.EdgeGateway.ID + ":lbStatistics")
+
+	return nil
+}
+
+// getLbCountersData converts traffic counters to a map which is extended with other fields of virtual server, pool
+// or pool member
+func getLbCountersData(counters nsxvLbCounters) map[string]interface{} {
+	return map[string]interface{}{
+		"bytes_in":         int(counters.BytesIn),
+		"bytes_out":        int(counters.BytesOut),
+		"current_sessions": int(counters.CurrentSessions),
+		"total_sessions":   int(counters.TotalSessions),
+	}
+}
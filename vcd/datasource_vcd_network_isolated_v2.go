@@ -0,0 +1,122 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNetworkIsolatedV2() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNetworkIsolatedV2Read,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"name", "filter"},
+				Description:  "A unique name for this network (optional when `filter` is used)",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network description",
+			},
+			"gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway IP address",
+			},
+			"prefix_length": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Network prefix length",
+			},
+			"dns1": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS server 1",
+			},
+			"dns2": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS server 2",
+			},
+			"dns_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS suffix",
+			},
+			"static_ip_pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IP ranges used for static pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+			"filter": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Optional:    true,
+				Description: "Criteria for retrieving a network by various attributes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_regex": elementNameRegex,
+						"ip":         elementIp,
+						"metadata":   elementMetadata,
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceVcdNetworkIsolatedV2Read(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] isolated network V2 data source read initiated")
+
+	if !nameOrFilterIsSet(d) {
+		return fmt.Errorf(noNameOrFilterError, "vcd_network_isolated_v2")
+	}
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	var network *openApiOrgVdcNetwork
+	filter, hasFilter := d.GetOk("filter")
+	if hasFilter {
+		network, err = getOpenApiOrgVdcNetworkByFilter(vcdClient, vdc, filter, "isolated")
+	} else {
+		network, err = getOpenApiOrgVdcNetworkByName(vcdClient, vdc, d.Get("name").(string))
+	}
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 read] error getting Org VDC network: %s", err)
+	}
+
+	if network.NetworkType != openApiOrgVdcNetworkTypeIsolated {
+		return fmt.Errorf("[isolated network v2 read] Org VDC network with name '%s' found, but is not of type Isolated (type is '%s')",
+			network.Name, network.NetworkType)
+	}
+
+	err = setOpenApiOrgVdcIsolatedNetworkData(d, network)
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 read] error setting Org VDC network data: %s", err)
+	}
+
+	d.SetId(network.ID)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceVcdNsxtAppPortProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxtAppPortProfileRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level. Only used for 'TENANT' scope",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Application Port Profile name",
+			},
+			"scope": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Scope - 'SYSTEM' (built-in profiles), 'PROVIDER' or 'TENANT'",
+				ValidateFunc: validation.StringInSlice([]string{nsxtAppPortProfileScopeSystem,
+					nsxtAppPortProfileScopeProvider, nsxtAppPortProfileScopeTenant}, false),
+			},
+			"nsxt_manager_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of NSX-T manager. Optionally narrows down the search in 'PROVIDER' scope",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application Port Profile description",
+			},
+			"app_port": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Set of protocol and port definitions",
+				Elem:        nsxtAppPortProfilePortSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxtAppPortProfileRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T application port profile data source read initiated")
+
+	scope := d.Get("scope").(string)
+
+	var contextId string
+	switch scope {
+	case nsxtAppPortProfileScopeTenant:
+		_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+		contextId = vdc.Vdc.ID
+	case nsxtAppPortProfileScopeProvider:
+		contextId = d.Get("nsxt_manager_id").(string)
+	}
+
+	appPortProfile, err := getNsxtAppPortProfileByName(vcdClient, d.Get("name").(string), scope, contextId)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile read] error retrieving application port profile: %s", err)
+	}
+
+	err = setNsxtAppPortProfileData(d, appPortProfile)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile read] error storing application port profile data: %s", err)
+	}
+
+	d.SetId(appPortProfile.ID)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtDistributedFirewall() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxtDistributedFirewallRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of VDC group with enabled distributed firewall",
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Ordered list of distributed firewall rules",
+				Elem:        nsxtFirewallRuleSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxtDistributedFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T distributed firewall data source read initiated")
+
+	vdcGroupId := d.Get("vdc_group_id").(string)
+	rules, err := getNsxtDistributedFirewall(vcdClient, vdcGroupId)
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall read] error retrieving distributed firewall rules: %s", err)
+	}
+
+	err = setNsxtFirewallData(rules.Values, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall read] error storing distributed firewall rules: %s", err)
+	}
+
+	d.SetId(vdcGroupId)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtEdgeCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceNsxtEdgeClusterRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of NSX-T edge cluster.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of NSX-T edge cluster.",
+			},
+			"node_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of transport nodes in NSX-T edge cluster.",
+			},
+			"node_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of transport nodes in NSX-T edge cluster.",
+			},
+			"deployment_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deployment type of transport nodes in NSX-T edge cluster.",
+			},
+		},
+	}
+}
+
+func datasourceNsxtEdgeClusterRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	edgeClusterName := d.Get("name").(string)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	edgeCluster, err := getNsxtEdgeClusterByName(vcdClient, vdc.Vdc.ID, edgeClusterName)
+	if err != nil {
+		return fmt.Errorf("could not find NSX-T edge cluster by name '%s': %s", edgeClusterName, err)
+	}
+
+	_ = d.Set("description", edgeCluster.Description)
+	_ = d.Set("node_count", edgeCluster.NodeCount)
+	_ = d.Set("node_type", edgeCluster.NodeType)
+	_ = d.Set("deployment_type", edgeCluster.DeploymentType)
+	d.SetId(edgeCluster.ID)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtEdgeGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxtEdgeGatewayRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Edge Gateway name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edge Gateway description",
+			},
+			"dedicate_external_network": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Dedicating the External Network will enable Route Advertisement for this Edge Gateway.",
+			},
+			"external_network_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "External network ID",
+			},
+			"subnet": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "External network subnets attached to this gateway's interface",
+				Elem:        nsxtEdgeSubnet,
+			},
+			"primary_ip": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary IP address of edge gateway",
+			},
+			"edge_cluster_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T Edge Cluster ID",
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxtEdgeGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T edge gateway data source read initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	name := d.Get("name").(string)
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, name)
+	if err != nil {
+		return fmt.Errorf("could not retrieve NSX-T edge gateway '%s': %s", name, err)
+	}
+
+	err = setNsxtEdgeGatewayData(edgeGateway, d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(edgeGateway.ID)
+
+	return nil
+}
@@ -24,27 +24,37 @@ func datasourceNsxtManagerRead(d *schema.ResourceData, meta interface{}) error {
 	vcdClient := meta.(*VCDClient)
 	nsxtManagerName := d.Get("name").(string)
 
+	urn, err := getNsxtManagerIdByName(vcdClient, nsxtManagerName)
+	if err != nil {
+		return err
+	}
+	d.SetId(urn)
+
+	return nil
+}
+
+// getNsxtManagerIdByName looks up NSX-T manager by name and returns its URN
+func getNsxtManagerIdByName(vcdClient *VCDClient, nsxtManagerName string) (string, error) {
 	nsxtManagers, err := vcdClient.QueryNsxtManagerByName(nsxtManagerName)
 	if err != nil {
-		return fmt.Errorf("could not find NSX-T manager by name '%s': %s", nsxtManagerName, err)
+		return "", fmt.Errorf("could not find NSX-T manager by name '%s': %s", nsxtManagerName, err)
 	}
 
 	if len(nsxtManagers) == 0 {
-		return fmt.Errorf("%s found %d NSX-T managers with name '%s'",
+		return "", fmt.Errorf("%s found %d NSX-T managers with name '%s'",
 			govcd.ErrorEntityNotFound, len(nsxtManagers), nsxtManagerName)
 	}
 
 	if len(nsxtManagers) > 1 {
-		return fmt.Errorf("found %d NSX-T managers with name '%s'", len(nsxtManagers), nsxtManagerName)
+		return "", fmt.Errorf("found %d NSX-T managers with name '%s'", len(nsxtManagers), nsxtManagerName)
 	}
 
 	// We try to keep IDs clean
 	id := extractUuid(nsxtManagers[0].HREF)
 	urn, err := govcd.BuildUrnWithUuid("urn:vcloud:nsxtmanager:", id)
 	if err != nil {
-		return fmt.Errorf("could not construct URN from id '%s': %s", id, err)
+		return "", fmt.Errorf("could not construct URN from id '%s': %s", id, err)
 	}
-	d.SetId(urn)
 
-	return nil
+	return urn, nil
 }
@@ -0,0 +1,125 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtNetworkImported() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxtNetworkImportedRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "nsxt_logical_switch_name"},
+				Description:  "A unique name for this network",
+			},
+			"nsxt_logical_switch_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "nsxt_logical_switch_name"},
+				Description:  "Name of NSX-T logical switch (segment) backing this network",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network description",
+			},
+			"nsxt_logical_switch_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of used NSX-T logical switch (segment)",
+			},
+			"gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Gateway IP address",
+			},
+			"prefix_length": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Network prefix length",
+			},
+			"dns1": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS server 1",
+			},
+			"dns2": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS server 2",
+			},
+			"dns_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DNS suffix",
+			},
+			"static_ip_pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "IP ranges used for static pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxtNetworkImportedRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] imported network data source read initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	var network *openApiOrgVdcNetwork
+	switchName, lookupBySwitchName := d.GetOk("nsxt_logical_switch_name")
+	if lookupBySwitchName {
+		network, err = getOpenApiOrgVdcImportedNetworkBySwitchName(vcdClient, vdc, switchName.(string))
+	} else {
+		network, err = getOpenApiOrgVdcNetworkByName(vcdClient, vdc, d.Get("name").(string))
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network read] error getting Org VDC network: %s", err)
+	}
+
+	if network.NetworkType != openApiOrgVdcNetworkTypeImported {
+		return fmt.Errorf("[nsxt imported network read] Org VDC network with name '%s' found, but is not of type Imported (OPAQUE) (type is '%s')",
+			network.Name, network.NetworkType)
+	}
+
+	err = setOpenApiOrgVdcImportedNetworkData(d, network)
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network read] error setting Org VDC network data: %s", err)
+	}
+
+	if !lookupBySwitchName {
+		logicalSwitchName, err := getNsxtLogicalSwitchNameById(vcdClient, vdc, network.BackingNetworkId)
+		if err != nil {
+			return fmt.Errorf("[nsxt imported network read] error resolving NSX-T logical switch name: %s", err)
+		}
+		_ = d.Set("nsxt_logical_switch_name", logicalSwitchName)
+	}
+
+	d.SetId(network.ID)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxtSecurityGroupRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Edge gateway ID in which security group is located",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Security group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Security group description",
+			},
+			"member_org_network_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Set of Org VDC network IDs attached to this security group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"member_vms": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Set of VM references which are members of the security group through attached Org VDC networks",
+				Elem:        nsxtSecurityGroupMemberVmSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxtSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T security group data source read initiated")
+
+	securityGroup, err := getNsxtFirewallGroupByName(vcdClient, d.Get("edge_gateway_id").(string),
+		d.Get("name").(string), nsxtFirewallGroupTypeSecurityGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt security group read] error retrieving security group: %s", err)
+	}
+
+	err = setNsxtSecurityGroupData(vcdClient, d, securityGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt security group read] error storing security group data: %s", err)
+	}
+
+	d.SetId(securityGroup.ID)
+
+	return nil
+}
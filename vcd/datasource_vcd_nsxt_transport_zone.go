@@ -0,0 +1,53 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxtTransportZone() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceNsxtTransportZoneRead,
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of NSX-T transport zone.",
+			},
+			"nsxt_manager_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of NSX-T manager.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of NSX-T transport zone (OVERLAY_BACKED or VLAN_BACKED).",
+			},
+			"already_imported": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Defines if transport zone is already used by a network pool.",
+			},
+		},
+	}
+}
+
+func datasourceNsxtTransportZoneRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	nsxtManagerId := d.Get("nsxt_manager_id").(string)
+	transportZoneName := d.Get("name").(string)
+
+	transportZone, err := getNsxtTransportZoneByName(vcdClient, nsxtManagerId, transportZoneName)
+	if err != nil {
+		return fmt.Errorf("could not find NSX-T transport zone by name '%s' in NSX-T manager %s: %s",
+			transportZoneName, nsxtManagerId, err)
+	}
+
+	_ = d.Set("type", transportZone.Type)
+	_ = d.Set("already_imported", transportZone.AlreadyImported)
+	d.SetId(transportZone.ID)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func datasourceVcdNsxvDhcpLeases() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxvDhcpLeasesRead,
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for DHCP leases",
+			},
+			"lease": {
+				Computed:    true,
+				Type:        schema.TypeList,
+				Description: "DHCP leases provided by edge gateway",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "MAC address of the client",
+						},
+						"ip_address": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "IP address leased to the client",
+						},
+						"hostname": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Hostname sent by the client",
+						},
+						"uid": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Client identifier sent by the client",
+						},
+						"binding_state": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Binding state of the lease (e.g. 'active', 'free')",
+						},
+						"next_binding_state": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Binding state of the lease after it expires",
+						},
+						"starts": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Start time of the lease",
+						},
+						"ends": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "End time of the lease",
+						},
+						"last_transaction_time": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Time of the last transaction with the client",
+						},
+						"hardware_type": {
+							Computed:    true,
+							Type:        schema.TypeString,
+							Description: "Hardware type of the client, usually 'ethernet'",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceVcdNsxvDhcpLeasesRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V DHCP leases read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	// GetAllNsxvDhcpLeases returns govcd.ErrorEntityNotFound when there are no leases, which is a valid state here
+	dhcpLeases, err := edgeGateway.GetAllNsxvDhcpLeases()
+	if err != nil && !govcd.ContainsNotFound(err) {
+		return fmt.Errorf("[nsxv dhcp leases read] unable to read DHCP leases: %s", err)
+	}
+
+	leases := make([]interface{}, len(dhcpLeases))
+	for index, lease := range dhcpLeases {
+		leases[index] = map[string]interface{}{
+			"mac_address":           lease.MacAddress,
+			"ip_address":            lease.IpAddress,
+			"hostname":              lease.ClientHostname,
+			"uid":                   lease.Uid,
+			"binding_state":         lease.BindingState,
+			"next_binding_state":    lease.NextBindingState,
+			"starts":                lease.Starts,
+			"ends":                  lease.Ends,
+			"last_transaction_time": lease.Cltt,
+			"hardware_type":         lease.HardwareType,
+		}
+	}
+
+	err = d.Set("lease", leases)
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp leases read] error setting 'lease': %s", err)
+	}
+
+	d.SetId(edgeGateway.EdgeGateway.ID + ":dhcpLeases")
+
+	return nil
+}
@@ -110,6 +110,14 @@ func datasourceVcdNsxvFirewallRule() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"mac_set_ids": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Set of MAC set IDs",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						// TODO - uncomment once security groups are supported
 						// "security_groups": {
 						// 	Type:        schema.TypeSet,
@@ -173,6 +181,14 @@ func datasourceVcdNsxvFirewallRule() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"mac_set_ids": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Set of MAC set IDs",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						// TODO - uncomment once security groups are supported
 						// "security_groups": {
 						// 	Optional:    true,
@@ -203,6 +219,10 @@ func datasourceVcdNsxvFirewallRule() *schema.Resource {
 							Computed: true,
 							Type:     schema.TypeString,
 						},
+						"service_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
 					},
 				},
 			},
@@ -0,0 +1,48 @@
+package vcd
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxvMacSet() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxvMacSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "MAC set name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "MAC set description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Allows visibility in underlying scopes",
+			},
+			"mac_addresses": {
+				Computed:    true,
+				Type:        schema.TypeSet,
+				Description: "A set of MAC addresses",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
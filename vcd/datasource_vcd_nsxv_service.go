@@ -0,0 +1,55 @@
+package vcd
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxvService() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxvServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Allows visibility in underlying scopes",
+			},
+			"protocol": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Protocol - one of 'tcp', 'udp', 'icmp'",
+			},
+			"port": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Destination ports for 'tcp' and 'udp' or ICMP type for 'icmp'",
+			},
+			"source_port": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Source ports for 'tcp' and 'udp'",
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package vcd
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdNsxvServiceGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdNsxvServiceGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service group description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Allows visibility in underlying scopes",
+			},
+			"member_ids": {
+				Computed:    true,
+				Type:        schema.TypeSet,
+				Description: "A set of service and service group IDs which are members of this group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcdVdcGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: datasourceVcdVdcGroupRead,
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "VDC group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "VDC group description",
+			},
+			"participating_vdc_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "A set of participating VDC IDs",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dfw_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Defines if distributed firewall is enabled for the VDC group",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the VDC group",
+			},
+			"network_provider_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network provider type of the VDC group",
+			},
+			"network_pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network pool ID used by the VDC group",
+			},
+		},
+	}
+}
+
+func datasourceVcdVdcGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] VDC group data source read initiated")
+
+	adminOrg, err := vcdClient.GetAdminOrgFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrg, err)
+	}
+
+	vdcGroup, err := getVdcGroupByName(vcdClient, adminOrg.AdminOrg.ID, d.Get("name").(string))
+	if err != nil {
+		return fmt.Errorf("[vdc group read] error retrieving VDC group: %s", err)
+	}
+
+	err = setVdcGroupData(d, vdcGroup)
+	if err != nil {
+		return fmt.Errorf("[vdc group read] error storing VDC group data: %s", err)
+	}
+
+	d.SetId(vdcGroup.ID)
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// Org VDC network types as used in OpenAPI
+const (
+	openApiOrgVdcNetworkTypeRouted   = "NAT_ROUTED"
+	openApiOrgVdcNetworkTypeIsolated = "ISOLATED"
+	openApiOrgVdcNetworkTypeImported = "OPAQUE"
+)
+
+// getOpenApiOrgVdcNetworkById retrieves Org VDC network by its URN ID using OpenAPI
+func getOpenApiOrgVdcNetworkById(vcdClient *VCDClient, id string) (*openApiOrgVdcNetwork, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty Org VDC network ID")
+	}
+
+	orgVdcNetwork := &openApiOrgVdcNetwork{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworks, id, orgVdcNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return orgVdcNetwork, nil
+}
+
+// getOpenApiOrgVdcNetworkByName retrieves Org VDC network by name in a given VDC using OpenAPI. Returns an error if
+// not exactly one network is found.
+func getOpenApiOrgVdcNetworkByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*openApiOrgVdcNetwork, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty Org VDC network name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name+";orgVdc.id=="+vdc.Vdc.ID)
+
+	networks, err := getAllOpenApiOrgVdcNetworks(vcdClient, queryParameters)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve Org VDC network by name '%s': %s", name, err)
+	}
+
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("%s: could not find Org VDC network by name '%s' in VDC '%s'",
+			govcd.ErrorEntityNotFound, name, vdc.Vdc.Name)
+	}
+
+	if len(networks) > 1 {
+		return nil, fmt.Errorf("expected exactly one Org VDC network with name '%s' in VDC '%s'. Got %d",
+			name, vdc.Vdc.Name, len(networks))
+	}
+
+	return networks[0], nil
+}
+
+// getAllOpenApiOrgVdcNetworks retrieves all Org VDC networks visible to the user. Query parameters can be supplied
+// to perform additional filtering.
+func getAllOpenApiOrgVdcNetworks(vcdClient *VCDClient, queryParameters url.Values) ([]*openApiOrgVdcNetwork, error) {
+	networks := []*openApiOrgVdcNetwork{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworks, queryParameters, &networks)
+	if err != nil {
+		return nil, err
+	}
+
+	return networks, nil
+}
+
+// createOpenApiOrgVdcNetwork creates Org VDC network and returns the created structure
+func createOpenApiOrgVdcNetwork(vcdClient *VCDClient, networkConfig *openApiOrgVdcNetwork) (*openApiOrgVdcNetwork, error) {
+	createdNetwork := &openApiOrgVdcNetwork{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworks, networkConfig, createdNetwork)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Org VDC network: %s", err)
+	}
+
+	return createdNetwork, nil
+}
+
+// updateOpenApiOrgVdcNetwork updates Org VDC network and returns the updated structure
+func updateOpenApiOrgVdcNetwork(vcdClient *VCDClient, networkConfig *openApiOrgVdcNetwork) (*openApiOrgVdcNetwork, error) {
+	if networkConfig.ID == "" {
+		return nil, fmt.Errorf("cannot update Org VDC network without ID")
+	}
+
+	updatedNetwork := &openApiOrgVdcNetwork{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworks, networkConfig.ID,
+		networkConfig, updatedNetwork)
+	if err != nil {
+		return nil, fmt.Errorf("error updating Org VDC network: %s", err)
+	}
+
+	return updatedNetwork, nil
+}
+
+// deleteOpenApiOrgVdcNetwork deletes Org VDC network by ID
+func deleteOpenApiOrgVdcNetwork(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete Org VDC network without ID")
+	}
+
+	err := vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworks, id)
+	if err != nil {
+		return fmt.Errorf("error deleting Org VDC network: %s", err)
+	}
+
+	return nil
+}
+
+// getOpenApiOrgVdcNetworkByFilter finds an Org VDC network of given type using a data source 'filter' block. Search
+// itself is performed with the query API (see getNetworkByFilter) and the found network is then retrieved by ID
+// using OpenAPI.
+func getOpenApiOrgVdcNetworkByFilter(vcdClient *VCDClient, vdc *govcd.Vdc, filter interface{}, wanted string) (*openApiOrgVdcNetwork, error) {
+	network, err := getNetworkByFilter(vdc, filter, wanted)
+	if err != nil {
+		return nil, err
+	}
+
+	return getOpenApiOrgVdcNetworkById(vcdClient, network.OrgVDCNetwork.ID)
+}
+
+// getAllNsxtImportableSwitches retrieves all NSX-T logical switches (segments) which VCD lists for a given VDC. The
+// list is scoped to the NSX-T manager which backs the VDC network pool.
+func getAllNsxtImportableSwitches(vcdClient *VCDClient, vdc *govcd.Vdc) ([]*nsxtImportableSwitch, error) {
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "orgVdc.id=="+vdc.Vdc.ID)
+
+	allSwitches := []*nsxtImportableSwitch{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointImportableSwitches, queryParameters, &allSwitches)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve importable NSX-T logical switches: %s", err)
+	}
+
+	return allSwitches, nil
+}
+
+// getNsxtImportableSwitchByName retrieves NSX-T logical switch (segment) by name from the ones which VCD lists for a
+// given VDC
+func getNsxtImportableSwitchByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*nsxtImportableSwitch, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty NSX-T logical switch name")
+	}
+
+	// API does not support filtering by name therefore all importable switches of VDC are retrieved and filtered here
+	allSwitches, err := getAllNsxtImportableSwitches(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	var foundSwitches []*nsxtImportableSwitch
+	for _, logicalSwitch := range allSwitches {
+		if logicalSwitch.Name == name {
+			foundSwitches = append(foundSwitches, logicalSwitch)
+		}
+	}
+
+	if len(foundSwitches) == 0 {
+		return nil, fmt.Errorf("%s: could not find importable NSX-T logical switch by name '%s' in VDC '%s'",
+			govcd.ErrorEntityNotFound, name, vdc.Vdc.Name)
+	}
+
+	if len(foundSwitches) > 1 {
+		return nil, fmt.Errorf("expected exactly one importable NSX-T logical switch with name '%s' in VDC '%s'. Got %d",
+			name, vdc.Vdc.Name, len(foundSwitches))
+	}
+
+	return foundSwitches[0], nil
+}
+
+// getNsxtLogicalSwitchNameById resolves the name of NSX-T logical switch (segment) by its ID. An empty name is returned
+// when VCD does not list the switch for the VDC.
+func getNsxtLogicalSwitchNameById(vcdClient *VCDClient, vdc *govcd.Vdc, id string) (string, error) {
+	allSwitches, err := getAllNsxtImportableSwitches(vcdClient, vdc)
+	if err != nil {
+		return "", err
+	}
+
+	for _, logicalSwitch := range allSwitches {
+		if logicalSwitch.ID == id {
+			return logicalSwitch.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// getOpenApiOrgVdcImportedNetworkBySwitchName retrieves imported Org VDC network in a given VDC by the name of NSX-T
+// logical switch (segment) which backs it. The switch name is resolved through the backing network ID of each imported
+// network. Returns an error if not exactly one network is found.
+func getOpenApiOrgVdcImportedNetworkBySwitchName(vcdClient *VCDClient, vdc *govcd.Vdc, switchName string) (*openApiOrgVdcNetwork, error) {
+	if switchName == "" {
+		return nil, fmt.Errorf("empty NSX-T logical switch name")
+	}
+
+	allSwitches, err := getAllNsxtImportableSwitches(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	switchIds := make(map[string]bool)
+	for _, logicalSwitch := range allSwitches {
+		if logicalSwitch.Name == switchName {
+			switchIds[logicalSwitch.ID] = true
+		}
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "orgVdc.id=="+vdc.Vdc.ID)
+	networks, err := getAllOpenApiOrgVdcNetworks(vcdClient, queryParameters)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve Org VDC networks: %s", err)
+	}
+
+	var foundNetworks []*openApiOrgVdcNetwork
+	for _, network := range networks {
+		if network.NetworkType == openApiOrgVdcNetworkTypeImported && switchIds[network.BackingNetworkId] {
+			foundNetworks = append(foundNetworks, network)
+		}
+	}
+
+	if len(foundNetworks) == 0 {
+		return nil, fmt.Errorf("%s: could not find imported Org VDC network backed by NSX-T logical switch '%s' in VDC '%s'",
+			govcd.ErrorEntityNotFound, switchName, vdc.Vdc.Name)
+	}
+
+	if len(foundNetworks) > 1 {
+		return nil, fmt.Errorf("expected exactly one imported Org VDC network backed by NSX-T logical switch '%s' in VDC '%s'. Got %d",
+			switchName, vdc.Vdc.Name, len(foundNetworks))
+	}
+
+	return foundNetworks[0], nil
+}
@@ -0,0 +1,298 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// This file contains helpers for provider side NSX-T Advanced Load Balancer (ALB) configuration - controllers, NSX-T
+// clouds and service engine groups.
+
+// getNsxtAlbControllerById retrieves ALB controller by its ID
+func getNsxtAlbControllerById(vcdClient *VCDClient, id string) (*nsxtAlbController, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty ALB controller ID")
+	}
+
+	controller := &nsxtAlbController{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbController, id, controller)
+	if err != nil {
+		return nil, err
+	}
+
+	return controller, nil
+}
+
+// getNsxtAlbControllerByName retrieves ALB controller by name
+func getNsxtAlbControllerByName(vcdClient *VCDClient, name string) (*nsxtAlbController, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty ALB controller name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name)
+
+	controllers := []*nsxtAlbController{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbController, queryParameters, &controllers)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve ALB controllers: %s", err)
+	}
+
+	if len(controllers) == 0 {
+		return nil, fmt.Errorf("%s: could not find ALB controller by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(controllers) > 1 {
+		return nil, fmt.Errorf("expected exactly one ALB controller with name '%s'. Got %d", name, len(controllers))
+	}
+
+	return controllers[0], nil
+}
+
+// createNsxtAlbController registers ALB controller in VCD and returns it
+func createNsxtAlbController(vcdClient *VCDClient, controller *nsxtAlbController) (*nsxtAlbController, error) {
+	createdController := &nsxtAlbController{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbController, controller, createdController)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdController, nil
+}
+
+// updateNsxtAlbController updates ALB controller. controller.ID must be set.
+func updateNsxtAlbController(vcdClient *VCDClient, controller *nsxtAlbController) (*nsxtAlbController, error) {
+	if controller.ID == "" {
+		return nil, fmt.Errorf("cannot update ALB controller without ID")
+	}
+
+	updatedController := &nsxtAlbController{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbController, controller.ID, controller,
+		updatedController)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedController, nil
+}
+
+// deleteNsxtAlbController removes ALB controller registration from VCD
+func deleteNsxtAlbController(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete ALB controller without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbController, id)
+}
+
+// getNsxtAlbImportableCloudByName retrieves NSX-T cloud which is defined in ALB controller and can be imported into
+// VCD
+func getNsxtAlbImportableCloudByName(vcdClient *VCDClient, controllerId, name string) (*nsxtAlbImportableCloud, error) {
+	if controllerId == "" || name == "" {
+		return nil, fmt.Errorf("ALB controller ID and importable cloud name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "_context=="+controllerId)
+
+	importableClouds := []*nsxtAlbImportableCloud{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbImportableClouds, queryParameters,
+		&importableClouds)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve importable clouds: %s", err)
+	}
+
+	// Importable clouds do not support filtering by name
+	for _, importableCloud := range importableClouds {
+		if importableCloud.DisplayName == name {
+			return importableCloud, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: could not find importable cloud by name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// getNsxtAlbCloudById retrieves NSX-T cloud imported into VCD by its ID
+func getNsxtAlbCloudById(vcdClient *VCDClient, id string) (*nsxtAlbCloud, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty ALB cloud ID")
+	}
+
+	cloud := &nsxtAlbCloud{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbCloud, id, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloud, nil
+}
+
+// getNsxtAlbCloudByName retrieves NSX-T cloud imported into VCD by name
+func getNsxtAlbCloudByName(vcdClient *VCDClient, name string) (*nsxtAlbCloud, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty ALB cloud name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name)
+
+	clouds := []*nsxtAlbCloud{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbCloud, queryParameters, &clouds)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve ALB clouds: %s", err)
+	}
+
+	if len(clouds) == 0 {
+		return nil, fmt.Errorf("%s: could not find ALB cloud by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(clouds) > 1 {
+		return nil, fmt.Errorf("expected exactly one ALB cloud with name '%s'. Got %d", name, len(clouds))
+	}
+
+	return clouds[0], nil
+}
+
+// createNsxtAlbCloud imports NSX-T cloud from ALB controller into VCD and returns it
+func createNsxtAlbCloud(vcdClient *VCDClient, cloud *nsxtAlbCloud) (*nsxtAlbCloud, error) {
+	createdCloud := &nsxtAlbCloud{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbCloud, cloud, createdCloud)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdCloud, nil
+}
+
+// updateNsxtAlbCloud updates NSX-T cloud. cloud.ID must be set.
+func updateNsxtAlbCloud(vcdClient *VCDClient, cloud *nsxtAlbCloud) (*nsxtAlbCloud, error) {
+	if cloud.ID == "" {
+		return nil, fmt.Errorf("cannot update ALB cloud without ID")
+	}
+
+	updatedCloud := &nsxtAlbCloud{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbCloud, cloud.ID, cloud, updatedCloud)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedCloud, nil
+}
+
+// deleteNsxtAlbCloud removes NSX-T cloud from VCD
+func deleteNsxtAlbCloud(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete ALB cloud without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbCloud, id)
+}
+
+// getNsxtAlbImportableServiceEngineGroupByName retrieves service engine group which is defined in ALB cloud and can
+// be imported into VCD
+func getNsxtAlbImportableServiceEngineGroupByName(vcdClient *VCDClient, cloudId, name string) (*nsxtAlbImportableServiceEngineGroup, error) {
+	if cloudId == "" || name == "" {
+		return nil, fmt.Errorf("ALB cloud ID and importable service engine group name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "_context=="+cloudId)
+
+	importableGroups := []*nsxtAlbImportableServiceEngineGroup{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbImportableSeGroups, queryParameters,
+		&importableGroups)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve importable service engine groups: %s", err)
+	}
+
+	// Importable service engine groups do not support filtering by name
+	for _, importableGroup := range importableGroups {
+		if importableGroup.DisplayName == name {
+			return importableGroup, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: could not find importable service engine group by name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// getNsxtAlbServiceEngineGroupById retrieves service engine group imported into VCD by its ID
+func getNsxtAlbServiceEngineGroupById(vcdClient *VCDClient, id string) (*nsxtAlbServiceEngineGroup, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty service engine group ID")
+	}
+
+	serviceEngineGroup := &nsxtAlbServiceEngineGroup{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbServiceEngineGroups, id, serviceEngineGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceEngineGroup, nil
+}
+
+// getNsxtAlbServiceEngineGroupByName retrieves service engine group imported into VCD by name
+func getNsxtAlbServiceEngineGroupByName(vcdClient *VCDClient, name string) (*nsxtAlbServiceEngineGroup, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty service engine group name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name)
+
+	serviceEngineGroups := []*nsxtAlbServiceEngineGroup{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbServiceEngineGroups, queryParameters,
+		&serviceEngineGroups)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve service engine groups: %s", err)
+	}
+
+	if len(serviceEngineGroups) == 0 {
+		return nil, fmt.Errorf("%s: could not find service engine group by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(serviceEngineGroups) > 1 {
+		return nil, fmt.Errorf("expected exactly one service engine group with name '%s'. Got %d", name, len(serviceEngineGroups))
+	}
+
+	return serviceEngineGroups[0], nil
+}
+
+// createNsxtAlbServiceEngineGroup imports service engine group into VCD and returns it
+func createNsxtAlbServiceEngineGroup(vcdClient *VCDClient, serviceEngineGroup *nsxtAlbServiceEngineGroup) (*nsxtAlbServiceEngineGroup, error) {
+	createdGroup := &nsxtAlbServiceEngineGroup{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbServiceEngineGroups, serviceEngineGroup,
+		createdGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdGroup, nil
+}
+
+// updateNsxtAlbServiceEngineGroup updates service engine group. serviceEngineGroup.ID must be set.
+func updateNsxtAlbServiceEngineGroup(vcdClient *VCDClient, serviceEngineGroup *nsxtAlbServiceEngineGroup) (*nsxtAlbServiceEngineGroup, error) {
+	if serviceEngineGroup.ID == "" {
+		return nil, fmt.Errorf("cannot update service engine group without ID")
+	}
+
+	updatedGroup := &nsxtAlbServiceEngineGroup{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbServiceEngineGroups, serviceEngineGroup.ID,
+		serviceEngineGroup, updatedGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedGroup, nil
+}
+
+// deleteNsxtAlbServiceEngineGroup removes service engine group from VCD
+func deleteNsxtAlbServiceEngineGroup(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete service engine group without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbServiceEngineGroups, id)
+}
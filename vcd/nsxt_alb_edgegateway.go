@@ -0,0 +1,283 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// This file contains helpers for tenant side NSX-T Advanced Load Balancer (ALB) configuration - edge gateway
+// settings, service engine group assignments, pools and virtual services.
+
+// getNsxtAlbEdgeGatewaySettings retrieves ALB configuration of NSX-T edge gateway
+func getNsxtAlbEdgeGatewaySettings(vcdClient *VCDClient, edgeGatewayId string) (*nsxtAlbEdgeGatewaySettings, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	settings := &nsxtAlbEdgeGatewaySettings{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbEdgeGateway, "", settings, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// updateNsxtAlbEdgeGatewaySettings updates ALB configuration of NSX-T edge gateway. There is no separate delete
+// operation - ALB is disabled by setting 'Enabled' to false.
+func updateNsxtAlbEdgeGatewaySettings(vcdClient *VCDClient, edgeGatewayId string, settings *nsxtAlbEdgeGatewaySettings) (*nsxtAlbEdgeGatewaySettings, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	updatedSettings := &nsxtAlbEdgeGatewaySettings{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbEdgeGateway, "", settings,
+		updatedSettings, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedSettings, nil
+}
+
+// getNsxtAlbServiceEngineGroupAssignmentById retrieves service engine group assignment by its ID
+func getNsxtAlbServiceEngineGroupAssignmentById(vcdClient *VCDClient, id string) (*nsxtAlbServiceEngineGroupAssignment, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty service engine group assignment ID")
+	}
+
+	assignment := &nsxtAlbServiceEngineGroupAssignment{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbSeGroupAssignments, id, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// getNsxtAlbServiceEngineGroupAssignmentByName retrieves assignment of service engine group with given name to NSX-T
+// edge gateway
+func getNsxtAlbServiceEngineGroupAssignmentByName(vcdClient *VCDClient, edgeGatewayId, serviceEngineGroupName string) (*nsxtAlbServiceEngineGroupAssignment, error) {
+	if edgeGatewayId == "" || serviceEngineGroupName == "" {
+		return nil, fmt.Errorf("NSX-T edge gateway ID and service engine group name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "gatewayRef.id=="+edgeGatewayId)
+
+	assignments := []*nsxtAlbServiceEngineGroupAssignment{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbSeGroupAssignments, queryParameters,
+		&assignments)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve service engine group assignments: %s", err)
+	}
+
+	for _, assignment := range assignments {
+		if assignment.ServiceEngineGroupRef != nil && assignment.ServiceEngineGroupRef.Name == serviceEngineGroupName {
+			return assignment, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: could not find assignment of service engine group '%s'", govcd.ErrorEntityNotFound,
+		serviceEngineGroupName)
+}
+
+// createNsxtAlbServiceEngineGroupAssignment assigns service engine group to NSX-T edge gateway
+func createNsxtAlbServiceEngineGroupAssignment(vcdClient *VCDClient, assignment *nsxtAlbServiceEngineGroupAssignment) (*nsxtAlbServiceEngineGroupAssignment, error) {
+	createdAssignment := &nsxtAlbServiceEngineGroupAssignment{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbSeGroupAssignments, assignment,
+		createdAssignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdAssignment, nil
+}
+
+// updateNsxtAlbServiceEngineGroupAssignment updates service engine group assignment. assignment.ID must be set.
+func updateNsxtAlbServiceEngineGroupAssignment(vcdClient *VCDClient, assignment *nsxtAlbServiceEngineGroupAssignment) (*nsxtAlbServiceEngineGroupAssignment, error) {
+	if assignment.ID == "" {
+		return nil, fmt.Errorf("cannot update service engine group assignment without ID")
+	}
+
+	updatedAssignment := &nsxtAlbServiceEngineGroupAssignment{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbSeGroupAssignments, assignment.ID,
+		assignment, updatedAssignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedAssignment, nil
+}
+
+// deleteNsxtAlbServiceEngineGroupAssignment removes service engine group assignment from NSX-T edge gateway
+func deleteNsxtAlbServiceEngineGroupAssignment(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete service engine group assignment without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbSeGroupAssignments, id)
+}
+
+// getNsxtAlbPoolById retrieves ALB pool by its ID
+func getNsxtAlbPoolById(vcdClient *VCDClient, id string) (*nsxtAlbPool, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty ALB pool ID")
+	}
+
+	pool := &nsxtAlbPool{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbPools, id, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// getNsxtAlbPoolByName retrieves ALB pool of NSX-T edge gateway by name. Pool summaries are used for lookup because
+// the pool endpoint does not support listing.
+func getNsxtAlbPoolByName(vcdClient *VCDClient, edgeGatewayId, name string) (*nsxtAlbPool, error) {
+	if edgeGatewayId == "" || name == "" {
+		return nil, fmt.Errorf("NSX-T edge gateway ID and ALB pool name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name)
+
+	poolSummaries := []*nsxtAlbPool{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbPoolSummaries, queryParameters,
+		&poolSummaries, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve ALB pools: %s", err)
+	}
+
+	if len(poolSummaries) == 0 {
+		return nil, fmt.Errorf("%s: could not find ALB pool by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(poolSummaries) > 1 {
+		return nil, fmt.Errorf("expected exactly one ALB pool with name '%s'. Got %d", name, len(poolSummaries))
+	}
+
+	// Summaries do not contain all fields
+	return getNsxtAlbPoolById(vcdClient, poolSummaries[0].ID)
+}
+
+// createNsxtAlbPool creates ALB pool and returns it
+func createNsxtAlbPool(vcdClient *VCDClient, pool *nsxtAlbPool) (*nsxtAlbPool, error) {
+	createdPool := &nsxtAlbPool{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbPools, pool, createdPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdPool, nil
+}
+
+// updateNsxtAlbPool updates ALB pool. pool.ID must be set.
+func updateNsxtAlbPool(vcdClient *VCDClient, pool *nsxtAlbPool) (*nsxtAlbPool, error) {
+	if pool.ID == "" {
+		return nil, fmt.Errorf("cannot update ALB pool without ID")
+	}
+
+	updatedPool := &nsxtAlbPool{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbPools, pool.ID, pool, updatedPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedPool, nil
+}
+
+// deleteNsxtAlbPool deletes ALB pool
+func deleteNsxtAlbPool(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete ALB pool without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbPools, id)
+}
+
+// getNsxtAlbVirtualServiceById retrieves ALB virtual service by its ID
+func getNsxtAlbVirtualServiceById(vcdClient *VCDClient, id string) (*nsxtAlbVirtualService, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty ALB virtual service ID")
+	}
+
+	virtualService := &nsxtAlbVirtualService{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbVirtualServices, id, virtualService)
+	if err != nil {
+		return nil, err
+	}
+
+	return virtualService, nil
+}
+
+// getNsxtAlbVirtualServiceByName retrieves ALB virtual service of NSX-T edge gateway by name. Virtual service
+// summaries are used for lookup because the virtual service endpoint does not support listing.
+func getNsxtAlbVirtualServiceByName(vcdClient *VCDClient, edgeGatewayId, name string) (*nsxtAlbVirtualService, error) {
+	if edgeGatewayId == "" || name == "" {
+		return nil, fmt.Errorf("NSX-T edge gateway ID and ALB virtual service name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name)
+
+	summaries := []*nsxtAlbVirtualService{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAlbVirtualServiceSummaries,
+		queryParameters, &summaries, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve ALB virtual services: %s", err)
+	}
+
+	if len(summaries) == 0 {
+		return nil, fmt.Errorf("%s: could not find ALB virtual service by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(summaries) > 1 {
+		return nil, fmt.Errorf("expected exactly one ALB virtual service with name '%s'. Got %d", name, len(summaries))
+	}
+
+	// Summaries do not contain all fields
+	return getNsxtAlbVirtualServiceById(vcdClient, summaries[0].ID)
+}
+
+// createNsxtAlbVirtualService creates ALB virtual service and returns it
+func createNsxtAlbVirtualService(vcdClient *VCDClient, virtualService *nsxtAlbVirtualService) (*nsxtAlbVirtualService, error) {
+	createdVirtualService := &nsxtAlbVirtualService{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbVirtualServices, virtualService,
+		createdVirtualService)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdVirtualService, nil
+}
+
+// updateNsxtAlbVirtualService updates ALB virtual service. virtualService.ID must be set.
+func updateNsxtAlbVirtualService(vcdClient *VCDClient, virtualService *nsxtAlbVirtualService) (*nsxtAlbVirtualService, error) {
+	if virtualService.ID == "" {
+		return nil, fmt.Errorf("cannot update ALB virtual service without ID")
+	}
+
+	updatedVirtualService := &nsxtAlbVirtualService{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbVirtualServices, virtualService.ID,
+		virtualService, updatedVirtualService)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedVirtualService, nil
+}
+
+// deleteNsxtAlbVirtualService deletes ALB virtual service
+func deleteNsxtAlbVirtualService(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete ALB virtual service without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAlbVirtualServices, id)
+}
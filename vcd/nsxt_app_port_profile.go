@@ -0,0 +1,113 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+const (
+	nsxtAppPortProfileScopeSystem   = "SYSTEM"
+	nsxtAppPortProfileScopeProvider = "PROVIDER"
+	nsxtAppPortProfileScopeTenant   = "TENANT"
+)
+
+// getNsxtAppPortProfileById retrieves application port profile by its ID
+func getNsxtAppPortProfileById(vcdClient *VCDClient, id string) (*nsxtAppPortProfile, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty application port profile ID")
+	}
+
+	appPortProfile := &nsxtAppPortProfile{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointAppPortProfiles, id, appPortProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return appPortProfile, nil
+}
+
+// getNsxtAppPortProfileByName retrieves application port profile by name and scope. 'contextId' (VDC ID for TENANT
+// scope and NSX-T manager ID for PROVIDER scope) is optional and narrows down the search. Returns an error if not
+// exactly one profile is found.
+func getNsxtAppPortProfileByName(vcdClient *VCDClient, name, scope, contextId string) (*nsxtAppPortProfile, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty application port profile name")
+	}
+
+	filter := "name==" + name + ";scope==" + scope
+	if contextId != "" {
+		filter += ";_context==" + contextId
+	}
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", filter)
+
+	appPortProfiles, err := getAllNsxtAppPortProfiles(vcdClient, queryParameters)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve application port profiles: %s", err)
+	}
+
+	if len(appPortProfiles) == 0 {
+		return nil, fmt.Errorf("%s: could not find application port profile by name '%s' in scope '%s'",
+			govcd.ErrorEntityNotFound, name, scope)
+	}
+
+	if len(appPortProfiles) > 1 {
+		return nil, fmt.Errorf("expected exactly one application port profile with name '%s' in scope '%s'. Got %d",
+			name, scope, len(appPortProfiles))
+	}
+
+	return appPortProfiles[0], nil
+}
+
+// getAllNsxtAppPortProfiles retrieves all application port profiles visible to the user. Query parameters can be
+// supplied to perform additional filtering.
+func getAllNsxtAppPortProfiles(vcdClient *VCDClient, queryParameters url.Values) ([]*nsxtAppPortProfile, error) {
+	appPortProfiles := []*nsxtAppPortProfile{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointAppPortProfiles, queryParameters,
+		&appPortProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return appPortProfiles, nil
+}
+
+// createNsxtAppPortProfile creates application port profile and returns it
+func createNsxtAppPortProfile(vcdClient *VCDClient, appPortProfile *nsxtAppPortProfile) (*nsxtAppPortProfile, error) {
+	createdProfile := &nsxtAppPortProfile{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointAppPortProfiles, appPortProfile,
+		createdProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdProfile, nil
+}
+
+// updateNsxtAppPortProfile updates application port profile. appPortProfile.ID must be set.
+func updateNsxtAppPortProfile(vcdClient *VCDClient, appPortProfile *nsxtAppPortProfile) (*nsxtAppPortProfile, error) {
+	if appPortProfile.ID == "" {
+		return nil, fmt.Errorf("cannot update application port profile without ID")
+	}
+
+	updatedProfile := &nsxtAppPortProfile{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointAppPortProfiles, appPortProfile.ID,
+		appPortProfile, updatedProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedProfile, nil
+}
+
+// deleteNsxtAppPortProfile deletes application port profile
+func deleteNsxtAppPortProfile(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete application port profile without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointAppPortProfiles, id)
+}
@@ -0,0 +1,68 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getVdcGroupDfwDefaultPolicyId retrieves ID of the default distributed firewall policy of VDC group. All rules
+// managed by the provider belong to this policy.
+func getVdcGroupDfwDefaultPolicyId(vcdClient *VCDClient, vdcGroupId string) (string, error) {
+	if vdcGroupId == "" {
+		return "", fmt.Errorf("empty VDC group ID")
+	}
+
+	policy := &nsxtDfwPolicy{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroupDfwDefaultPolicy, "", policy, vdcGroupId)
+	if err != nil {
+		return "", err
+	}
+
+	if policy.ID == "" {
+		return "", fmt.Errorf("default distributed firewall policy of VDC group '%s' has no ID. Is distributed firewall enabled?",
+			vdcGroupId)
+	}
+
+	return policy.ID, nil
+}
+
+// getNsxtDistributedFirewall retrieves all distributed firewall rules of VDC group
+func getNsxtDistributedFirewall(vcdClient *VCDClient, vdcGroupId string) (*nsxtDistributedFirewallRules, error) {
+	policyId, err := getVdcGroupDfwDefaultPolicyId(vcdClient, vdcGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &nsxtDistributedFirewallRules{}
+	err = vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroupDfwRules, "", rules, vdcGroupId, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// updateNsxtDistributedFirewall replaces all distributed firewall rules of VDC group with the ones specified in
+// 'rules'. Order of rules is preserved.
+func updateNsxtDistributedFirewall(vcdClient *VCDClient, vdcGroupId string, rules *nsxtDistributedFirewallRules) (*nsxtDistributedFirewallRules, error) {
+	policyId, err := getVdcGroupDfwDefaultPolicyId(vcdClient, vdcGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedRules := &nsxtDistributedFirewallRules{}
+	err = vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroupDfwRules, "", rules, updatedRules,
+		vdcGroupId, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedRules, nil
+}
+
+// deleteNsxtDistributedFirewall removes all distributed firewall rules of VDC group
+func deleteNsxtDistributedFirewall(vcdClient *VCDClient, vdcGroupId string) error {
+	_, err := updateNsxtDistributedFirewall(vcdClient, vdcGroupId, &nsxtDistributedFirewallRules{Values: []*nsxtFirewallRule{}})
+	return err
+}
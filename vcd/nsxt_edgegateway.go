@@ -0,0 +1,105 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtEdgeGatewayById retrieves NSX-T edge gateway by its URN ID
+func getNsxtEdgeGatewayById(vcdClient *VCDClient, id string) (*nsxtEdgeGateway, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	edgeGateway := &nsxtEdgeGateway{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointEdgeGateways, id, edgeGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	return edgeGateway, nil
+}
+
+// getNsxtEdgeGatewayByName retrieves NSX-T edge gateway by name in a given VDC. Returns an error if not exactly one
+// edge gateway is found.
+func getNsxtEdgeGatewayByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*nsxtEdgeGateway, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "name=="+name+";orgVdc.id=="+vdc.Vdc.ID)
+
+	edgeGateways, err := getAllNsxtEdgeGateways(vcdClient, queryParameters)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve NSX-T edge gateway by name '%s': %s", name, err)
+	}
+
+	if len(edgeGateways) == 0 {
+		return nil, fmt.Errorf("%s: could not find NSX-T edge gateway by name '%s' in VDC '%s'",
+			govcd.ErrorEntityNotFound, name, vdc.Vdc.Name)
+	}
+
+	if len(edgeGateways) > 1 {
+		return nil, fmt.Errorf("expected exactly one NSX-T edge gateway with name '%s' in VDC '%s'. Got %d",
+			name, vdc.Vdc.Name, len(edgeGateways))
+	}
+
+	return edgeGateways[0], nil
+}
+
+// getAllNsxtEdgeGateways retrieves all NSX-T edge gateways visible to the user. Query parameters can be supplied to
+// perform additional filtering.
+func getAllNsxtEdgeGateways(vcdClient *VCDClient, queryParameters url.Values) ([]*nsxtEdgeGateway, error) {
+	edgeGateways := []*nsxtEdgeGateway{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointEdgeGateways, queryParameters, &edgeGateways)
+	if err != nil {
+		return nil, err
+	}
+
+	return edgeGateways, nil
+}
+
+// createNsxtEdgeGateway creates NSX-T edge gateway and returns the created structure
+func createNsxtEdgeGateway(vcdClient *VCDClient, edgeGatewayConfig *nsxtEdgeGateway) (*nsxtEdgeGateway, error) {
+	createdEdgeGateway := &nsxtEdgeGateway{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointEdgeGateways, edgeGatewayConfig, createdEdgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NSX-T edge gateway: %s", err)
+	}
+
+	return createdEdgeGateway, nil
+}
+
+// updateNsxtEdgeGateway updates NSX-T edge gateway and returns the updated structure
+func updateNsxtEdgeGateway(vcdClient *VCDClient, edgeGatewayConfig *nsxtEdgeGateway) (*nsxtEdgeGateway, error) {
+	if edgeGatewayConfig.ID == "" {
+		return nil, fmt.Errorf("cannot update NSX-T edge gateway without ID")
+	}
+
+	updatedEdgeGateway := &nsxtEdgeGateway{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointEdgeGateways, edgeGatewayConfig.ID,
+		edgeGatewayConfig, updatedEdgeGateway)
+	if err != nil {
+		return nil, fmt.Errorf("error updating NSX-T edge gateway: %s", err)
+	}
+
+	return updatedEdgeGateway, nil
+}
+
+// deleteNsxtEdgeGateway deletes NSX-T edge gateway by ID
+func deleteNsxtEdgeGateway(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete NSX-T edge gateway without ID")
+	}
+
+	err := vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointEdgeGateways, id)
+	if err != nil {
+		return fmt.Errorf("error deleting NSX-T edge gateway: %s", err)
+	}
+
+	return nil
+}
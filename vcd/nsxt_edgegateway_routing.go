@@ -0,0 +1,284 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtStaticRouteById retrieves static route of NSX-T edge gateway by its ID
+func getNsxtStaticRouteById(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtStaticRoute, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty static route ID")
+	}
+
+	staticRoute := &nsxtStaticRoute{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtStaticRoutes, id, staticRoute, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return staticRoute, nil
+}
+
+// getNsxtStaticRouteByName retrieves static route of NSX-T edge gateway by name. Returns an error if not exactly one
+// static route is found.
+func getNsxtStaticRouteByName(vcdClient *VCDClient, edgeGatewayId, name string) (*nsxtStaticRoute, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty static route name")
+	}
+
+	allStaticRoutes, err := getAllNsxtStaticRoutes(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve static routes: %s", err)
+	}
+
+	var foundStaticRoutes []*nsxtStaticRoute
+	for _, staticRoute := range allStaticRoutes {
+		if staticRoute.Name == name {
+			foundStaticRoutes = append(foundStaticRoutes, staticRoute)
+		}
+	}
+
+	if len(foundStaticRoutes) == 0 {
+		return nil, fmt.Errorf("%s: could not find static route by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundStaticRoutes) > 1 {
+		return nil, fmt.Errorf("expected exactly one static route with name '%s'. Got %d", name, len(foundStaticRoutes))
+	}
+
+	return foundStaticRoutes[0], nil
+}
+
+// getAllNsxtStaticRoutes retrieves all static routes of NSX-T edge gateway
+func getAllNsxtStaticRoutes(vcdClient *VCDClient, edgeGatewayId string) ([]*nsxtStaticRoute, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	staticRoutes := []*nsxtStaticRoute{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtStaticRoutes, nil, &staticRoutes, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return staticRoutes, nil
+}
+
+// createNsxtStaticRoute creates static route for NSX-T edge gateway and returns it.
+//
+// Note. Same as for NAT rules, task returned by API has edge gateway as owner therefore the created static route is
+// found by comparing IDs before and after creation.
+func createNsxtStaticRoute(vcdClient *VCDClient, edgeGatewayId string, staticRoute *nsxtStaticRoute) (*nsxtStaticRoute, error) {
+	staticRoutesBefore, err := getAllNsxtStaticRoutes(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving static routes before creation: %s", err)
+	}
+
+	existingIds := make(map[string]bool)
+	for _, existingStaticRoute := range staticRoutesBefore {
+		existingIds[existingStaticRoute.ID] = true
+	}
+
+	err = vcdClient.openApiPostItemAsync(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtStaticRoutes, staticRoute, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating static route: %s", err)
+	}
+
+	staticRoutesAfter, err := getAllNsxtStaticRoutes(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving static routes after creation: %s", err)
+	}
+
+	for _, newStaticRoute := range staticRoutesAfter {
+		if !existingIds[newStaticRoute.ID] && newStaticRoute.Name == staticRoute.Name {
+			return newStaticRoute, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find created static route '%s'", staticRoute.Name)
+}
+
+// updateNsxtStaticRoute updates static route of NSX-T edge gateway. staticRoute.ID must be set.
+func updateNsxtStaticRoute(vcdClient *VCDClient, edgeGatewayId string, staticRoute *nsxtStaticRoute) (*nsxtStaticRoute, error) {
+	if staticRoute.ID == "" {
+		return nil, fmt.Errorf("cannot update static route without ID")
+	}
+
+	updatedStaticRoute := &nsxtStaticRoute{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtStaticRoutes, staticRoute.ID, staticRoute,
+		updatedStaticRoute, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedStaticRoute, nil
+}
+
+// deleteNsxtStaticRoute deletes static route of NSX-T edge gateway
+func deleteNsxtStaticRoute(vcdClient *VCDClient, edgeGatewayId, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete static route without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtStaticRoutes, id, edgeGatewayId)
+}
+
+// getNsxtBgpConfig retrieves BGP configuration of NSX-T edge gateway
+func getNsxtBgpConfig(vcdClient *VCDClient, edgeGatewayId string) (*nsxtBgpConfig, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	bgpConfig := &nsxtBgpConfig{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpConfig, "", bgpConfig, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return bgpConfig, nil
+}
+
+// updateNsxtBgpConfig updates BGP configuration of NSX-T edge gateway. Current configuration version is retrieved
+// automatically as VCD rejects updates with outdated version.
+func updateNsxtBgpConfig(vcdClient *VCDClient, edgeGatewayId string, bgpConfig *nsxtBgpConfig) (*nsxtBgpConfig, error) {
+	currentBgpConfig, err := getNsxtBgpConfig(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving current BGP configuration: %s", err)
+	}
+	bgpConfig.Version = currentBgpConfig.Version
+
+	updatedBgpConfig := &nsxtBgpConfig{}
+	err = vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpConfig, "", bgpConfig,
+		updatedBgpConfig, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedBgpConfig, nil
+}
+
+// getNsxtBgpNeighborById retrieves BGP neighbor of NSX-T edge gateway by its ID
+func getNsxtBgpNeighborById(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtBgpNeighbor, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty BGP neighbor ID")
+	}
+
+	neighbor := &nsxtBgpNeighbor{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpNeighbors, id, neighbor, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return neighbor, nil
+}
+
+// getNsxtBgpNeighborByAddress retrieves BGP neighbor of NSX-T edge gateway by its IP address
+func getNsxtBgpNeighborByAddress(vcdClient *VCDClient, edgeGatewayId, neighborAddress string) (*nsxtBgpNeighbor, error) {
+	if neighborAddress == "" {
+		return nil, fmt.Errorf("empty BGP neighbor address")
+	}
+
+	allNeighbors, err := getAllNsxtBgpNeighbors(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve BGP neighbors: %s", err)
+	}
+
+	for _, neighbor := range allNeighbors {
+		if neighbor.NeighborAddress == neighborAddress {
+			return neighbor, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: could not find BGP neighbor by address '%s'", govcd.ErrorEntityNotFound, neighborAddress)
+}
+
+// getAllNsxtBgpNeighbors retrieves all BGP neighbors of NSX-T edge gateway
+func getAllNsxtBgpNeighbors(vcdClient *VCDClient, edgeGatewayId string) ([]*nsxtBgpNeighbor, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	neighbors := []*nsxtBgpNeighbor{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpNeighbors, nil, &neighbors, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return neighbors, nil
+}
+
+// createNsxtBgpNeighbor creates BGP neighbor for NSX-T edge gateway and returns it. Neighbor addresses are unique
+// within edge gateway therefore the created neighbor is looked up by address.
+func createNsxtBgpNeighbor(vcdClient *VCDClient, edgeGatewayId string, neighbor *nsxtBgpNeighbor) (*nsxtBgpNeighbor, error) {
+	err := vcdClient.openApiPostItemAsync(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpNeighbors, neighbor, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BGP neighbor: %s", err)
+	}
+
+	return getNsxtBgpNeighborByAddress(vcdClient, edgeGatewayId, neighbor.NeighborAddress)
+}
+
+// updateNsxtBgpNeighbor updates BGP neighbor of NSX-T edge gateway. neighbor.ID must be set.
+func updateNsxtBgpNeighbor(vcdClient *VCDClient, edgeGatewayId string, neighbor *nsxtBgpNeighbor) (*nsxtBgpNeighbor, error) {
+	if neighbor.ID == "" {
+		return nil, fmt.Errorf("cannot update BGP neighbor without ID")
+	}
+
+	updatedNeighbor := &nsxtBgpNeighbor{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpNeighbors, neighbor.ID, neighbor,
+		updatedNeighbor, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedNeighbor, nil
+}
+
+// deleteNsxtBgpNeighbor deletes BGP neighbor of NSX-T edge gateway
+func deleteNsxtBgpNeighbor(vcdClient *VCDClient, edgeGatewayId, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete BGP neighbor without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtBgpNeighbors, id, edgeGatewayId)
+}
+
+// getNsxtRouteAdvertisement retrieves route advertisement configuration of NSX-T edge gateway
+func getNsxtRouteAdvertisement(vcdClient *VCDClient, edgeGatewayId string) (*nsxtRouteAdvertisement, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	routeAdvertisement := &nsxtRouteAdvertisement{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtRouteAdvertisement, "",
+		routeAdvertisement, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return routeAdvertisement, nil
+}
+
+// updateNsxtRouteAdvertisement replaces route advertisement configuration of NSX-T edge gateway
+func updateNsxtRouteAdvertisement(vcdClient *VCDClient, edgeGatewayId string, routeAdvertisement *nsxtRouteAdvertisement) (*nsxtRouteAdvertisement, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	// VCD rejects 'null' subnet list
+	if routeAdvertisement.Subnets == nil {
+		routeAdvertisement.Subnets = []string{}
+	}
+
+	updatedRouteAdvertisement := &nsxtRouteAdvertisement{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtRouteAdvertisement, "",
+		routeAdvertisement, updatedRouteAdvertisement, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedRouteAdvertisement, nil
+}
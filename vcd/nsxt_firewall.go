@@ -0,0 +1,49 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtFirewall retrieves all firewall rules (system, default and user defined) of NSX-T edge gateway
+func getNsxtFirewall(vcdClient *VCDClient, edgeGatewayId string) (*nsxtFirewall, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	firewall := &nsxtFirewall{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtFirewallRules, "", firewall, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return firewall, nil
+}
+
+// updateNsxtFirewall replaces all user defined firewall rules of NSX-T edge gateway with the ones specified in
+// 'firewall'. Order of rules is preserved.
+func updateNsxtFirewall(vcdClient *VCDClient, edgeGatewayId string, firewall *nsxtFirewall) (*nsxtFirewall, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	updatedFirewall := &nsxtFirewall{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtFirewallRules, "", firewall,
+		updatedFirewall, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedFirewall, nil
+}
+
+// deleteNsxtFirewall removes all user defined firewall rules of NSX-T edge gateway. System and default rules are left
+// intact.
+func deleteNsxtFirewall(vcdClient *VCDClient, edgeGatewayId string) error {
+	if edgeGatewayId == "" {
+		return fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtFirewallRules, "", edgeGatewayId)
+}
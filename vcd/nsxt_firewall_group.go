@@ -0,0 +1,136 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// nsxtFirewallGroupTypeSecurityGroup is the type of firewall group which has Org VDC networks as members
+const nsxtFirewallGroupTypeSecurityGroup = "SECURITY_GROUP"
+
+// getNsxtFirewallGroupById retrieves firewall group by its ID
+func getNsxtFirewallGroupById(vcdClient *VCDClient, id string) (*nsxtFirewallGroup, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty firewall group ID")
+	}
+
+	firewallGroup := &nsxtFirewallGroup{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroups, id, firewallGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return firewallGroup, nil
+}
+
+// getNsxtFirewallGroupByName retrieves firewall group of given type in NSX-T edge gateway by name. Returns an error if
+// not exactly one firewall group is found.
+func getNsxtFirewallGroupByName(vcdClient *VCDClient, edgeGatewayId, name, groupType string) (*nsxtFirewallGroup, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty firewall group name")
+	}
+
+	allGroups, err := getAllNsxtFirewallGroups(vcdClient, edgeGatewayId, groupType)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve firewall groups: %s", err)
+	}
+
+	var foundGroups []*nsxtFirewallGroup
+	for _, group := range allGroups {
+		if group.Name == name {
+			foundGroups = append(foundGroups, group)
+		}
+	}
+
+	if len(foundGroups) == 0 {
+		return nil, fmt.Errorf("%s: could not find firewall group by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundGroups) > 1 {
+		return nil, fmt.Errorf("expected exactly one firewall group with name '%s'. Got %d", name, len(foundGroups))
+	}
+
+	return foundGroups[0], nil
+}
+
+// getAllNsxtFirewallGroups retrieves all firewall groups of given type in NSX-T edge gateway
+func getAllNsxtFirewallGroups(vcdClient *VCDClient, edgeGatewayId, groupType string) ([]*nsxtFirewallGroup, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	// '_context' filter returns all firewall groups which belong to the given edge gateway
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "_context=="+edgeGatewayId)
+
+	allGroups := []*nsxtFirewallGroup{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroups, queryParameters, &allGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	var firewallGroups []*nsxtFirewallGroup
+	for _, group := range allGroups {
+		if group.Type == groupType {
+			firewallGroups = append(firewallGroups, group)
+		}
+	}
+
+	return firewallGroups, nil
+}
+
+// createNsxtFirewallGroup creates firewall group and returns it
+func createNsxtFirewallGroup(vcdClient *VCDClient, firewallGroup *nsxtFirewallGroup) (*nsxtFirewallGroup, error) {
+	createdGroup := &nsxtFirewallGroup{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroups, firewallGroup, createdGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdGroup, nil
+}
+
+// updateNsxtFirewallGroup updates firewall group. firewallGroup.ID must be set.
+func updateNsxtFirewallGroup(vcdClient *VCDClient, firewallGroup *nsxtFirewallGroup) (*nsxtFirewallGroup, error) {
+	if firewallGroup.ID == "" {
+		return nil, fmt.Errorf("cannot update firewall group without ID")
+	}
+
+	updatedGroup := &nsxtFirewallGroup{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroups, firewallGroup.ID,
+		firewallGroup, updatedGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedGroup, nil
+}
+
+// deleteNsxtFirewallGroup deletes firewall group
+func deleteNsxtFirewallGroup(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete firewall group without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroups, id)
+}
+
+// getNsxtFirewallGroupAssociatedVms retrieves VMs which are members of security group. VMs become members of a
+// security group when they are attached to one of its member Org VDC networks.
+func getNsxtFirewallGroupAssociatedVms(vcdClient *VCDClient, id string) ([]*nsxtFirewallGroupMemberVm, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty firewall group ID")
+	}
+
+	memberVms := []*nsxtFirewallGroupMemberVm{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointFirewallGroupAssociatedVms, nil,
+		&memberVms, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberVms, nil
+}
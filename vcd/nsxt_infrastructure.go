@@ -0,0 +1,81 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtEdgeClusterByName retrieves NSX-T edge cluster which is available to the given NSX-T VDC by name. Returns an
+// error if not exactly one edge cluster is found.
+func getNsxtEdgeClusterByName(vcdClient *VCDClient, vdcId, name string) (*nsxtEdgeCluster, error) {
+	if vdcId == "" || name == "" {
+		return nil, fmt.Errorf("VDC ID and edge cluster name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "orgVdcId=="+vdcId)
+
+	allEdgeClusters := []*nsxtEdgeCluster{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtEdgeClusters, queryParameters,
+		&allEdgeClusters)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve edge clusters: %s", err)
+	}
+
+	// Edge clusters do not support filtering by name
+	var foundEdgeClusters []*nsxtEdgeCluster
+	for _, edgeCluster := range allEdgeClusters {
+		if edgeCluster.Name == name {
+			foundEdgeClusters = append(foundEdgeClusters, edgeCluster)
+		}
+	}
+
+	if len(foundEdgeClusters) == 0 {
+		return nil, fmt.Errorf("%s: could not find edge cluster by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundEdgeClusters) > 1 {
+		return nil, fmt.Errorf("expected exactly one edge cluster with name '%s'. Got %d", name, len(foundEdgeClusters))
+	}
+
+	return foundEdgeClusters[0], nil
+}
+
+// getNsxtTransportZoneByName retrieves NSX-T transport zone of the given NSX-T manager by name. Transport zones which
+// are already used by network pools are returned as well. Returns an error if not exactly one transport zone is found.
+func getNsxtTransportZoneByName(vcdClient *VCDClient, nsxtManagerId, name string) (*nsxtTransportZone, error) {
+	if nsxtManagerId == "" || name == "" {
+		return nil, fmt.Errorf("NSX-T manager ID and transport zone name must be specified")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", "_context=="+nsxtManagerId)
+
+	allTransportZones := []*nsxtTransportZone{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtImportableTransportZones,
+		queryParameters, &allTransportZones)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve transport zones: %s", err)
+	}
+
+	// Transport zones do not support filtering by name
+	var foundTransportZones []*nsxtTransportZone
+	for _, transportZone := range allTransportZones {
+		if transportZone.Name == name {
+			foundTransportZones = append(foundTransportZones, transportZone)
+		}
+	}
+
+	if len(foundTransportZones) == 0 {
+		return nil, fmt.Errorf("%s: could not find transport zone by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundTransportZones) > 1 {
+		return nil, fmt.Errorf("expected exactly one transport zone with name '%s'. Got %d", name, len(foundTransportZones))
+	}
+
+	return foundTransportZones[0], nil
+}
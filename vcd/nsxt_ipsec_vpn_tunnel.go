@@ -0,0 +1,164 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtIpSecVpnTunnelById retrieves IPsec VPN tunnel of NSX-T edge gateway by its ID
+func getNsxtIpSecVpnTunnelById(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtIpSecVpnTunnel, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty IPsec VPN tunnel ID")
+	}
+
+	tunnel := &nsxtIpSecVpnTunnel{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnels, id, tunnel, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tunnel, nil
+}
+
+// getNsxtIpSecVpnTunnelByName retrieves IPsec VPN tunnel of NSX-T edge gateway by name. Returns an error if not
+// exactly one tunnel is found.
+func getNsxtIpSecVpnTunnelByName(vcdClient *VCDClient, edgeGatewayId, name string) (*nsxtIpSecVpnTunnel, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty IPsec VPN tunnel name")
+	}
+
+	allTunnels, err := getAllNsxtIpSecVpnTunnels(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve IPsec VPN tunnels: %s", err)
+	}
+
+	var foundTunnels []*nsxtIpSecVpnTunnel
+	for _, tunnel := range allTunnels {
+		if tunnel.Name == name {
+			foundTunnels = append(foundTunnels, tunnel)
+		}
+	}
+
+	if len(foundTunnels) == 0 {
+		return nil, fmt.Errorf("%s: could not find IPsec VPN tunnel by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundTunnels) > 1 {
+		return nil, fmt.Errorf("expected exactly one IPsec VPN tunnel with name '%s'. Got %d", name, len(foundTunnels))
+	}
+
+	return foundTunnels[0], nil
+}
+
+// getAllNsxtIpSecVpnTunnels retrieves all IPsec VPN tunnels of NSX-T edge gateway
+func getAllNsxtIpSecVpnTunnels(vcdClient *VCDClient, edgeGatewayId string) ([]*nsxtIpSecVpnTunnel, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	tunnels := []*nsxtIpSecVpnTunnel{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnels, nil, &tunnels, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tunnels, nil
+}
+
+// createNsxtIpSecVpnTunnel creates IPsec VPN tunnel for NSX-T edge gateway and returns it.
+//
+// Note. Same as for NAT rules, task returned by API has edge gateway as owner therefore the created tunnel is found
+// by comparing tunnel IDs before and after creation.
+func createNsxtIpSecVpnTunnel(vcdClient *VCDClient, edgeGatewayId string, tunnel *nsxtIpSecVpnTunnel) (*nsxtIpSecVpnTunnel, error) {
+	tunnelsBefore, err := getAllNsxtIpSecVpnTunnels(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnels before creation: %s", err)
+	}
+
+	existingIds := make(map[string]bool)
+	for _, existingTunnel := range tunnelsBefore {
+		existingIds[existingTunnel.ID] = true
+	}
+
+	err = vcdClient.openApiPostItemAsync(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnels, tunnel, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating IPsec VPN tunnel: %s", err)
+	}
+
+	tunnelsAfter, err := getAllNsxtIpSecVpnTunnels(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IPsec VPN tunnels after creation: %s", err)
+	}
+
+	for _, newTunnel := range tunnelsAfter {
+		if !existingIds[newTunnel.ID] && newTunnel.Name == tunnel.Name {
+			return newTunnel, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find created IPsec VPN tunnel '%s'", tunnel.Name)
+}
+
+// updateNsxtIpSecVpnTunnel updates IPsec VPN tunnel of NSX-T edge gateway. tunnel.ID must be set.
+func updateNsxtIpSecVpnTunnel(vcdClient *VCDClient, edgeGatewayId string, tunnel *nsxtIpSecVpnTunnel) (*nsxtIpSecVpnTunnel, error) {
+	if tunnel.ID == "" {
+		return nil, fmt.Errorf("cannot update IPsec VPN tunnel without ID")
+	}
+
+	updatedTunnel := &nsxtIpSecVpnTunnel{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnels, tunnel.ID, tunnel,
+		updatedTunnel, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedTunnel, nil
+}
+
+// deleteNsxtIpSecVpnTunnel deletes IPsec VPN tunnel of NSX-T edge gateway
+func deleteNsxtIpSecVpnTunnel(vcdClient *VCDClient, edgeGatewayId, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete IPsec VPN tunnel without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnels, id, edgeGatewayId)
+}
+
+// getNsxtIpSecVpnTunnelSecurityProfile retrieves security profile (connection properties) of IPsec VPN tunnel
+func getNsxtIpSecVpnTunnelSecurityProfile(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtIpSecVpnTunnelSecurityProfile, error) {
+	profile := &nsxtIpSecVpnTunnelSecurityProfile{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnelProperties, "", profile,
+		edgeGatewayId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// updateNsxtIpSecVpnTunnelSecurityProfile updates security profile (connection properties) of IPsec VPN tunnel.
+// Setting SecurityType to DEFAULT resets all customizations.
+func updateNsxtIpSecVpnTunnelSecurityProfile(vcdClient *VCDClient, edgeGatewayId, id string, profile *nsxtIpSecVpnTunnelSecurityProfile) (*nsxtIpSecVpnTunnelSecurityProfile, error) {
+	updatedProfile := &nsxtIpSecVpnTunnelSecurityProfile{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnelProperties, "", profile,
+		updatedProfile, edgeGatewayId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedProfile, nil
+}
+
+// getNsxtIpSecVpnTunnelStatus retrieves runtime status of IPsec VPN tunnel
+func getNsxtIpSecVpnTunnelStatus(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtIpSecVpnTunnelStatus, error) {
+	status := &nsxtIpSecVpnTunnelStatus{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtIpSecVpnTunnelStatus, "", status,
+		edgeGatewayId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
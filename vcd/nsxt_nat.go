@@ -0,0 +1,127 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxtNatRuleById retrieves NAT rule of NSX-T edge gateway by its ID
+func getNsxtNatRuleById(vcdClient *VCDClient, edgeGatewayId, id string) (*nsxtNatRule, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty NAT rule ID")
+	}
+
+	natRule := &nsxtNatRule{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtNatRules, id, natRule, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return natRule, nil
+}
+
+// getNsxtNatRuleByName retrieves NAT rule of NSX-T edge gateway by name. Returns an error if not exactly one rule is
+// found because NAT rule names are not unique.
+func getNsxtNatRuleByName(vcdClient *VCDClient, edgeGatewayId, name string) (*nsxtNatRule, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty NAT rule name")
+	}
+
+	allRules, err := getAllNsxtNatRules(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve NAT rules: %s", err)
+	}
+
+	var foundRules []*nsxtNatRule
+	for _, rule := range allRules {
+		if rule.Name == name {
+			foundRules = append(foundRules, rule)
+		}
+	}
+
+	if len(foundRules) == 0 {
+		return nil, fmt.Errorf("%s: could not find NAT rule by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(foundRules) > 1 {
+		return nil, fmt.Errorf("expected exactly one NAT rule with name '%s'. Got %d", name, len(foundRules))
+	}
+
+	return foundRules[0], nil
+}
+
+// getAllNsxtNatRules retrieves all NAT rules of NSX-T edge gateway
+func getAllNsxtNatRules(vcdClient *VCDClient, edgeGatewayId string) ([]*nsxtNatRule, error) {
+	if edgeGatewayId == "" {
+		return nil, fmt.Errorf("empty NSX-T edge gateway ID")
+	}
+
+	natRules := []*nsxtNatRule{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtNatRules, nil, &natRules, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return natRules, nil
+}
+
+// createNsxtNatRule creates NAT rule for NSX-T edge gateway and returns it.
+//
+// Note. Task returned by API has edge gateway as owner instead of the created NAT rule. The created rule is found by
+// comparing rule IDs before and after creation.
+func createNsxtNatRule(vcdClient *VCDClient, edgeGatewayId string, natRule *nsxtNatRule) (*nsxtNatRule, error) {
+	rulesBefore, err := getAllNsxtNatRules(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving NAT rules before creation: %s", err)
+	}
+
+	existingIds := make(map[string]bool)
+	for _, rule := range rulesBefore {
+		existingIds[rule.ID] = true
+	}
+
+	err = vcdClient.openApiPostItemAsync(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtNatRules, natRule, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT rule: %s", err)
+	}
+
+	rulesAfter, err := getAllNsxtNatRules(vcdClient, edgeGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving NAT rules after creation: %s", err)
+	}
+
+	for _, rule := range rulesAfter {
+		if !existingIds[rule.ID] && rule.Name == natRule.Name {
+			return rule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find created NAT rule '%s'", natRule.Name)
+}
+
+// updateNsxtNatRule updates NAT rule of NSX-T edge gateway. natRule.ID must be set.
+func updateNsxtNatRule(vcdClient *VCDClient, edgeGatewayId string, natRule *nsxtNatRule) (*nsxtNatRule, error) {
+	if natRule.ID == "" {
+		return nil, fmt.Errorf("cannot update NAT rule without ID")
+	}
+
+	updatedNatRule := &nsxtNatRule{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtNatRules, natRule.ID, natRule,
+		updatedNatRule, edgeGatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedNatRule, nil
+}
+
+// deleteNsxtNatRule deletes NAT rule of NSX-T edge gateway
+func deleteNsxtNatRule(vcdClient *VCDClient, edgeGatewayId, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete NAT rule without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointNsxtNatRules, id, edgeGatewayId)
+}
@@ -0,0 +1,47 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getOpenApiOrgVdcNetworkDhcp retrieves DHCP configuration of NSX-T backed Org VDC network
+func getOpenApiOrgVdcNetworkDhcp(vcdClient *VCDClient, orgNetworkId string) (*openApiOrgVdcNetworkDhcp, error) {
+	if orgNetworkId == "" {
+		return nil, fmt.Errorf("empty Org VDC network ID")
+	}
+
+	dhcp := &openApiOrgVdcNetworkDhcp{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworksDhcp, "", dhcp, orgNetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	return dhcp, nil
+}
+
+// updateOpenApiOrgVdcNetworkDhcp replaces DHCP configuration of NSX-T backed Org VDC network
+func updateOpenApiOrgVdcNetworkDhcp(vcdClient *VCDClient, orgNetworkId string, dhcp *openApiOrgVdcNetworkDhcp) (*openApiOrgVdcNetworkDhcp, error) {
+	if orgNetworkId == "" {
+		return nil, fmt.Errorf("empty Org VDC network ID")
+	}
+
+	updatedDhcp := &openApiOrgVdcNetworkDhcp{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworksDhcp, "", dhcp,
+		updatedDhcp, orgNetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedDhcp, nil
+}
+
+// deleteOpenApiOrgVdcNetworkDhcp removes DHCP configuration of NSX-T backed Org VDC network
+func deleteOpenApiOrgVdcNetworkDhcp(vcdClient *VCDClient, orgNetworkId string) error {
+	if orgNetworkId == "" {
+		return fmt.Errorf("empty Org VDC network ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointOrgVdcNetworksDhcp, "", orgNetworkId)
+}
@@ -0,0 +1,696 @@
+package vcd
+
+// This file contains types for NSX-T related OpenAPI endpoints which are not available in go-vcloud-director yet.
+
+// openApiReference is a generic reference type commonly used throughout OpenAPI
+type openApiReference struct {
+	Name string `json:"name,omitempty"`
+	ID   string `json:"id,omitempty"`
+}
+
+// openApiIpRange is a single IP range in OpenAPI
+type openApiIpRange struct {
+	StartAddress string `json:"startAddress"`
+	EndAddress   string `json:"endAddress"`
+}
+
+// openApiIpRanges is a list of IP ranges in OpenAPI
+type openApiIpRanges struct {
+	Values []openApiIpRange `json:"values"`
+}
+
+// nsxtEdgeGateway represents an NSX-T edge gateway (also known as Tier-1 gateway)
+type nsxtEdgeGateway struct {
+	Status string `json:"status,omitempty"`
+	ID     string `json:"id,omitempty"`
+	// Name of edge gateway
+	Name string `json:"name"`
+	// Description of edge gateway
+	Description string `json:"description"`
+	// OrgVdc holds the VDC to which this edge gateway belongs
+	OrgVdc *openApiReference `json:"orgVdc,omitempty"`
+	// Org holds the organization to which this edge gateway belongs
+	Org *openApiReference `json:"orgRef,omitempty"`
+	// EdgeGatewayUplinks defines uplink connections to external networks
+	EdgeGatewayUplinks []nsxtEdgeGatewayUplink `json:"edgeGatewayUplinks"`
+	// EdgeClusterConfig specifies NSX-T edge cluster used to deploy the gateway. It is automatically chosen when
+	// not specified.
+	EdgeClusterConfig *nsxtEdgeGatewayEdgeClusterConfig `json:"edgeClusterConfig,omitempty"`
+	// OrgVdcNetworkCount holds the number of Org VDC networks connected to this gateway
+	OrgVdcNetworkCount *int `json:"orgVdcNetworkCount,omitempty"`
+}
+
+// nsxtEdgeGatewayUplink defines an uplink connection of edge gateway to an external network
+type nsxtEdgeGatewayUplink struct {
+	// UplinkID contains ID of external network
+	UplinkID string `json:"uplinkId,omitempty"`
+	// UplinkName contains name of external network
+	UplinkName string `json:"uplinkName,omitempty"`
+	// Subnets contain subnets of external network which are allocated to edge gateway
+	Subnets nsxtEdgeGatewaySubnets `json:"subnets,omitempty"`
+	// Connected defines if uplink is connected
+	Connected bool `json:"connected,omitempty"`
+	// Dedicated defines if the external network is dedicated to this edge gateway only
+	Dedicated bool `json:"dedicated,omitempty"`
+}
+
+// nsxtEdgeGatewaySubnets is a list of edge gateway uplink subnets
+type nsxtEdgeGatewaySubnets struct {
+	Values []nsxtEdgeGatewaySubnet `json:"values"`
+}
+
+// nsxtEdgeGatewaySubnet defines one subnet of an external network which is used by edge gateway
+type nsxtEdgeGatewaySubnet struct {
+	Gateway      string `json:"gateway"`
+	PrefixLength int    `json:"prefixLength"`
+	DNSSuffix    string `json:"dnsSuffix,omitempty"`
+	DNSServer1   string `json:"dnsServer1,omitempty"`
+	DNSServer2   string `json:"dnsServer2,omitempty"`
+	// IPRanges contain IP allocations
+	IPRanges *openApiIpRanges `json:"ipRanges,omitempty"`
+	// Enabled toggles if the subnet is enabled
+	Enabled      bool `json:"enabled"`
+	TotalIPCount int  `json:"totalIpCount,omitempty"`
+	UsedIPCount  int  `json:"usedIpCount,omitempty"`
+	// PrimaryIP of the edge gateway. Only one subnet can have it set
+	PrimaryIP string `json:"primaryIp,omitempty"`
+}
+
+// nsxtEdgeGatewayEdgeClusterConfig allows to specify edge cluster for edge gateway
+type nsxtEdgeGatewayEdgeClusterConfig struct {
+	PrimaryEdgeCluster nsxtEdgeGatewayEdgeCluster `json:"primaryEdgeCluster,omitempty"`
+}
+
+// nsxtEdgeGatewayEdgeCluster holds a reference to NSX-T edge cluster
+type nsxtEdgeGatewayEdgeCluster struct {
+	// EdgeClusterRef is the reference to NSX-T edge cluster in VCD
+	EdgeClusterRef *openApiReference `json:"edgeClusterRef,omitempty"`
+	// BackingID is the NSX-T edge cluster ID
+	BackingID string `json:"backingId,omitempty"`
+}
+
+// openApiOrgVdcNetwork represents an Org VDC network in OpenAPI. Depending on NetworkType it can be routed (connected
+// to an edge gateway), isolated or imported (backed by an existing NSX-T logical switch)
+type openApiOrgVdcNetwork struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// OrgVdc holds the VDC to which this network belongs
+	OrgVdc *openApiReference `json:"orgVdc,omitempty"`
+	// OwnerRef holds the owner of network (usually the same VDC as OrgVdc)
+	OwnerRef *openApiReference `json:"ownerRef,omitempty"`
+	// OrgVdcIsNsxTBacked is set to true when the VDC of this network is backed by NSX-T
+	OrgVdcIsNsxTBacked bool `json:"orgVdcIsNsxTBacked,omitempty"`
+	// BackingNetworkId is the ID of backing entity (e.g. NSX-T logical switch ID for imported networks)
+	BackingNetworkId string `json:"backingNetworkId,omitempty"`
+	// BackingNetworkType is the type of backing entity (e.g. NSXT_FLEXIBLE_SEGMENT)
+	BackingNetworkType string `json:"backingNetworkType,omitempty"`
+	// ParentNetwork is only used for direct networks to reference an external network
+	ParentNetwork *openApiReference `json:"parentNetwork,omitempty"`
+	// NetworkType is one of NAT_ROUTED, ISOLATED, OPAQUE (imported), DIRECT
+	NetworkType string `json:"networkType"`
+	// Connection is only used for NAT_ROUTED networks and defines edge gateway connection
+	Connection *openApiOrgVdcNetworkConnection `json:"connection,omitempty"`
+	// Subnets contains IP configuration of the network
+	Subnets openApiOrgVdcNetworkSubnets `json:"subnets"`
+	// Shared defines if network is shared to other VDCs in the same Org
+	Shared       *bool  `json:"shared,omitempty"`
+	TotalIpCount *int   `json:"totalIpCount,omitempty"`
+	UsedIpCount  *int   `json:"usedIpCount,omitempty"`
+	Status       string `json:"status,omitempty"`
+}
+
+// openApiOrgVdcNetworkConnection defines an edge gateway connection of a routed Org VDC network
+type openApiOrgVdcNetworkConnection struct {
+	// RouterRef holds the reference to edge gateway
+	RouterRef openApiReference `json:"routerRef"`
+	// ConnectionType is one of INTERNAL, SUBINTERFACE, DISTRIBUTED. NSX-T edge gateways only support INTERNAL
+	ConnectionType string `json:"connectionType,omitempty"`
+}
+
+// openApiOrgVdcNetworkSubnets is a list of Org VDC network subnets
+type openApiOrgVdcNetworkSubnets struct {
+	Values []openApiOrgVdcNetworkSubnet `json:"values"`
+}
+
+// openApiOrgVdcNetworkSubnet defines a subnet of an Org VDC network
+type openApiOrgVdcNetworkSubnet struct {
+	Gateway      string `json:"gateway"`
+	PrefixLength int    `json:"prefixLength"`
+	DNSServer1   string `json:"dnsServer1"`
+	DNSServer2   string `json:"dnsServer2"`
+	DNSSuffix    string `json:"dnsSuffix"`
+	// IPRanges contain static IP pools of the network
+	IPRanges openApiIpRanges `json:"ipRanges"`
+}
+
+// nsxtImportableSwitch is an NSX-T logical switch (segment) which can be consumed by an imported Org VDC network
+type nsxtImportableSwitch struct {
+	// ID is the NSX-T ID of logical switch
+	ID string `json:"id"`
+	// Name is the display name of logical switch in NSX-T
+	Name string `json:"name"`
+}
+
+// nsxtFirewall contains all firewall rules of an NSX-T edge gateway. Only UserDefinedRules can be managed by tenants.
+type nsxtFirewall struct {
+	SystemRules      []*nsxtFirewallRule `json:"systemRules,omitempty"`
+	DefaultRules     []*nsxtFirewallRule `json:"defaultRules,omitempty"`
+	UserDefinedRules []*nsxtFirewallRule `json:"userDefinedRules"`
+}
+
+// nsxtFirewallRule defines a single NSX-T edge gateway firewall rule
+type nsxtFirewallRule struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Action is one of ALLOW, DROP, REJECT
+	Action  string `json:"action"`
+	Enabled bool   `json:"enabled"`
+	// SourceFirewallGroups and DestinationFirewallGroups reference firewall groups (security groups or IP sets).
+	// Empty list means 'any'.
+	SourceFirewallGroups      []openApiReference `json:"sourceFirewallGroups,omitempty"`
+	DestinationFirewallGroups []openApiReference `json:"destinationFirewallGroups,omitempty"`
+	// ApplicationPortProfiles reference application port profiles. Empty list means 'any'.
+	ApplicationPortProfiles []openApiReference `json:"applicationPortProfiles,omitempty"`
+	// IpProtocol is one of IPV4, IPV6, IPV4_IPV6
+	IpProtocol string `json:"ipProtocol"`
+	Logging    bool   `json:"logging"`
+	// Direction is one of IN, OUT, IN_OUT
+	Direction string `json:"direction"`
+}
+
+// nsxtNatRule defines a single NAT rule of NSX-T edge gateway
+type nsxtNatRule struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	// RuleType is one of DNAT, SNAT, NO_DNAT, NO_SNAT. It is deprecated in API 36.0 and replaced by Type
+	RuleType string `json:"ruleType,omitempty"`
+	// Type is one of DNAT, SNAT, NO_DNAT, NO_SNAT, REFLEXIVE. Available since API 36.0
+	Type string `json:"type,omitempty"`
+	// ExternalAddresses contains external IP address (or a range/CIDR) of the rule
+	ExternalAddresses string `json:"externalAddresses"`
+	// InternalAddresses contains internal IP address (or a range/CIDR) of the rule
+	InternalAddresses string `json:"internalAddresses"`
+	// ApplicationPortProfile defines ports and protocols to which the rule applies
+	ApplicationPortProfile *openApiReference `json:"applicationPortProfile,omitempty"`
+	// InternalPort is the port to which DNAT rule translates inbound traffic. It is superseded by
+	// ApplicationPortProfile in newer API versions
+	InternalPort string `json:"internalPort,omitempty"`
+	// DnatExternalPort is the external port of DNAT rule
+	DnatExternalPort string `json:"dnatExternalPort,omitempty"`
+	// SnatDestinationAddresses limits SNAT rule to specified destination addresses
+	SnatDestinationAddresses string `json:"snatDestinationAddresses,omitempty"`
+	Logging                  bool   `json:"logging"`
+	// FirewallMatch is one of MATCH_INTERNAL_ADDRESS, MATCH_EXTERNAL_ADDRESS, BYPASS. Available since API 35.2
+	FirewallMatch string `json:"firewallMatch,omitempty"`
+	// Priority defines the order of rule processing when address/port matches multiple rules. Lower value means
+	// higher priority. Available since API 35.2
+	Priority *int `json:"priority,omitempty"`
+}
+
+// nsxtIpSecVpnTunnel defines an IPsec VPN tunnel of NSX-T edge gateway
+type nsxtIpSecVpnTunnel struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	// LocalEndpoint is the endpoint on NSX-T edge gateway side
+	LocalEndpoint nsxtIpSecVpnTunnelLocalEndpoint `json:"localEndpoint"`
+	// RemoteEndpoint is the endpoint on peer side
+	RemoteEndpoint nsxtIpSecVpnTunnelRemoteEndpoint `json:"remoteEndpoint"`
+	// PreSharedKey is used for authentication when AuthenticationMode is PSK
+	PreSharedKey string `json:"preSharedKey"`
+	// SecurityType is DEFAULT or CUSTOM. It is read-only here and is set using connection properties endpoint
+	SecurityType string `json:"securityType,omitempty"`
+	Logging      bool   `json:"logging"`
+	// AuthenticationMode is PSK
+	AuthenticationMode string `json:"authenticationMode,omitempty"`
+}
+
+// nsxtIpSecVpnTunnelLocalEndpoint defines local endpoint of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelLocalEndpoint struct {
+	// LocalId is read-only and is equal to LocalAddress
+	LocalId      string `json:"localId,omitempty"`
+	LocalAddress string `json:"localAddress"`
+	// LocalNetworks contains subnets (in CIDR format) of local side
+	LocalNetworks []string `json:"localNetworks"`
+}
+
+// nsxtIpSecVpnTunnelRemoteEndpoint defines remote endpoint of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelRemoteEndpoint struct {
+	// RemoteId defaults to RemoteAddress when it is empty
+	RemoteId      string `json:"remoteId,omitempty"`
+	RemoteAddress string `json:"remoteAddress"`
+	// RemoteNetworks contains subnets (in CIDR format) of remote side. Empty list means 'any'
+	RemoteNetworks []string `json:"remoteNetworks,omitempty"`
+}
+
+// nsxtIpSecVpnTunnelSecurityProfile defines security profile (connection properties) of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelSecurityProfile struct {
+	// SecurityType is DEFAULT or CUSTOM. Configurations are only applied when it is CUSTOM
+	SecurityType        string                                        `json:"securityType"`
+	IkeConfiguration    *nsxtIpSecVpnTunnelProfileIkeConfiguration    `json:"ikeConfiguration,omitempty"`
+	TunnelConfiguration *nsxtIpSecVpnTunnelProfileTunnelConfiguration `json:"tunnelConfiguration,omitempty"`
+	DpdConfiguration    *nsxtIpSecVpnTunnelProfileDpdConfiguration    `json:"dpdConfiguration,omitempty"`
+}
+
+// nsxtIpSecVpnTunnelProfileIkeConfiguration defines IKE (phase 1) parameters of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelProfileIkeConfiguration struct {
+	// IkeVersion is one of IKE_V1, IKE_V2, IKE_FLEX
+	IkeVersion           string   `json:"ikeVersion"`
+	DhGroups             []string `json:"dhGroups"`
+	DigestAlgorithms     []string `json:"digestAlgorithms,omitempty"`
+	EncryptionAlgorithms []string `json:"encryptionAlgorithms"`
+	SaLifeTime           *int     `json:"saLifeTime,omitempty"`
+}
+
+// nsxtIpSecVpnTunnelProfileTunnelConfiguration defines tunnel (phase 2) parameters of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelProfileTunnelConfiguration struct {
+	PerfectForwardSecrecyEnabled bool     `json:"perfectForwardSecrecyEnabled"`
+	DhGroups                     []string `json:"dhGroups"`
+	EncryptionAlgorithms         []string `json:"encryptionAlgorithms"`
+	DigestAlgorithms             []string `json:"digestAlgorithms,omitempty"`
+	SaLifeTime                   *int     `json:"saLifeTime,omitempty"`
+}
+
+// nsxtIpSecVpnTunnelProfileDpdConfiguration defines Dead Peer Detection parameters of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelProfileDpdConfiguration struct {
+	// ProbeInterval is the interval in seconds between DPD probes
+	ProbeInterval int `json:"probeInterval"`
+}
+
+// nsxtIpSecVpnTunnelStatus contains runtime status of IPsec VPN tunnel
+type nsxtIpSecVpnTunnelStatus struct {
+	TunnelStatus struct {
+		// Status is one of UP, DOWN, UNKNOWN
+		Status string `json:"status"`
+		// Reason for DOWN status
+		Reason string `json:"reason,omitempty"`
+	} `json:"tunnelStatus"`
+	IkeStatus struct {
+		// IkeServiceStatus is one of UP, DOWN, NEGOTIATING
+		IkeServiceStatus string `json:"ikeServiceStatus"`
+		// FailReason contains the reason of IKE service failure
+		FailReason string `json:"failReason,omitempty"`
+	} `json:"ikeStatus"`
+}
+
+// nsxtFirewallGroup defines a firewall group (security group or IP set) of NSX-T edge gateway
+type nsxtFirewallGroup struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// EdgeGatewayRef is the edge gateway to which the firewall group belongs
+	EdgeGatewayRef *openApiReference `json:"edgeGatewayRef"`
+	// Members contains Org VDC network references for SECURITY_GROUP type
+	Members []openApiReference `json:"members,omitempty"`
+	// IpAddresses contains IP addresses, ranges or CIDRs for IP_SET type
+	IpAddresses []string `json:"ipAddresses,omitempty"`
+	// Type is one of SECURITY_GROUP, IP_SET
+	Type string `json:"type"`
+}
+
+// nsxtFirewallGroupMemberVm is a VM which is associated with a security group via Org VDC network membership
+type nsxtFirewallGroupMemberVm struct {
+	VmRef   *openApiReference `json:"vmRef"`
+	VappRef *openApiReference `json:"vappRef,omitempty"`
+}
+
+// nsxtAppPortProfile defines an application port profile which can be referenced in NSX-T firewall and NAT rules
+type nsxtAppPortProfile struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// ApplicationPorts contains one or more protocol and port definitions
+	ApplicationPorts []nsxtAppPortProfilePort `json:"applicationPorts"`
+	// OrgRef is required for TENANT scope
+	OrgRef *openApiReference `json:"orgRef,omitempty"`
+	// ContextEntityId is VDC ID for TENANT scope and NSX-T manager ID for PROVIDER scope
+	ContextEntityId string `json:"contextEntityId,omitempty"`
+	// Scope is one of SYSTEM, PROVIDER, TENANT. SYSTEM profiles are built-in and read-only
+	Scope string `json:"scope"`
+}
+
+// nsxtAppPortProfilePort is a single protocol and port definition of application port profile
+type nsxtAppPortProfilePort struct {
+	// Protocol is one of ICMPv4, ICMPv6, TCP, UDP
+	Protocol string `json:"protocol"`
+	// DestinationPorts contains single ports or port ranges (e.g. "80", "8080-8090"). Not used for ICMP
+	DestinationPorts []string `json:"destinationPorts,omitempty"`
+}
+
+// openApiOrgVdcNetworkDhcp defines DHCP service configuration of NSX-T backed Org VDC network
+type openApiOrgVdcNetworkDhcp struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// LeaseTime is the default lease time in seconds for all pools
+	LeaseTime *int                           `json:"leaseTime,omitempty"`
+	DhcpPools []openApiOrgVdcNetworkDhcpPool `json:"dhcpPools,omitempty"`
+	// DnsServers contains up to two DNS servers which are assigned to clients. Available since API 36.1
+	DnsServers []string `json:"dnsServers,omitempty"`
+}
+
+// openApiOrgVdcNetworkDhcpPool is a single DHCP pool of Org VDC network
+type openApiOrgVdcNetworkDhcpPool struct {
+	Enabled *bool          `json:"enabled,omitempty"`
+	IPRange openApiIpRange `json:"ipRange"`
+}
+
+// nsxtAlbController defines NSX-T Advanced Load Balancer (ALB) controller registration in VCD. Controllers are
+// managed by providers only.
+type nsxtAlbController struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Url of ALB controller (e.g. https://alb.example.com)
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	// Password is never returned by the API
+	Password string `json:"password,omitempty"`
+	// LicenseType is one of BASIC, ENTERPRISE
+	LicenseType string `json:"licenseType,omitempty"`
+	// Version is the read-only version of ALB controller
+	Version string `json:"version,omitempty"`
+}
+
+// nsxtAlbImportableCloud is an NSX-T cloud defined in ALB controller which can be imported into VCD
+type nsxtAlbImportableCloud struct {
+	ID              string `json:"id"`
+	DisplayName     string `json:"displayName"`
+	AlreadyImported bool   `json:"alreadyImported"`
+	// NetworkPoolRef is the VCD network pool which is backed by the same transport zone as the cloud
+	NetworkPoolRef    *openApiReference `json:"networkPoolRef,omitempty"`
+	TransportZoneName string            `json:"transportZoneName,omitempty"`
+}
+
+// nsxtAlbCloud defines NSX-T cloud imported from ALB controller
+type nsxtAlbCloud struct {
+	ID                       string              `json:"id,omitempty"`
+	Name                     string              `json:"name"`
+	Description              string              `json:"description,omitempty"`
+	LoadBalancerCloudBacking nsxtAlbCloudBacking `json:"loadBalancerCloudBacking"`
+	NetworkPoolRef           *openApiReference   `json:"networkPoolRef"`
+	// HealthStatus and DetailedHealthMessage are read-only
+	HealthStatus          string `json:"healthStatus,omitempty"`
+	DetailedHealthMessage string `json:"detailedHealthMessage,omitempty"`
+}
+
+// nsxtAlbCloudBacking references importable cloud in ALB controller
+type nsxtAlbCloudBacking struct {
+	// BackingId is the ID of importable cloud
+	BackingId string `json:"backingId"`
+	// BackingType is NSXALB_NSXT
+	BackingType               string            `json:"backingType,omitempty"`
+	LoadBalancerControllerRef *openApiReference `json:"loadBalancerControllerRef"`
+}
+
+// nsxtAlbImportableServiceEngineGroup is a service engine group defined in ALB controller which can be imported into
+// VCD
+type nsxtAlbImportableServiceEngineGroup struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	// HaMode is one of ELASTIC_N_PLUS_M_BUFFER, ELASTIC_ACTIVE_ACTIVE, LEGACY_ACTIVE_STANDBY
+	HaMode string `json:"haMode,omitempty"`
+}
+
+// nsxtAlbServiceEngineGroup defines service engine group imported from ALB controller
+type nsxtAlbServiceEngineGroup struct {
+	ID                        string                           `json:"id,omitempty"`
+	Name                      string                           `json:"name"`
+	Description               string                           `json:"description,omitempty"`
+	ServiceEngineGroupBacking nsxtAlbServiceEngineGroupBacking `json:"serviceEngineGroupBacking"`
+	// ReservationType is one of DEDICATED, SHARED
+	ReservationType string `json:"reservationType"`
+	// HaMode, MaxVirtualServices and NumDeployedVirtualServices are read-only
+	HaMode                     string `json:"haMode,omitempty"`
+	MaxVirtualServices         *int   `json:"maxVirtualServices,omitempty"`
+	NumDeployedVirtualServices *int   `json:"numDeployedVirtualServices,omitempty"`
+}
+
+// nsxtAlbServiceEngineGroupBacking references importable service engine group in ALB cloud
+type nsxtAlbServiceEngineGroupBacking struct {
+	// BackingId is the ID of importable service engine group
+	BackingId            string            `json:"backingId"`
+	LoadBalancerCloudRef *openApiReference `json:"loadBalancerCloudRef"`
+}
+
+// nsxtAlbEdgeGatewaySettings defines ALB configuration of NSX-T edge gateway
+type nsxtAlbEdgeGatewaySettings struct {
+	Enabled bool `json:"enabled"`
+	// ServiceNetworkDefinition is the CIDR of network used by service engines. VCD default is used when it is empty
+	ServiceNetworkDefinition string `json:"serviceNetworkDefinition,omitempty"`
+}
+
+// nsxtAlbServiceEngineGroupAssignment assigns service engine group to NSX-T edge gateway
+type nsxtAlbServiceEngineGroupAssignment struct {
+	ID                    string            `json:"id,omitempty"`
+	GatewayRef            *openApiReference `json:"gatewayRef"`
+	ServiceEngineGroupRef *openApiReference `json:"serviceEngineGroupRef"`
+	// MaxVirtualServices and MinVirtualServices are only used for SHARED service engine groups
+	MaxVirtualServices *int `json:"maxVirtualServices,omitempty"`
+	MinVirtualServices *int `json:"minVirtualServices,omitempty"`
+	// NumDeployedVirtualServices is read-only
+	NumDeployedVirtualServices *int `json:"numDeployedVirtualServices,omitempty"`
+}
+
+// nsxtAlbPool defines ALB pool of NSX-T edge gateway
+type nsxtAlbPool struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	// Algorithm is one of LEAST_CONNECTIONS, ROUND_ROBIN, CONSISTENT_HASH, FASTEST_RESPONSE, LEAST_LOAD,
+	// FEWEST_SERVERS, RANDOM, FEWEST_TASKS, CORE_AFFINITY
+	Algorithm string `json:"algorithm,omitempty"`
+	// DefaultPort is used for members which do not specify port
+	DefaultPort *int `json:"defaultPort,omitempty"`
+	// GracefulTimeoutPeriod is the time in minutes to wait before closing connections of disabled members. -1 means
+	// infinite and 0 means immediate
+	GracefulTimeoutPeriod *int                       `json:"gracefulTimeoutPeriod,omitempty"`
+	Members               []nsxtAlbPoolMember        `json:"members,omitempty"`
+	HealthMonitors        []nsxtAlbPoolHealthMonitor `json:"healthMonitors,omitempty"`
+	GatewayRef            openApiReference           `json:"gatewayRef"`
+	// Read-only summary fields
+	MemberCount        int    `json:"memberCount,omitempty"`
+	EnabledMemberCount int    `json:"enabledMemberCount,omitempty"`
+	UpMemberCount      int    `json:"upMemberCount,omitempty"`
+	HealthMessage      string `json:"healthMessage,omitempty"`
+}
+
+// nsxtAlbPoolMember is a single member of ALB pool
+type nsxtAlbPoolMember struct {
+	Enabled   bool   `json:"enabled"`
+	IpAddress string `json:"ipAddress"`
+	Port      int    `json:"port,omitempty"`
+	Ratio     *int   `json:"ratio,omitempty"`
+	// MarkedDownBy, HealthStatus and DetailedHealthMessage are read-only
+	MarkedDownBy          []string `json:"markedDownBy,omitempty"`
+	HealthStatus          string   `json:"healthStatus,omitempty"`
+	DetailedHealthMessage string   `json:"detailedHealthMessage,omitempty"`
+}
+
+// nsxtAlbPoolHealthMonitor is a health monitor used by ALB pool
+type nsxtAlbPoolHealthMonitor struct {
+	// Name is read-only and is assigned by the system
+	Name string `json:"name,omitempty"`
+	// Type is one of HTTP, HTTPS, TCP, UDP, PING
+	Type          string `json:"type"`
+	SystemDefined bool   `json:"systemDefined,omitempty"`
+}
+
+// nsxtAlbVirtualService defines ALB virtual service of NSX-T edge gateway
+type nsxtAlbVirtualService struct {
+	ID                    string                          `json:"id,omitempty"`
+	Name                  string                          `json:"name"`
+	Description           string                          `json:"description,omitempty"`
+	Enabled               *bool                           `json:"enabled,omitempty"`
+	ApplicationProfile    nsxtAlbVirtualServiceAppProfile `json:"applicationProfile"`
+	GatewayRef            openApiReference                `json:"gatewayRef"`
+	LoadBalancerPoolRef   openApiReference                `json:"loadBalancerPoolRef"`
+	ServiceEngineGroupRef openApiReference                `json:"serviceEngineGroupRef"`
+	// CertificateRef is required when SSL is enabled on any of the service ports
+	CertificateRef   *openApiReference           `json:"certificateRef,omitempty"`
+	ServicePorts     []nsxtAlbVirtualServicePort `json:"servicePorts"`
+	VirtualIpAddress string                      `json:"virtualIpAddress"`
+	// HealthStatus and HealthMessage are read-only
+	HealthStatus  string `json:"healthStatus,omitempty"`
+	HealthMessage string `json:"healthMessage,omitempty"`
+}
+
+// nsxtAlbVirtualServiceAppProfile defines application profile of ALB virtual service
+type nsxtAlbVirtualServiceAppProfile struct {
+	// Type is one of HTTP, HTTPS, L4, L4_TLS
+	Type          string `json:"type"`
+	SystemDefined bool   `json:"systemDefined"`
+}
+
+// nsxtAlbVirtualServicePort defines a port (or range) on which ALB virtual service listens
+type nsxtAlbVirtualServicePort struct {
+	PortStart     *int                            `json:"portStart"`
+	PortEnd       *int                            `json:"portEnd,omitempty"`
+	SslEnabled    *bool                           `json:"sslEnabled,omitempty"`
+	TcpUdpProfile *nsxtAlbVirtualServiceL4Profile `json:"tcpUdpProfile,omitempty"`
+}
+
+// nsxtAlbVirtualServiceL4Profile defines transport layer profile of ALB virtual service port
+type nsxtAlbVirtualServiceL4Profile struct {
+	// Type is one of TCP_PROXY, TCP_FAST_PATH, UDP_FAST_PATH
+	Type          string `json:"type"`
+	SystemDefined bool   `json:"systemDefined"`
+}
+
+// nsxtVdcGroup defines a group of Org VDCs which share networking and distributed firewall
+type nsxtVdcGroup struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	OrgId       string `json:"orgId"`
+	// ParticipatingOrgVdcs must contain all member VDCs (including the starting one) on every create and update
+	ParticipatingOrgVdcs []nsxtVdcGroupParticipatingVdc `json:"participatingOrgVdcs"`
+	LocalEgress          bool                           `json:"localEgress"`
+	NetworkPoolId        string                         `json:"networkPoolId,omitempty"`
+	// NetworkProviderType is NSX_T or NSX_V
+	NetworkProviderType string `json:"networkProviderType"`
+	// Type is LOCAL or UNIVERSAL. Only LOCAL groups are supported
+	Type string `json:"type"`
+	// DfwEnabled and Status are read-only. Distributed firewall is toggled using a separate endpoint
+	DfwEnabled bool   `json:"dfwEnabled,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+// nsxtVdcGroupParticipatingVdc is a member VDC of VDC group
+type nsxtVdcGroupParticipatingVdc struct {
+	VdcRef               openApiReference  `json:"vdcRef"`
+	OrgRef               openApiReference  `json:"orgRef"`
+	SiteRef              *openApiReference `json:"siteRef,omitempty"`
+	NetworkProviderScope string            `json:"networkProviderScope,omitempty"`
+	FaultDomainTag       string            `json:"faultDomainTag,omitempty"`
+	RemoteOrg            bool              `json:"remoteOrg"`
+	Status               string            `json:"status,omitempty"`
+}
+
+// nsxtVdcGroupCandidateVdc is a VDC which can participate in VDC group together with the starting VDC
+type nsxtVdcGroupCandidateVdc struct {
+	ID                   string           `json:"id"`
+	Name                 string           `json:"name"`
+	FaultDomainTag       string           `json:"faultDomainTag"`
+	NetworkProviderScope string           `json:"networkProviderScope"`
+	OrgRef               openApiReference `json:"orgRef"`
+	SiteRef              openApiReference `json:"siteRef"`
+}
+
+// nsxtDfwPolicies toggles distributed firewall of VDC group
+type nsxtDfwPolicies struct {
+	Enabled bool `json:"enabled"`
+}
+
+// nsxtDfwPolicy is a distributed firewall policy of VDC group. Rules are managed in the 'default' policy
+type nsxtDfwPolicy struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// nsxtDistributedFirewallRules holds an ordered list of distributed firewall rules of VDC group
+type nsxtDistributedFirewallRules struct {
+	Values []*nsxtFirewallRule `json:"values"`
+}
+
+// nsxtStaticRoute defines a static route of NSX-T edge gateway
+type nsxtStaticRoute struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// NetworkCidr is the destination network in CIDR format (e.g. 192.168.1.0/24)
+	NetworkCidr string               `json:"networkCidr"`
+	NextHops    []nsxtStaticRouteHop `json:"nextHops"`
+	// SystemOwned is read-only and marks routes created by VCD itself
+	SystemOwned *bool `json:"systemOwned,omitempty"`
+}
+
+// nsxtStaticRouteHop is a next hop of static route
+type nsxtStaticRouteHop struct {
+	IpAddress string `json:"ipAddress"`
+	// AdminDistance is used to choose which route to use when there are multiple routes for a specific network
+	AdminDistance int `json:"adminDistance"`
+}
+
+// nsxtBgpConfig defines BGP configuration of NSX-T edge gateway
+type nsxtBgpConfig struct {
+	Enabled bool `json:"enabled"`
+	// Ecmp enables equal cost multi-path routing
+	Ecmp            bool                    `json:"ecmp"`
+	LocalASNumber   string                  `json:"localASNumber,omitempty"`
+	GracefulRestart *nsxtBgpGracefulRestart `json:"gracefulRestart,omitempty"`
+	Version         *nsxtBgpConfigVersion   `json:"version,omitempty"`
+}
+
+// nsxtBgpGracefulRestart defines graceful restart configuration of BGP
+type nsxtBgpGracefulRestart struct {
+	// Mode is one of DISABLE, HELPER_ONLY, GRACEFUL_AND_HELPER
+	Mode            string `json:"mode"`
+	RestartTimer    int    `json:"restartTimer,omitempty"`
+	StaleRouteTimer int    `json:"staleRouteTimer,omitempty"`
+}
+
+// nsxtBgpConfigVersion is used for optimistic locking of BGP configuration. Current version must be sent on update.
+type nsxtBgpConfigVersion struct {
+	Version int `json:"version"`
+}
+
+// nsxtBgpNeighbor defines BGP neighbor of NSX-T edge gateway
+type nsxtBgpNeighbor struct {
+	ID               string `json:"id,omitempty"`
+	NeighborAddress  string `json:"neighborAddress"`
+	RemoteASNumber   string `json:"remoteASNumber"`
+	KeepAliveTimer   int    `json:"keepAliveTimer,omitempty"`
+	HoldDownTimer    int    `json:"holdDownTimer,omitempty"`
+	NeighborPassword string `json:"neighborPassword,omitempty"`
+	AllowASIn        bool   `json:"allowASIn"`
+	// GracefulRestartMode overrides BGP configuration graceful restart mode for this neighbor. One of DISABLE,
+	// HELPER_ONLY, GRACEFUL_AND_HELPER
+	GracefulRestartMode string `json:"gracefulRestartMode,omitempty"`
+	// IpAddressTypeFiltering is one of IPV4, IPV6, DISABLED
+	IpAddressTypeFiltering string              `json:"ipAddressTypeFiltering,omitempty"`
+	Bfd                    *nsxtBgpNeighborBfd `json:"bfd,omitempty"`
+}
+
+// nsxtBgpNeighborBfd defines Bidirectional Forwarding Detection configuration of BGP neighbor
+type nsxtBgpNeighborBfd struct {
+	Enabled bool `json:"enabled"`
+	// BfdInterval is time interval (in milliseconds) for sending heartbeat packets
+	BfdInterval int `json:"bfdInterval,omitempty"`
+	// DeclareDeadMultiple is number of missed heartbeats after which neighbor is declared down
+	DeclareDeadMultiple int `json:"declareDeadMultiple,omitempty"`
+}
+
+// nsxtRouteAdvertisement defines which subnets of NSX-T edge gateway are advertised to the Tier-0 gateway. It is only
+// effective when the edge gateway uses a dedicated Tier-0 gateway.
+type nsxtRouteAdvertisement struct {
+	Enable bool `json:"enable"`
+	// Subnets contains advertised subnets in CIDR format
+	Subnets []string `json:"subnets"`
+}
+
+// nsxtEdgeCluster is an NSX-T edge cluster which can be used for edge gateway deployment
+type nsxtEdgeCluster struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// NodeCount is the number of edge transport nodes in the edge cluster
+	NodeCount int `json:"nodeCount"`
+	// NodeType is the type of transport nodes (e.g. EDGE_NODE)
+	NodeType string `json:"nodeType"`
+	// DeploymentType is the deployment type of transport nodes (e.g. VIRTUAL_MACHINE, PHYSICAL_MACHINE)
+	DeploymentType string `json:"deploymentType"`
+}
+
+// nsxtTransportZone is an NSX-T transport zone which can be used to back a network pool
+type nsxtTransportZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Type is one of OVERLAY_BACKED, VLAN_BACKED
+	Type            string `json:"type"`
+	AlreadyImported bool   `json:"alreadyImported"`
+}
@@ -0,0 +1,267 @@
+package vcd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// This file contains thin wrappers around low level go-vcloud-director client functions for NSX-V API endpoints
+// (proxied by VCD under '/network/edges/' and '/network/services/') that do not have a dedicated implementation in the
+// SDK yet. Edge endpoint suffixes are relative to the edge gateway, while services endpoint suffixes are relative to
+// '/network/services'. All suffixes must have a leading '/'.
+
+const (
+	nsxvEndpointIpsecVpnConfig = "/ipsec/config"
+	nsxvEndpointRoutingGlobal  = "/routing/config/global"
+	nsxvEndpointRoutingStatic  = "/routing/config/static"
+	nsxvEndpointRoutingOspf    = "/routing/config/ospf"
+	nsxvEndpointRoutingBgp     = "/routing/config/bgp"
+	nsxvEndpointDhcpConfig     = "/dhcp/config"
+	nsxvEndpointDhcpBindings   = "/dhcp/config/bindings"
+	nsxvEndpointFirewallConfig = "/firewall/config"
+	nsxvEndpointLbAppProfiles  = "/loadbalancer/config/applicationprofiles"
+	nsxvEndpointLbStatistics   = "/loadbalancer/statistics"
+	nsxvEndpointL2VpnConfig    = "/l2vpn/config"
+	nsxvEndpointSyslogConfig   = "/syslog/config"
+	nsxvEndpointDnsConfig      = "/dns/config"
+)
+
+const (
+	nsxvEndpointSslVpnConfig          = "/sslvpn/config"
+	nsxvEndpointSslVpnServer          = "/sslvpn/config/server"
+	nsxvEndpointSslVpnIpPools         = "/sslvpn/config/client/networkextension/ippools"
+	nsxvEndpointSslVpnPrivateNetworks = "/sslvpn/config/client/networkextension/privatenetworks"
+	nsxvEndpointSslVpnInstallPackages = "/sslvpn/config/client/networkextension/installpackages"
+	nsxvEndpointSslVpnUsers           = "/sslvpn/config/auth/localserver/users"
+)
+
+const (
+	nsxvServicesMacSet           = "/macset"
+	nsxvServicesApplication      = "/application"
+	nsxvServicesApplicationGroup = "/applicationgroup"
+	nsxvServicesCertificate      = "/truststore/certificate"
+	nsxvServicesCrl              = "/truststore/crl"
+)
+
+// nsxvEdgeEndpointUrl builds NSX-V API proxy URL of the given edge gateway and appends 'suffix' to it
+func nsxvEdgeEndpointUrl(edge *govcd.EdgeGateway, suffix string) (string, error) {
+	if !edge.HasAdvancedNetworking() {
+		return "", fmt.Errorf("only advanced edge gateways support NSX-V API")
+	}
+
+	apiEndpoint, err := url.ParseRequestURI(edge.EdgeGateway.HREF)
+	if err != nil {
+		return "", fmt.Errorf("unable to process edge gateway URL: %s", err)
+	}
+
+	edgeId, err := nsxvEdgeId(edge)
+	if err != nil {
+		return "", err
+	}
+
+	return apiEndpoint.Scheme + "://" + apiEndpoint.Host + "/network/edges/" + edgeId + suffix, nil
+}
+
+// nsxvEdgeId returns edge gateway ID as it is used in NSX-V API proxy URLs (the UUID part of edge gateway URN)
+func nsxvEdgeId(edge *govcd.EdgeGateway) (string, error) {
+	edgeId := strings.Split(edge.EdgeGateway.ID, ":")
+	if len(edgeId) != 4 {
+		return "", fmt.Errorf("unable to find edge gateway id: %s", edge.EdgeGateway.ID)
+	}
+
+	return edgeId[3], nil
+}
+
+// nsxvEdgeServicesEndpointUrl builds NSX-V API proxy URL for edge gateway scoped services (e.g. truststore) and
+// appends 'suffix' to it. Unlike nsxvServicesEndpointUrl it takes host from edge gateway HREF
+func nsxvEdgeServicesEndpointUrl(edge *govcd.EdgeGateway, suffix string) (string, error) {
+	if !edge.HasAdvancedNetworking() {
+		return "", fmt.Errorf("only advanced edge gateways support NSX-V API")
+	}
+
+	apiEndpoint, err := url.ParseRequestURI(edge.EdgeGateway.HREF)
+	if err != nil {
+		return "", fmt.Errorf("unable to process edge gateway URL: %s", err)
+	}
+
+	return apiEndpoint.Scheme + "://" + apiEndpoint.Host + "/network/services" + suffix, nil
+}
+
+// nsxvServicesEndpointUrl builds NSX-V API proxy URL for VDC scoped services (grouping objects) and appends 'suffix'
+// to it
+func nsxvServicesEndpointUrl(vdc *govcd.Vdc, suffix string) (string, error) {
+	apiEndpoint, err := url.ParseRequestURI(vdc.Vdc.HREF)
+	if err != nil {
+		return "", fmt.Errorf("unable to process VDC URL: %s", err)
+	}
+
+	return apiEndpoint.Scheme + "://" + apiEndpoint.Host + "/network/services" + suffix, nil
+}
+
+// nsxvGetItem retrieves NSX-V configuration object of edge gateway and unmarshals it into 'outType'
+func (cli *VCDClient) nsxvGetItem(edge *govcd.EdgeGateway, suffix string, outType interface{}) error {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvGet(httpPath, outType)
+}
+
+// nsxvGetItemWithParams retrieves NSX-V configuration object of edge gateway passing 'params' as query parameters and
+// unmarshals it into 'outType'. Query parameters must not be appended to 'suffix' because the SDK replaces the query
+// string of request URL
+func (cli *VCDClient) nsxvGetItemWithParams(edge *govcd.EdgeGateway, suffix string, params map[string]string, outType interface{}) error {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return err
+	}
+
+	// Errors are decoded in the same way as in nsxvGet so that both helpers behave the same
+	resp, err := cli.Client.ExecuteParamRequestWithCustomError(httpPath, params, http.MethodGet, types.AnyXMLMime,
+		"unable to read NSX-V configuration: %s", nil, &types.Error{})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read NSX-V configuration response body: %s", err)
+	}
+
+	err = xml.Unmarshal(body, outType)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal NSX-V configuration: %s", err)
+	}
+
+	return nil
+}
+
+// nsxvPutItem replaces NSX-V configuration object of edge gateway with 'payload'
+func (cli *VCDClient) nsxvPutItem(edge *govcd.EdgeGateway, suffix string, payload interface{}) error {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvPut(httpPath, payload)
+}
+
+// nsxvPostItem creates NSX-V object in edge gateway and returns its ID which is taken from 'Location' header
+func (cli *VCDClient) nsxvPostItem(edge *govcd.EdgeGateway, suffix string, payload interface{}) (string, error) {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := cli.nsxvPost(httpPath, payload)
+	if err != nil {
+		return "", err
+	}
+
+	location := path.Clean(resp.Header.Get("Location"))
+	if location == "" || location == "." {
+		return "", fmt.Errorf("unable to get ID of created NSX-V object: empty 'Location' header")
+	}
+
+	return path.Base(location), nil
+}
+
+// nsxvDeleteItem removes NSX-V object or resets NSX-V configuration of edge gateway
+func (cli *VCDClient) nsxvDeleteItem(edge *govcd.EdgeGateway, suffix string) error {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvDelete(httpPath)
+}
+
+// nsxvEnableService enables or disables NSX-V edge gateway service (e.g. SSL VPN-Plus or L2VPN) by sending
+// 'enableService' query parameter to its configuration endpoint
+func (cli *VCDClient) nsxvEnableService(edge *govcd.EdgeGateway, suffix string, enable bool) error {
+	httpPath, err := nsxvEdgeEndpointUrl(edge, suffix)
+	if err != nil {
+		return err
+	}
+
+	_, err = cli.Client.ExecuteParamRequestWithCustomError(httpPath, map[string]string{"enableService": strconv.FormatBool(enable)},
+		http.MethodPost, types.AnyXMLMime, "error while toggling NSX-V service: %s", nil, &types.NSXError{})
+	return err
+}
+
+// nsxvServicesGetItem retrieves VDC scoped NSX-V service object and unmarshals it into 'outType'
+func (cli *VCDClient) nsxvServicesGetItem(vdc *govcd.Vdc, suffix string, outType interface{}) error {
+	httpPath, err := nsxvServicesEndpointUrl(vdc, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvGet(httpPath, outType)
+}
+
+// nsxvServicesPutItem replaces VDC scoped NSX-V service object with 'payload'. 'payload' may be nil for endpoints
+// which do not expect a body
+func (cli *VCDClient) nsxvServicesPutItem(vdc *govcd.Vdc, suffix string, payload interface{}) error {
+	httpPath, err := nsxvServicesEndpointUrl(vdc, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvPut(httpPath, payload)
+}
+
+// nsxvServicesPostItem creates VDC scoped NSX-V service object. The API does not return a reference to created object
+// therefore it must be looked up by name
+func (cli *VCDClient) nsxvServicesPostItem(vdc *govcd.Vdc, suffix string, payload interface{}) error {
+	httpPath, err := nsxvServicesEndpointUrl(vdc, suffix)
+	if err != nil {
+		return err
+	}
+
+	_, err = cli.nsxvPost(httpPath, payload)
+	return err
+}
+
+// nsxvServicesDeleteItem removes VDC scoped NSX-V service object
+func (cli *VCDClient) nsxvServicesDeleteItem(vdc *govcd.Vdc, suffix string) error {
+	httpPath, err := nsxvServicesEndpointUrl(vdc, suffix)
+	if err != nil {
+		return err
+	}
+
+	return cli.nsxvDelete(httpPath)
+}
+
+func (cli *VCDClient) nsxvGet(httpPath string, outType interface{}) error {
+	_, err := cli.Client.ExecuteRequest(httpPath, http.MethodGet, types.AnyXMLMime,
+		"unable to read NSX-V configuration: %s", nil, outType)
+	return err
+}
+
+func (cli *VCDClient) nsxvPut(httpPath string, payload interface{}) error {
+	_, err := cli.Client.ExecuteRequestWithCustomError(httpPath, http.MethodPut, types.AnyXMLMime,
+		"error while updating NSX-V configuration: %s", payload, &types.NSXError{})
+	return err
+}
+
+func (cli *VCDClient) nsxvPost(httpPath string, payload interface{}) (*http.Response, error) {
+	return cli.Client.ExecuteRequestWithCustomError(httpPath, http.MethodPost, types.AnyXMLMime,
+		"error while creating NSX-V object: %s", payload, &types.NSXError{})
+}
+
+func (cli *VCDClient) nsxvDelete(httpPath string) error {
+	_, err := cli.Client.ExecuteRequestWithCustomError(httpPath, http.MethodDelete, types.AnyXMLMime,
+		"error while removing NSX-V configuration: %s", nil, &types.NSXError{})
+	return err
+}
@@ -0,0 +1,68 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// getNsxvDhcpSettings retrieves DHCP service configuration of NSX-V edge gateway
+func getNsxvDhcpSettings(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvDhcpSettings, error) {
+	dhcpSettings := &nsxvDhcpSettings{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointDhcpConfig, dhcpSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return dhcpSettings, nil
+}
+
+// updateNsxvDhcpSettings replaces DHCP service configuration of NSX-V edge gateway. Static bindings and IP pools which
+// were retrieved with getNsxvDhcpSettings are sent back unchanged
+func updateNsxvDhcpSettings(vcdClient *VCDClient, edge *govcd.EdgeGateway, dhcpSettings *nsxvDhcpSettings) error {
+	// Omit the version as it is updated automatically with each put
+	dhcpSettings.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointDhcpConfig, dhcpSettings)
+}
+
+// getAllNsxvDhcpStaticBindings retrieves all DHCP static bindings of NSX-V edge gateway
+func getAllNsxvDhcpStaticBindings(vcdClient *VCDClient, edge *govcd.EdgeGateway) ([]*nsxvDhcpStaticBinding, error) {
+	bindingsConfig := &nsxvDhcpStaticBindingsConfig{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointDhcpConfig, bindingsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if bindingsConfig.StaticBindings == nil {
+		return nil, nil
+	}
+
+	return bindingsConfig.StaticBindings.Bindings, nil
+}
+
+// getNsxvDhcpStaticBindingById retrieves DHCP static binding by its ID (e.g. "binding-1"). It returns
+// govcd.ErrorEntityNotFound if the binding does not exist
+func getNsxvDhcpStaticBindingById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvDhcpStaticBinding, error) {
+	bindings, err := getAllNsxvDhcpStaticBindings(vcdClient, edge)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range bindings {
+		if binding.BindingId == id {
+			return binding, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: DHCP static binding with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvDhcpStaticBinding creates DHCP static binding and returns its ID
+func createNsxvDhcpStaticBinding(vcdClient *VCDClient, edge *govcd.EdgeGateway, binding *nsxvDhcpStaticBinding) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointDhcpBindings, binding)
+}
+
+// deleteNsxvDhcpStaticBinding removes DHCP static binding by its ID
+func deleteNsxvDhcpStaticBinding(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointDhcpBindings+"/"+id)
+}
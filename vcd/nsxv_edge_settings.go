@@ -0,0 +1,68 @@
+package vcd
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// nsxvDefaultDnsViewName is the name of DNS view which NSX-V creates for all clients
+const nsxvDefaultDnsViewName = "vsm-default-view"
+
+// getNsxvSyslog retrieves syslog configuration of NSX-V edge gateway
+func getNsxvSyslog(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvSyslog, error) {
+	syslog := &nsxvSyslog{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSyslogConfig, syslog)
+	if err != nil {
+		return nil, err
+	}
+
+	return syslog, nil
+}
+
+// updateNsxvSyslog replaces syslog configuration of NSX-V edge gateway
+func updateNsxvSyslog(vcdClient *VCDClient, edge *govcd.EdgeGateway, syslog *nsxvSyslog) error {
+	// Omit the version as it is updated automatically with each put
+	syslog.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSyslogConfig, syslog)
+}
+
+// getNsxvDns retrieves DNS service configuration of NSX-V edge gateway
+func getNsxvDns(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvDns, error) {
+	dns := &nsxvDns{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointDnsConfig, dns)
+	if err != nil {
+		return nil, err
+	}
+
+	return dns, nil
+}
+
+// updateNsxvDns replaces DNS service configuration of NSX-V edge gateway. Listeners and view matching rules which
+// were retrieved with getNsxvDns are sent back unchanged
+func updateNsxvDns(vcdClient *VCDClient, edge *govcd.EdgeGateway, dns *nsxvDns) error {
+	// Omit the version as it is updated automatically with each put
+	dns.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointDnsConfig, dns)
+}
+
+// getNsxvDnsDefaultView returns the DNS view which applies to all clients. NSX-V always creates it when DNS service is
+// configured, but it is created here when DNS service was never configured. The view is added to 'dns' if it was
+// missing
+func getNsxvDnsDefaultView(dns *nsxvDns) *nsxvDnsView {
+	if dns.DnsViews == nil {
+		dns.DnsViews = &nsxvDnsViews{}
+	}
+
+	for _, view := range dns.DnsViews.Views {
+		if view.Name == nsxvDefaultDnsViewName {
+			return view
+		}
+	}
+
+	view := &nsxvDnsView{
+		Name:      nsxvDefaultDnsViewName,
+		Enabled:   true,
+		ViewMatch: &nsxvInnerXml{Text: "<ipAddress>any</ipAddress><vnic>any</vnic>"},
+	}
+	dns.DnsViews.Views = append(dns.DnsViews.Views, view)
+	return view
+}
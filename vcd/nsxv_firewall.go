@@ -0,0 +1,36 @@
+package vcd
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getNsxvFirewallConfig retrieves complete firewall configuration of NSX-V edge gateway
+func getNsxvFirewallConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvFirewallConfig, error) {
+	firewallConfig := &nsxvFirewallConfig{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointFirewallConfig, firewallConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return firewallConfig, nil
+}
+
+// updateNsxvFirewallConfig replaces complete firewall configuration of NSX-V edge gateway. Rules which are not
+// user defined (e.g. 'internal_high', 'default_policy') are generated by NSX-V and must not be sent
+func updateNsxvFirewallConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway, firewallConfig *nsxvFirewallConfig) error {
+	// Omit the version as it is updated automatically with each put
+	firewallConfig.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointFirewallConfig, firewallConfig)
+}
+
+// getNsxvUserFirewallRules filters out rules generated by NSX-V and returns user defined rules in their order
+func getNsxvUserFirewallRules(firewallConfig *nsxvFirewallConfig) []*types.EdgeFirewallRule {
+	var userRules []*types.EdgeFirewallRule
+	for _, rule := range firewallConfig.FirewallRules.Rules {
+		if rule.RuleType == "" || rule.RuleType == "user" {
+			userRules = append(userRules, rule)
+		}
+	}
+	return userRules
+}
@@ -0,0 +1,339 @@
+package vcd
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// This file contains helpers for VDC scoped NSX-V grouping objects (MAC sets, custom services and service groups).
+// All of them are created under VDC scope ('/network/services/<type>/<vdc-uuid>') and addressed by their composite
+// IDs afterwards ('/network/services/<type>/<vdc-uuid>:<type>-<n>'). The API does not return a reference after
+// creation therefore newly created objects are looked up by name, which is unique within the scope.
+
+// getNsxvScopeId returns VDC UUID which is used as NSX-V grouping object scope
+func getNsxvScopeId(vdc *govcd.Vdc) (string, error) {
+	scopeId, err := govcd.GetUuidFromHref(vdc.Vdc.HREF, true)
+	if err != nil {
+		return "", fmt.Errorf("unable to get VDC ID from HREF: %s", err)
+	}
+	return scopeId, nil
+}
+
+// getAllNsxvMacSets retrieves all MAC sets in VDC scope
+func getAllNsxvMacSets(vcdClient *VCDClient, vdc *govcd.Vdc) ([]*nsxvMacSet, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	macSets := &struct {
+		XMLName xml.Name      `xml:"list"`
+		MacSets []*nsxvMacSet `xml:"macset"`
+	}{}
+	err = vcdClient.nsxvServicesGetItem(vdc, nsxvServicesMacSet+"/scope/"+scopeId, macSets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read MAC sets: %s", err)
+	}
+
+	return macSets.MacSets, nil
+}
+
+// getNsxvMacSetByName retrieves MAC set by name. It returns govcd.ErrorEntityNotFound if the MAC set does not exist
+func getNsxvMacSetByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*nsxvMacSet, error) {
+	macSets, err := getAllNsxvMacSets(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, macSet := range macSets {
+		if macSet.Name == name {
+			return macSet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: MAC set with name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// getNsxvMacSetById retrieves MAC set by ID. It returns govcd.ErrorEntityNotFound if the MAC set does not exist
+func getNsxvMacSetById(vcdClient *VCDClient, vdc *govcd.Vdc, id string) (*nsxvMacSet, error) {
+	macSets, err := getAllNsxvMacSets(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, macSet := range macSets {
+		if macSet.ObjectId == id {
+			return macSet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: MAC set with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvMacSet creates MAC set and returns it as it was stored in NSX-V
+func createNsxvMacSet(vcdClient *VCDClient, vdc *govcd.Vdc, macSet *nsxvMacSet) (*nsxvMacSet, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vcdClient.nsxvServicesPostItem(vdc, nsxvServicesMacSet+"/"+scopeId, macSet)
+	if err != nil {
+		return nil, fmt.Errorf("error creating MAC set: %s", err)
+	}
+
+	createdMacSet, err := getNsxvMacSetByName(vcdClient, vdc, macSet.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup newly created MAC set with name %s: %s", macSet.Name, err)
+	}
+
+	return createdMacSet, nil
+}
+
+// updateNsxvMacSet updates MAC set identified by macSet.ObjectId. The latest revision is fetched before update
+// because the API rejects changes based on older revisions
+func updateNsxvMacSet(vcdClient *VCDClient, vdc *govcd.Vdc, macSet *nsxvMacSet) error {
+	currentMacSet, err := getNsxvMacSetById(vcdClient, vdc, macSet.ObjectId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve current revision of MAC set: %s", err)
+	}
+	macSet.Revision = currentMacSet.Revision
+
+	return vcdClient.nsxvServicesPutItem(vdc, nsxvServicesMacSet+"/"+macSet.ObjectId, macSet)
+}
+
+// deleteNsxvMacSet removes MAC set by its ID
+func deleteNsxvMacSet(vcdClient *VCDClient, vdc *govcd.Vdc, id string) error {
+	return vcdClient.nsxvServicesDeleteItem(vdc, nsxvServicesMacSet+"/"+id)
+}
+
+// getAllNsxvApplications retrieves all custom services in VDC scope
+func getAllNsxvApplications(vcdClient *VCDClient, vdc *govcd.Vdc) ([]*nsxvApplication, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := &struct {
+		XMLName      xml.Name           `xml:"list"`
+		Applications []*nsxvApplication `xml:"application"`
+	}{}
+	err = vcdClient.nsxvServicesGetItem(vdc, nsxvServicesApplication+"/scope/"+scopeId, applications)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read services: %s", err)
+	}
+
+	return applications.Applications, nil
+}
+
+// getNsxvApplicationByName retrieves custom service by name. It returns govcd.ErrorEntityNotFound if the service does
+// not exist
+func getNsxvApplicationByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*nsxvApplication, error) {
+	applications, err := getAllNsxvApplications(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, application := range applications {
+		if application.Name == name {
+			return application, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: service with name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// getNsxvApplicationById retrieves custom service by ID. It returns govcd.ErrorEntityNotFound if the service does not
+// exist
+func getNsxvApplicationById(vcdClient *VCDClient, vdc *govcd.Vdc, id string) (*nsxvApplication, error) {
+	applications, err := getAllNsxvApplications(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, application := range applications {
+		if application.ObjectId == id {
+			return application, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: service with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvApplication creates custom service and returns it as it was stored in NSX-V
+func createNsxvApplication(vcdClient *VCDClient, vdc *govcd.Vdc, application *nsxvApplication) (*nsxvApplication, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vcdClient.nsxvServicesPostItem(vdc, nsxvServicesApplication+"/"+scopeId, application)
+	if err != nil {
+		return nil, fmt.Errorf("error creating service: %s", err)
+	}
+
+	createdApplication, err := getNsxvApplicationByName(vcdClient, vdc, application.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup newly created service with name %s: %s", application.Name, err)
+	}
+
+	return createdApplication, nil
+}
+
+// updateNsxvApplication updates custom service identified by application.ObjectId using its latest revision
+func updateNsxvApplication(vcdClient *VCDClient, vdc *govcd.Vdc, application *nsxvApplication) error {
+	currentApplication, err := getNsxvApplicationById(vcdClient, vdc, application.ObjectId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve current revision of service: %s", err)
+	}
+	application.Revision = currentApplication.Revision
+
+	return vcdClient.nsxvServicesPutItem(vdc, nsxvServicesApplication+"/"+application.ObjectId, application)
+}
+
+// deleteNsxvApplication removes custom service by its ID
+func deleteNsxvApplication(vcdClient *VCDClient, vdc *govcd.Vdc, id string) error {
+	return vcdClient.nsxvServicesDeleteItem(vdc, nsxvServicesApplication+"/"+id)
+}
+
+// getAllNsxvApplicationGroups retrieves all service groups in VDC scope
+func getAllNsxvApplicationGroups(vcdClient *VCDClient, vdc *govcd.Vdc) ([]*nsxvApplicationGroup, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGroups := &struct {
+		XMLName           xml.Name                `xml:"list"`
+		ApplicationGroups []*nsxvApplicationGroup `xml:"applicationGroup"`
+	}{}
+	err = vcdClient.nsxvServicesGetItem(vdc, nsxvServicesApplicationGroup+"/scope/"+scopeId, applicationGroups)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read service groups: %s", err)
+	}
+
+	return applicationGroups.ApplicationGroups, nil
+}
+
+// getNsxvApplicationGroupByName retrieves service group by name. It returns govcd.ErrorEntityNotFound if the service
+// group does not exist
+func getNsxvApplicationGroupByName(vcdClient *VCDClient, vdc *govcd.Vdc, name string) (*nsxvApplicationGroup, error) {
+	applicationGroups, err := getAllNsxvApplicationGroups(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, applicationGroup := range applicationGroups {
+		if applicationGroup.Name == name {
+			return applicationGroup, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: service group with name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// getNsxvApplicationGroupById retrieves service group by ID. It returns govcd.ErrorEntityNotFound if the service group
+// does not exist
+func getNsxvApplicationGroupById(vcdClient *VCDClient, vdc *govcd.Vdc, id string) (*nsxvApplicationGroup, error) {
+	applicationGroups, err := getAllNsxvApplicationGroups(vcdClient, vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, applicationGroup := range applicationGroups {
+		if applicationGroup.ObjectId == id {
+			return applicationGroup, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: service group with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvApplicationGroup creates an empty service group and then adds members one by one, because members are
+// managed by a separate endpoint
+func createNsxvApplicationGroup(vcdClient *VCDClient, vdc *govcd.Vdc, applicationGroup *nsxvApplicationGroup) (*nsxvApplicationGroup, error) {
+	scopeId, err := getNsxvScopeId(vdc)
+	if err != nil {
+		return nil, err
+	}
+
+	members := applicationGroup.Members
+	applicationGroup.Members = nil
+	err = vcdClient.nsxvServicesPostItem(vdc, nsxvServicesApplicationGroup+"/"+scopeId, applicationGroup)
+	if err != nil {
+		return nil, fmt.Errorf("error creating service group: %s", err)
+	}
+
+	createdApplicationGroup, err := getNsxvApplicationGroupByName(vcdClient, vdc, applicationGroup.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup newly created service group with name %s: %s",
+			applicationGroup.Name, err)
+	}
+
+	err = updateNsxvApplicationGroupMembers(vcdClient, vdc, createdApplicationGroup, members)
+	if err != nil {
+		return createdApplicationGroup, err
+	}
+
+	return createdApplicationGroup, nil
+}
+
+// updateNsxvApplicationGroup updates name and description of service group identified by applicationGroup.ObjectId
+// and then reconciles its members
+func updateNsxvApplicationGroup(vcdClient *VCDClient, vdc *govcd.Vdc, applicationGroup *nsxvApplicationGroup) error {
+	currentApplicationGroup, err := getNsxvApplicationGroupById(vcdClient, vdc, applicationGroup.ObjectId)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve current revision of service group: %s", err)
+	}
+
+	// Members are sent back as they are and changed separately
+	payload := *applicationGroup
+	payload.Revision = currentApplicationGroup.Revision
+	payload.Members = currentApplicationGroup.Members
+	err = vcdClient.nsxvServicesPutItem(vdc, nsxvServicesApplicationGroup+"/"+applicationGroup.ObjectId, &payload)
+	if err != nil {
+		return err
+	}
+
+	return updateNsxvApplicationGroupMembers(vcdClient, vdc, currentApplicationGroup, applicationGroup.Members)
+}
+
+// updateNsxvApplicationGroupMembers adds and removes members of service group so that they match 'members'
+func updateNsxvApplicationGroupMembers(vcdClient *VCDClient, vdc *govcd.Vdc, applicationGroup *nsxvApplicationGroup,
+	members []*nsxvGroupingMember) error {
+	membersSuffix := nsxvServicesApplicationGroup + "/" + applicationGroup.ObjectId + "/members/"
+
+	wanted := make(map[string]bool)
+	for _, member := range members {
+		wanted[member.ObjectId] = true
+	}
+
+	existing := make(map[string]bool)
+	for _, member := range applicationGroup.Members {
+		existing[member.ObjectId] = true
+		if !wanted[member.ObjectId] {
+			err := vcdClient.nsxvServicesDeleteItem(vdc, membersSuffix+member.ObjectId)
+			if err != nil {
+				return fmt.Errorf("error removing member %s from service group: %s", member.ObjectId, err)
+			}
+		}
+	}
+
+	for _, member := range members {
+		if !existing[member.ObjectId] {
+			err := vcdClient.nsxvServicesPutItem(vdc, membersSuffix+member.ObjectId, nil)
+			if err != nil {
+				return fmt.Errorf("error adding member %s to service group: %s", member.ObjectId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteNsxvApplicationGroup removes service group by its ID
+func deleteNsxvApplicationGroup(vcdClient *VCDClient, vdc *govcd.Vdc, id string) error {
+	return vcdClient.nsxvServicesDeleteItem(vdc, nsxvServicesApplicationGroup+"/"+id)
+}
@@ -0,0 +1,30 @@
+package vcd
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// getNsxvIpsecVpnConfig retrieves IPsec VPN configuration of NSX-V edge gateway. Pre-shared keys are retrieved in
+// plain text so that they can be compared with the configured ones.
+func getNsxvIpsecVpnConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvIpsecVpnConfig, error) {
+	ipsecConfig := &nsxvIpsecVpnConfig{}
+	err := vcdClient.nsxvGetItemWithParams(edge, nsxvEndpointIpsecVpnConfig, map[string]string{"showSensitiveData": "true"},
+		ipsecConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipsecConfig, nil
+}
+
+// updateNsxvIpsecVpnConfig replaces complete IPsec VPN configuration of NSX-V edge gateway
+func updateNsxvIpsecVpnConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway, ipsecConfig *nsxvIpsecVpnConfig) error {
+	// Omit the version as it is updated automatically with each put
+	ipsecConfig.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointIpsecVpnConfig, ipsecConfig)
+}
+
+// deleteNsxvIpsecVpnConfig removes all IPsec VPN sites and resets IPsec VPN configuration to defaults
+func deleteNsxvIpsecVpnConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointIpsecVpnConfig)
+}
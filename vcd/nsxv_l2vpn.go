@@ -0,0 +1,28 @@
+package vcd
+
+import (
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// getNsxvL2Vpn retrieves L2VPN configuration of NSX-V edge gateway
+func getNsxvL2Vpn(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvL2Vpn, error) {
+	l2Vpn := &nsxvL2Vpn{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointL2VpnConfig, l2Vpn)
+	if err != nil {
+		return nil, err
+	}
+
+	return l2Vpn, nil
+}
+
+// updateNsxvL2Vpn replaces L2VPN configuration of NSX-V edge gateway
+func updateNsxvL2Vpn(vcdClient *VCDClient, edge *govcd.EdgeGateway, l2Vpn *nsxvL2Vpn) error {
+	// Omit the version as it is updated automatically with each put
+	l2Vpn.Version = ""
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointL2VpnConfig, l2Vpn)
+}
+
+// deleteNsxvL2Vpn removes L2VPN configuration of NSX-V edge gateway
+func deleteNsxvL2Vpn(vcdClient *VCDClient, edge *govcd.EdgeGateway) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointL2VpnConfig)
+}
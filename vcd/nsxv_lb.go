@@ -0,0 +1,84 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// Load balancer application profiles are handled here instead of the SDK because types.LbAppProfile lacks client
+// and pool side SSL settings. Sending it back with an update would wipe certificate references.
+
+// getAllNsxvLbAppProfiles retrieves all load balancer application profiles of NSX-V edge gateway
+func getAllNsxvLbAppProfiles(vcdClient *VCDClient, edge *govcd.EdgeGateway) ([]*nsxvLbAppProfile, error) {
+	appProfiles := &nsxvLbAppProfiles{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointLbAppProfiles, appProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return appProfiles.AppProfiles, nil
+}
+
+// getNsxvLbAppProfileById retrieves load balancer application profile by its ID (e.g. "applicationProfile-1"). It
+// returns govcd.ErrorEntityNotFound if the profile does not exist
+func getNsxvLbAppProfileById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvLbAppProfile, error) {
+	appProfiles, err := getAllNsxvLbAppProfiles(vcdClient, edge)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, appProfile := range appProfiles {
+		if appProfile.ID == id {
+			return appProfile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: load balancer application profile with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// getNsxvLbAppProfileByName retrieves load balancer application profile by its name. It returns
+// govcd.ErrorEntityNotFound if the profile does not exist
+func getNsxvLbAppProfileByName(vcdClient *VCDClient, edge *govcd.EdgeGateway, name string) (*nsxvLbAppProfile, error) {
+	appProfiles, err := getAllNsxvLbAppProfiles(vcdClient, edge)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, appProfile := range appProfiles {
+		if appProfile.Name == name {
+			return appProfile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: load balancer application profile with name '%s'", govcd.ErrorEntityNotFound, name)
+}
+
+// createNsxvLbAppProfile creates load balancer application profile and returns its ID
+func createNsxvLbAppProfile(vcdClient *VCDClient, edge *govcd.EdgeGateway, appProfile *nsxvLbAppProfile) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointLbAppProfiles, appProfile)
+}
+
+// updateNsxvLbAppProfile replaces load balancer application profile with the ID set in 'appProfile'
+func updateNsxvLbAppProfile(vcdClient *VCDClient, edge *govcd.EdgeGateway, appProfile *nsxvLbAppProfile) error {
+	if appProfile.ID == "" {
+		return fmt.Errorf("load balancer application profile ID must be set for update")
+	}
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointLbAppProfiles+"/"+appProfile.ID, appProfile)
+}
+
+// deleteNsxvLbAppProfile removes load balancer application profile by its ID
+func deleteNsxvLbAppProfile(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointLbAppProfiles+"/"+id)
+}
+
+// getNsxvLbStatistics retrieves runtime status and statistics of load balancer virtual servers, pools and pool members
+func getNsxvLbStatistics(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvLbStatistics, error) {
+	statistics := &nsxvLbStatistics{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointLbStatistics, statistics)
+	if err != nil {
+		return nil, err
+	}
+
+	return statistics, nil
+}
@@ -0,0 +1,208 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// nsxvRedistributionRuleResource is shared by OSPF and BGP resources
+var nsxvRedistributionRuleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Action of redistribution rule. One of 'permit', 'deny'",
+			ValidateFunc: validation.StringInSlice([]string{"permit", "deny"}, false),
+		},
+		"prefix_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of IP prefix defined in edge gateway routing configuration. Rule matches all prefixes if not set",
+		},
+		"from_ospf": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Redistribute routes learned by OSPF. Default 'false'",
+		},
+		"from_bgp": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Redistribute routes learned by BGP. Default 'false'",
+		},
+		"from_static": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Redistribute static routes. Default 'false'",
+		},
+		"from_connected": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Redistribute connected networks. Default 'false'",
+		},
+	},
+}
+
+// getNsxvRoutingGlobalConfig retrieves global routing configuration (router ID, ECMP) of NSX-V edge gateway
+func getNsxvRoutingGlobalConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvRoutingGlobalConfig, error) {
+	globalConfig := &nsxvRoutingGlobalConfig{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointRoutingGlobal, globalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return globalConfig, nil
+}
+
+// updateNsxvRoutingGlobalConfig replaces global routing configuration of NSX-V edge gateway
+func updateNsxvRoutingGlobalConfig(vcdClient *VCDClient, edge *govcd.EdgeGateway, globalConfig *nsxvRoutingGlobalConfig) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointRoutingGlobal, globalConfig)
+}
+
+// getNsxvStaticRouting retrieves static routes and the default route of NSX-V edge gateway
+func getNsxvStaticRouting(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvStaticRouting, error) {
+	staticRouting := &nsxvStaticRouting{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointRoutingStatic, staticRouting)
+	if err != nil {
+		return nil, err
+	}
+
+	return staticRouting, nil
+}
+
+// updateNsxvStaticRouting replaces all static routes and the default route of NSX-V edge gateway
+func updateNsxvStaticRouting(vcdClient *VCDClient, edge *govcd.EdgeGateway, staticRouting *nsxvStaticRouting) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointRoutingStatic, staticRouting)
+}
+
+// getNsxvOspf retrieves OSPF configuration of NSX-V edge gateway
+func getNsxvOspf(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvOspf, error) {
+	ospfConfig := &nsxvOspf{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointRoutingOspf, ospfConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return ospfConfig, nil
+}
+
+// updateNsxvOspf replaces complete OSPF configuration of NSX-V edge gateway
+func updateNsxvOspf(vcdClient *VCDClient, edge *govcd.EdgeGateway, ospfConfig *nsxvOspf) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointRoutingOspf, ospfConfig)
+}
+
+// deleteNsxvOspf disables OSPF and removes all its areas, interfaces and redistribution rules
+func deleteNsxvOspf(vcdClient *VCDClient, edge *govcd.EdgeGateway) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointRoutingOspf)
+}
+
+// getNsxvBgp retrieves BGP configuration of NSX-V edge gateway
+func getNsxvBgp(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvBgp, error) {
+	bgpConfig := &nsxvBgp{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointRoutingBgp, bgpConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return bgpConfig, nil
+}
+
+// updateNsxvBgp replaces complete BGP configuration of NSX-V edge gateway
+func updateNsxvBgp(vcdClient *VCDClient, edge *govcd.EdgeGateway, bgpConfig *nsxvBgp) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointRoutingBgp, bgpConfig)
+}
+
+// deleteNsxvBgp disables BGP and removes all its neighbours and redistribution rules
+func deleteNsxvBgp(vcdClient *VCDClient, edge *govcd.EdgeGateway) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointRoutingBgp)
+}
+
+// getNsxvRedistributionType converts 'redistribution_enabled' and 'redistribution_rule' fields of OSPF or BGP resource
+// to *nsxvRedistribution
+func getNsxvRedistributionType(d *schema.ResourceData) *nsxvRedistribution {
+	redistribution := &nsxvRedistribution{
+		Enabled: d.Get("redistribution_enabled").(bool),
+		Rules:   &nsxvRedistributionRules{},
+	}
+
+	for _, ruleItem := range d.Get("redistribution_rule").([]interface{}) {
+		rule := ruleItem.(map[string]interface{})
+		redistribution.Rules.Rules = append(redistribution.Rules.Rules, &nsxvRedistributionRule{
+			PrefixName: rule["prefix_name"].(string),
+			Action:     rule["action"].(string),
+			From: nsxvRedistributionFrom{
+				Ospf:      rule["from_ospf"].(bool),
+				Bgp:       rule["from_bgp"].(bool),
+				Static:    rule["from_static"].(bool),
+				Connected: rule["from_connected"].(bool),
+			},
+		})
+	}
+
+	return redistribution
+}
+
+// setNsxvRedistributionData sets 'redistribution_enabled' and 'redistribution_rule' fields of OSPF or BGP resource
+func setNsxvRedistributionData(d *schema.ResourceData, redistribution *nsxvRedistribution) error {
+	var rules []interface{}
+	enabled := false
+	if redistribution != nil {
+		enabled = redistribution.Enabled
+		if redistribution.Rules != nil {
+			for _, rule := range redistribution.Rules.Rules {
+				rules = append(rules, map[string]interface{}{
+					"action":         rule.Action,
+					"prefix_name":    rule.PrefixName,
+					"from_ospf":      rule.From.Ospf,
+					"from_bgp":       rule.From.Bgp,
+					"from_static":    rule.From.Static,
+					"from_connected": rule.From.Connected,
+				})
+			}
+		}
+	}
+
+	_ = d.Set("redistribution_enabled", enabled)
+	err := d.Set("redistribution_rule", rules)
+	if err != nil {
+		return fmt.Errorf("error setting 'redistribution_rule': %s", err)
+	}
+
+	return nil
+}
+
+// getNsxvVnicIndexByNetworkName returns vNic index of edge gateway interface connected to the given network. A nil
+// index is returned when network name is empty
+func getNsxvVnicIndexByNetworkName(edge *govcd.EdgeGateway, networkName string) (*int, error) {
+	if networkName == "" {
+		return nil, nil
+	}
+
+	vNicIndex, _, err := edge.GetAnyVnicIndexByNetworkName(networkName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find edge gateway interface for network '%s': %s", networkName, err)
+	}
+
+	return vNicIndex, nil
+}
+
+// getNsxvNetworkNameByVnicIndex returns name of network attached to edge gateway vNic. An empty name is returned
+// when the index is nil
+func getNsxvNetworkNameByVnicIndex(edge *govcd.EdgeGateway, vNicIndex *int) (string, error) {
+	if vNicIndex == nil {
+		return "", nil
+	}
+
+	networkName, _, err := edge.GetNetworkNameAndTypeByVnicIndex(*vNicIndex)
+	if err != nil {
+		return "", fmt.Errorf("could not find network name for edge gateway vNic %d: %s", *vNicIndex, err)
+	}
+
+	return networkName, nil
+}
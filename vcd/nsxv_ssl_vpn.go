@@ -0,0 +1,171 @@
+package vcd
+
+import (
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// getNsxvSslVpnEnabled returns true if SSL VPN-Plus service is enabled in NSX-V edge gateway
+func getNsxvSslVpnEnabled(vcdClient *VCDClient, edge *govcd.EdgeGateway) (bool, error) {
+	sslVpnConfig := &nsxvSslVpnConfig{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnConfig, sslVpnConfig)
+	if err != nil {
+		return false, err
+	}
+
+	return sslVpnConfig.Enabled, nil
+}
+
+// enableNsxvSslVpn enables or disables SSL VPN-Plus service of NSX-V edge gateway
+func enableNsxvSslVpn(vcdClient *VCDClient, edge *govcd.EdgeGateway, enable bool) error {
+	return vcdClient.nsxvEnableService(edge, nsxvEndpointSslVpnConfig, enable)
+}
+
+// getNsxvSslVpnServerSettings retrieves SSL VPN-Plus server settings of NSX-V edge gateway
+func getNsxvSslVpnServerSettings(vcdClient *VCDClient, edge *govcd.EdgeGateway) (*nsxvSslVpnServerSettings, error) {
+	serverSettings := &nsxvSslVpnServerSettings{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnServer, serverSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return serverSettings, nil
+}
+
+// updateNsxvSslVpnServerSettings replaces SSL VPN-Plus server settings of NSX-V edge gateway
+func updateNsxvSslVpnServerSettings(vcdClient *VCDClient, edge *govcd.EdgeGateway, serverSettings *nsxvSslVpnServerSettings) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSslVpnServer, serverSettings)
+}
+
+// getNsxvSslVpnIpPoolById retrieves SSL VPN-Plus IP pool by its ID. It returns govcd.ErrorEntityNotFound if the pool
+// does not exist
+func getNsxvSslVpnIpPoolById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvSslVpnIpPool, error) {
+	ipPools := &nsxvSslVpnIpPools{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnIpPools, ipPools)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipPool := range ipPools.IpPools {
+		if ipPool.ObjectId == id {
+			return ipPool, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: SSL VPN-Plus IP pool with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvSslVpnIpPool creates SSL VPN-Plus IP pool and returns its ID
+func createNsxvSslVpnIpPool(vcdClient *VCDClient, edge *govcd.EdgeGateway, ipPool *nsxvSslVpnIpPool) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointSslVpnIpPools, ipPool)
+}
+
+// updateNsxvSslVpnIpPool replaces SSL VPN-Plus IP pool with the given ID
+func updateNsxvSslVpnIpPool(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string, ipPool *nsxvSslVpnIpPool) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSslVpnIpPools+"/"+id, ipPool)
+}
+
+// deleteNsxvSslVpnIpPool removes SSL VPN-Plus IP pool by its ID
+func deleteNsxvSslVpnIpPool(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointSslVpnIpPools+"/"+id)
+}
+
+// getNsxvSslVpnPrivateNetworkById retrieves SSL VPN-Plus private network by its ID. It returns
+// govcd.ErrorEntityNotFound if the private network does not exist
+func getNsxvSslVpnPrivateNetworkById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvSslVpnPrivateNetwork, error) {
+	privateNetworks := &nsxvSslVpnPrivateNetworks{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnPrivateNetworks, privateNetworks)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, privateNetwork := range privateNetworks.PrivateNetworks {
+		if privateNetwork.ObjectId == id {
+			return privateNetwork, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: SSL VPN-Plus private network with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvSslVpnPrivateNetwork creates SSL VPN-Plus private network and returns its ID
+func createNsxvSslVpnPrivateNetwork(vcdClient *VCDClient, edge *govcd.EdgeGateway, privateNetwork *nsxvSslVpnPrivateNetwork) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointSslVpnPrivateNetworks, privateNetwork)
+}
+
+// updateNsxvSslVpnPrivateNetwork replaces SSL VPN-Plus private network with the given ID
+func updateNsxvSslVpnPrivateNetwork(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string, privateNetwork *nsxvSslVpnPrivateNetwork) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSslVpnPrivateNetworks+"/"+id, privateNetwork)
+}
+
+// deleteNsxvSslVpnPrivateNetwork removes SSL VPN-Plus private network by its ID
+func deleteNsxvSslVpnPrivateNetwork(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointSslVpnPrivateNetworks+"/"+id)
+}
+
+// getNsxvSslVpnUserById retrieves SSL VPN-Plus local user by its object ID (not user name). It returns
+// govcd.ErrorEntityNotFound if the user does not exist
+func getNsxvSslVpnUserById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvSslVpnUser, error) {
+	users := &nsxvSslVpnUsers{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnUsers, users)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users.Users {
+		if user.ObjectId == id {
+			return user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: SSL VPN-Plus user with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvSslVpnUser creates SSL VPN-Plus local user and returns its object ID
+func createNsxvSslVpnUser(vcdClient *VCDClient, edge *govcd.EdgeGateway, user *nsxvSslVpnUser) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointSslVpnUsers, user)
+}
+
+// updateNsxvSslVpnUser replaces SSL VPN-Plus local user with the given object ID
+func updateNsxvSslVpnUser(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string, user *nsxvSslVpnUser) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSslVpnUsers+"/"+id, user)
+}
+
+// deleteNsxvSslVpnUser removes SSL VPN-Plus local user by its object ID
+func deleteNsxvSslVpnUser(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointSslVpnUsers+"/"+id)
+}
+
+// getNsxvSslVpnInstallPackageById retrieves SSL VPN-Plus client install package by its ID. It returns
+// govcd.ErrorEntityNotFound if the package does not exist
+func getNsxvSslVpnInstallPackageById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvSslVpnInstallPackage, error) {
+	installPackages := &nsxvSslVpnInstallPackages{}
+	err := vcdClient.nsxvGetItem(edge, nsxvEndpointSslVpnInstallPackages, installPackages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, installPackage := range installPackages.InstallPackages {
+		if installPackage.ObjectId == id {
+			return installPackage, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: SSL VPN-Plus install package with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// createNsxvSslVpnInstallPackage creates SSL VPN-Plus client install package and returns its ID
+func createNsxvSslVpnInstallPackage(vcdClient *VCDClient, edge *govcd.EdgeGateway, installPackage *nsxvSslVpnInstallPackage) (string, error) {
+	return vcdClient.nsxvPostItem(edge, nsxvEndpointSslVpnInstallPackages, installPackage)
+}
+
+// updateNsxvSslVpnInstallPackage replaces SSL VPN-Plus client install package with the given ID
+func updateNsxvSslVpnInstallPackage(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string, installPackage *nsxvSslVpnInstallPackage) error {
+	return vcdClient.nsxvPutItem(edge, nsxvEndpointSslVpnInstallPackages+"/"+id, installPackage)
+}
+
+// deleteNsxvSslVpnInstallPackage removes SSL VPN-Plus client install package by its ID
+func deleteNsxvSslVpnInstallPackage(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	return vcdClient.nsxvDeleteItem(edge, nsxvEndpointSslVpnInstallPackages+"/"+id)
+}
@@ -0,0 +1,138 @@
+package vcd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// NSX-V truststore keeps certificates and CRLs in the scope of a single edge gateway. Certificate IDs look like
+// "certificate-1" and CRL IDs look like "crl-1"
+
+// getAllNsxvCertificates retrieves all service and CA certificates in the truststore of NSX-V edge gateway
+func getAllNsxvCertificates(vcdClient *VCDClient, edge *govcd.EdgeGateway) ([]*nsxvCertificate, error) {
+	edgeId, err := nsxvEdgeId(edge)
+	if err != nil {
+		return nil, err
+	}
+
+	httpPath, err := nsxvEdgeServicesEndpointUrl(edge, nsxvServicesCertificate+"/scope/"+edgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := &nsxvCertificates{}
+	err = vcdClient.nsxvGet(httpPath, certificates)
+	if err != nil {
+		return nil, err
+	}
+
+	return certificates.Certificates, nil
+}
+
+// getNsxvCertificateById retrieves a certificate from the truststore of NSX-V edge gateway by its ID (e.g.
+// "certificate-1"). It returns govcd.ErrorEntityNotFound if the certificate does not exist
+func getNsxvCertificateById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvCertificate, error) {
+	certificates, err := getAllNsxvCertificates(vcdClient, edge)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, certificate := range certificates {
+		if certificate.ObjectId == id {
+			return certificate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: certificate with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// getAllNsxvCrls retrieves all CRLs in the truststore of NSX-V edge gateway
+func getAllNsxvCrls(vcdClient *VCDClient, edge *govcd.EdgeGateway) ([]*nsxvCrl, error) {
+	edgeId, err := nsxvEdgeId(edge)
+	if err != nil {
+		return nil, err
+	}
+
+	httpPath, err := nsxvEdgeServicesEndpointUrl(edge, nsxvServicesCrl+"/scope/"+edgeId)
+	if err != nil {
+		return nil, err
+	}
+
+	crls := &nsxvCrls{}
+	err = vcdClient.nsxvGet(httpPath, crls)
+	if err != nil {
+		return nil, err
+	}
+
+	return crls.Crls, nil
+}
+
+// getNsxvCrlById retrieves a CRL from the truststore of NSX-V edge gateway by its ID (e.g. "crl-1"). It returns
+// govcd.ErrorEntityNotFound if the CRL does not exist
+func getNsxvCrlById(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) (*nsxvCrl, error) {
+	crls, err := getAllNsxvCrls(vcdClient, edge)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, crl := range crls {
+		if crl.ObjectId == id {
+			return crl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: CRL with ID '%s'", govcd.ErrorEntityNotFound, id)
+}
+
+// uploadNsxvTrustObject uploads a certificate or CRL (depending on 'suffix' which is one of nsxvServicesCertificate
+// or nsxvServicesCrl) to the truststore of NSX-V edge gateway and returns its ID. When a certificate chain is
+// uploaded, the ID of the first certificate in the chain is returned
+func uploadNsxvTrustObject(vcdClient *VCDClient, edge *govcd.EdgeGateway, suffix string, trustObject *nsxvTrustObject) (string, error) {
+	edgeId, err := nsxvEdgeId(edge)
+	if err != nil {
+		return "", err
+	}
+
+	httpPath, err := nsxvEdgeServicesEndpointUrl(edge, suffix+"/"+edgeId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := vcdClient.nsxvPost(httpPath, trustObject)
+	if err != nil {
+		return "", err
+	}
+
+	uploaded := &nsxvTrustObjectResponse{}
+	err = xml.NewDecoder(resp.Body).Decode(uploaded)
+	if err != nil {
+		return "", fmt.Errorf("unable to process truststore upload response: %s", err)
+	}
+
+	switch {
+	case uploaded.ObjectId != "":
+		return uploaded.ObjectId, nil
+	case len(uploaded.Certificates) > 0 && uploaded.Certificates[0].ObjectId != "":
+		return uploaded.Certificates[0].ObjectId, nil
+	}
+
+	return "", fmt.Errorf("unable to get ID of uploaded truststore object")
+}
+
+// deleteNsxvTrustObject removes a certificate or CRL from the truststore. The endpoint is picked by ID prefix
+func deleteNsxvTrustObject(vcdClient *VCDClient, edge *govcd.EdgeGateway, id string) error {
+	suffix := nsxvServicesCertificate
+	if strings.HasPrefix(id, "crl-") {
+		suffix = nsxvServicesCrl
+	}
+
+	httpPath, err := nsxvEdgeServicesEndpointUrl(edge, suffix+"/"+id)
+	if err != nil {
+		return err
+	}
+
+	return vcdClient.nsxvDelete(httpPath)
+}
@@ -0,0 +1,726 @@
+package vcd
+
+import (
+	"encoding/xml"
+
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// This file contains types for NSX-V API endpoints which are not available in go-vcloud-director yet.
+
+// nsxvServiceLogging is a common logging configuration of NSX-V edge gateway services
+type nsxvServiceLogging struct {
+	Enable   bool   `xml:"enable"`
+	LogLevel string `xml:"logLevel,omitempty"`
+}
+
+// nsxvSubnets is a list of subnets in CIDR format
+type nsxvSubnets struct {
+	Subnets []string `xml:"subnet"`
+}
+
+// nsxvIpsecVpnConfig is the complete IPsec VPN configuration of NSX-V edge gateway
+type nsxvIpsecVpnConfig struct {
+	XMLName xml.Name `xml:"ipsec"`
+	// Version is updated with each change and must be omitted on update
+	Version string              `xml:"version,omitempty"`
+	Enabled bool                `xml:"enabled"`
+	Logging *nsxvServiceLogging `xml:"logging,omitempty"`
+	Global  *nsxvIpsecVpnGlobal `xml:"global,omitempty"`
+	Sites   *nsxvIpsecVpnSites  `xml:"sites,omitempty"`
+}
+
+// nsxvIpsecVpnGlobal contains settings which are shared by all IPsec VPN sites
+type nsxvIpsecVpnGlobal struct {
+	// Psk is used for sites which have 'any' as peer IP
+	Psk                string                   `xml:"psk,omitempty"`
+	ServiceCertificate string                   `xml:"serviceCertificate,omitempty"`
+	CaCertificates     *nsxvIpsecCaCertificates `xml:"caCertificates,omitempty"`
+	CrlCertificates    *nsxvIpsecCrlCertificate `xml:"crlCertificates,omitempty"`
+}
+
+// nsxvIpsecCaCertificates is a list of CA certificate IDs
+type nsxvIpsecCaCertificates struct {
+	CaCertificate []string `xml:"caCertificate"`
+}
+
+// nsxvIpsecCrlCertificate is a list of certificate revocation list IDs
+type nsxvIpsecCrlCertificate struct {
+	CrlCertificate []string `xml:"crlCertificate"`
+}
+
+// nsxvIpsecVpnSites is a list of IPsec VPN sites
+type nsxvIpsecVpnSites struct {
+	Sites []*nsxvIpsecVpnSite `xml:"site"`
+}
+
+// nsxvIpsecVpnSite defines a single IPsec VPN site (tunnel to peer)
+type nsxvIpsecVpnSite struct {
+	Enabled     bool   `xml:"enabled"`
+	Name        string `xml:"name"`
+	Description string `xml:"description,omitempty"`
+	LocalID     string `xml:"localId"`
+	LocalIP     string `xml:"localIp"`
+	PeerID      string `xml:"peerId"`
+	PeerIP      string `xml:"peerIp"`
+	// EncryptionAlgorithm is one of aes, aes256, aes-gcm, triple_des
+	EncryptionAlgorithm string `xml:"encryptionAlgorithm"`
+	EnablePfs           bool   `xml:"enablePfs"`
+	// DhGroup is one of dh2, dh5, dh14, dh15, dh16
+	DhGroup      string      `xml:"dhGroup"`
+	LocalSubnets nsxvSubnets `xml:"localSubnets"`
+	PeerSubnets  nsxvSubnets `xml:"peerSubnets"`
+	Psk          string      `xml:"psk,omitempty"`
+	// AuthenticationMode is one of psk, x.509
+	AuthenticationMode string `xml:"authenticationMode"`
+}
+
+// nsxvRoutingGlobalConfig is the global routing configuration of NSX-V edge gateway
+type nsxvRoutingGlobalConfig struct {
+	XMLName xml.Name `xml:"routingGlobalConfig"`
+	// RouterId is required for dynamic routing (OSPF, BGP)
+	RouterId string              `xml:"routerId,omitempty"`
+	Ecmp     bool                `xml:"ecmp"`
+	Logging  *nsxvServiceLogging `xml:"logging,omitempty"`
+	// IpPrefixes are kept as is because they are not managed by the provider
+	IpPrefixes *nsxvInnerXml `xml:"ipPrefixes,omitempty"`
+}
+
+// nsxvInnerXml keeps raw XML of a structure which is not managed, but must be sent back on update
+type nsxvInnerXml struct {
+	Text string `xml:",innerxml"`
+}
+
+// nsxvStaticRouting is the static routing configuration of NSX-V edge gateway
+type nsxvStaticRouting struct {
+	XMLName      xml.Name              `xml:"staticRouting"`
+	StaticRoutes *nsxvStaticRoutes     `xml:"staticRoutes,omitempty"`
+	DefaultRoute *nsxvStaticRouteEntry `xml:"defaultRoute,omitempty"`
+}
+
+// nsxvStaticRoutes is a list of static routes
+type nsxvStaticRoutes struct {
+	Routes []*nsxvStaticRouteEntry `xml:"route"`
+}
+
+// nsxvStaticRouteEntry is a single static route or the default route (which has only GatewayAddress set instead of
+// Network and NextHop)
+type nsxvStaticRouteEntry struct {
+	Description    string `xml:"description,omitempty"`
+	Vnic           *int   `xml:"vnic,omitempty"`
+	Network        string `xml:"network,omitempty"`
+	NextHop        string `xml:"nextHop,omitempty"`
+	GatewayAddress string `xml:"gatewayAddress,omitempty"`
+	Mtu            int    `xml:"mtu,omitempty"`
+	AdminDistance  int    `xml:"adminDistance,omitempty"`
+}
+
+// nsxvRedistribution defines which routes are redistributed by dynamic routing protocol
+type nsxvRedistribution struct {
+	Enabled bool                     `xml:"enabled"`
+	Rules   *nsxvRedistributionRules `xml:"rules,omitempty"`
+}
+
+// nsxvRedistributionRules is a list of redistribution rules
+type nsxvRedistributionRules struct {
+	Rules []*nsxvRedistributionRule `xml:"rule"`
+}
+
+// nsxvRedistributionRule is a single redistribution rule. Rules are evaluated in order
+type nsxvRedistributionRule struct {
+	ID         string                 `xml:"id,omitempty"`
+	PrefixName string                 `xml:"prefixName,omitempty"`
+	From       nsxvRedistributionFrom `xml:"from"`
+	// Action is one of permit, deny
+	Action string `xml:"action"`
+}
+
+// nsxvRedistributionFrom defines route sources for redistribution rule
+type nsxvRedistributionFrom struct {
+	Ospf      bool `xml:"ospf"`
+	Bgp       bool `xml:"bgp"`
+	Static    bool `xml:"static"`
+	Connected bool `xml:"connected"`
+}
+
+// nsxvOspf is the OSPF configuration of NSX-V edge gateway
+type nsxvOspf struct {
+	XMLName          xml.Name            `xml:"ospf"`
+	Enabled          bool                `xml:"enabled"`
+	OspfAreas        *nsxvOspfAreas      `xml:"ospfAreas,omitempty"`
+	OspfInterfaces   *nsxvOspfInterfaces `xml:"ospfInterfaces,omitempty"`
+	Redistribution   *nsxvRedistribution `xml:"redistribution,omitempty"`
+	GracefulRestart  bool                `xml:"gracefulRestart"`
+	DefaultOriginate bool                `xml:"defaultOriginate"`
+}
+
+// nsxvOspfAreas is a list of OSPF areas
+type nsxvOspfAreas struct {
+	Areas []*nsxvOspfArea `xml:"ospfArea"`
+}
+
+// nsxvOspfArea defines a single OSPF area
+type nsxvOspfArea struct {
+	AreaId string `xml:"areaId"`
+	// Type is one of normal, nssa
+	Type           string                  `xml:"type"`
+	Authentication *nsxvOspfAuthentication `xml:"authentication,omitempty"`
+}
+
+// nsxvOspfAuthentication defines authentication of OSPF area
+type nsxvOspfAuthentication struct {
+	// Type is one of none, password, md5
+	Type  string `xml:"type"`
+	Value string `xml:"value,omitempty"`
+}
+
+// nsxvOspfInterfaces is a list of OSPF interfaces
+type nsxvOspfInterfaces struct {
+	Interfaces []*nsxvOspfInterface `xml:"ospfInterface"`
+}
+
+// nsxvOspfInterface maps edge gateway interface to OSPF area
+type nsxvOspfInterface struct {
+	Vnic          int    `xml:"vnic"`
+	AreaId        string `xml:"areaId"`
+	HelloInterval int    `xml:"helloInterval,omitempty"`
+	DeadInterval  int    `xml:"deadInterval,omitempty"`
+	Priority      int    `xml:"priority,omitempty"`
+	Cost          int    `xml:"cost,omitempty"`
+	MtuIgnore     bool   `xml:"mtuIgnore"`
+}
+
+// nsxvBgp is the BGP configuration of NSX-V edge gateway
+type nsxvBgp struct {
+	XMLName xml.Name `xml:"bgp"`
+	Enabled bool     `xml:"enabled"`
+	// LocalAS is the legacy numeric field which is only read. LocalASNumber is always sent
+	LocalAS          string              `xml:"localAS,omitempty"`
+	LocalASNumber    string              `xml:"localASNumber,omitempty"`
+	BgpNeighbours    *nsxvBgpNeighbours  `xml:"bgpNeighbours,omitempty"`
+	Redistribution   *nsxvRedistribution `xml:"redistribution,omitempty"`
+	GracefulRestart  bool                `xml:"gracefulRestart"`
+	DefaultOriginate bool                `xml:"defaultOriginate"`
+}
+
+// nsxvBgpNeighbours is a list of BGP neighbours
+type nsxvBgpNeighbours struct {
+	Neighbours []*nsxvBgpNeighbour `xml:"bgpNeighbour"`
+}
+
+// nsxvBgpNeighbour defines a single BGP neighbour
+type nsxvBgpNeighbour struct {
+	IpAddress string `xml:"ipAddress"`
+	// RemoteAS is the legacy numeric field which is only read. RemoteASNumber is always sent
+	RemoteAS       string `xml:"remoteAS,omitempty"`
+	RemoteASNumber string `xml:"remoteASNumber,omitempty"`
+	Weight         int    `xml:"weight,omitempty"`
+	HoldDownTimer  int    `xml:"holdDownTimer,omitempty"`
+	KeepAliveTimer int    `xml:"keepAliveTimer,omitempty"`
+	Password       string `xml:"password,omitempty"`
+}
+
+// nsxvAnyElement keeps raw XML of an element which is not managed, but must be sent back on update
+type nsxvAnyElement struct {
+	XMLName xml.Name
+	Text    string `xml:",innerxml"`
+}
+
+// nsxvDhcpSettings is the DHCP service configuration of NSX-V edge gateway. Only service state and logging are
+// managed, while static bindings and IP pools are kept in Other and sent back as they are
+type nsxvDhcpSettings struct {
+	XMLName xml.Name            `xml:"dhcp"`
+	Version string              `xml:"version,omitempty"`
+	Enabled bool                `xml:"enabled"`
+	Logging *nsxvServiceLogging `xml:"logging,omitempty"`
+	Other   []*nsxvAnyElement   `xml:",any"`
+}
+
+// nsxvDhcpStaticBindingsConfig is used to read static bindings from DHCP service configuration of NSX-V edge gateway
+type nsxvDhcpStaticBindingsConfig struct {
+	XMLName        xml.Name                `xml:"dhcp"`
+	StaticBindings *nsxvDhcpStaticBindings `xml:"staticBindings,omitempty"`
+}
+
+// nsxvDhcpStaticBindings is a list of DHCP static bindings
+type nsxvDhcpStaticBindings struct {
+	Bindings []*nsxvDhcpStaticBinding `xml:"staticBinding"`
+}
+
+// nsxvDhcpStaticBinding assigns fixed IP address and DHCP options to a given MAC address
+type nsxvDhcpStaticBinding struct {
+	XMLName             xml.Name `xml:"staticBinding"`
+	BindingId           string   `xml:"bindingId,omitempty"`
+	MacAddress          string   `xml:"macAddress"`
+	Hostname            string   `xml:"hostname,omitempty"`
+	IpAddress           string   `xml:"ipAddress"`
+	SubnetMask          string   `xml:"subnetMask,omitempty"`
+	DefaultGateway      string   `xml:"defaultGateway,omitempty"`
+	DomainName          string   `xml:"domainName,omitempty"`
+	AutoConfigureDNS    bool     `xml:"autoConfigureDNS"`
+	PrimaryNameServer   string   `xml:"primaryNameServer,omitempty"`
+	SecondaryNameServer string   `xml:"secondaryNameServer,omitempty"`
+	// LeaseTime is either a number of seconds or "infinite"
+	LeaseTime string `xml:"leaseTime,omitempty"`
+}
+
+// nsxvMacSet is a VDC scoped grouping object holding a set of MAC addresses. It can be used as source or destination
+// in firewall rules
+type nsxvMacSet struct {
+	XMLName xml.Name `xml:"macset"`
+	// ObjectId is a composite ID formatted as 'f9daf2da-b4f9-4921-a2f4-d77a943a381c:macset-4' where the first segment
+	// is VDC UUID
+	ObjectId string `xml:"objectId,omitempty"`
+	// Revision must always be the latest one on update
+	Revision    *int   `xml:"revision,omitempty"`
+	Name        string `xml:"name"`
+	Description string `xml:"description,omitempty"`
+	// Value holds comma separated MAC addresses
+	Value              string `xml:"value"`
+	InheritanceAllowed *bool  `xml:"inheritanceAllowed"`
+}
+
+// nsxvApplication is a VDC scoped custom service (protocol and ports) which can be used in firewall rules
+type nsxvApplication struct {
+	XMLName xml.Name `xml:"application"`
+	// ObjectId is a composite ID formatted as 'f9daf2da-b4f9-4921-a2f4-d77a943a381c:application-4' where the first
+	// segment is VDC UUID
+	ObjectId           string                  `xml:"objectId,omitempty"`
+	Revision           *int                    `xml:"revision,omitempty"`
+	Name               string                  `xml:"name"`
+	Description        string                  `xml:"description,omitempty"`
+	InheritanceAllowed *bool                   `xml:"inheritanceAllowed"`
+	Element            *nsxvApplicationElement `xml:"element"`
+}
+
+// nsxvApplicationElement defines protocol and ports of a custom service
+type nsxvApplicationElement struct {
+	ApplicationProtocol string `xml:"applicationProtocol"`
+	// Value holds destination ports (e.g. "80,443,8000-8080") for TCP and UDP or ICMP type for ICMP
+	Value      string `xml:"value,omitempty"`
+	SourcePort string `xml:"sourcePort,omitempty"`
+}
+
+// nsxvApplicationGroup is a VDC scoped service group combining custom services and other service groups
+type nsxvApplicationGroup struct {
+	XMLName xml.Name `xml:"applicationGroup"`
+	// ObjectId is a composite ID formatted as 'f9daf2da-b4f9-4921-a2f4-d77a943a381c:applicationgroup-4' where the
+	// first segment is VDC UUID
+	ObjectId           string                `xml:"objectId,omitempty"`
+	Revision           *int                  `xml:"revision,omitempty"`
+	Name               string                `xml:"name"`
+	Description        string                `xml:"description,omitempty"`
+	InheritanceAllowed *bool                 `xml:"inheritanceAllowed"`
+	Members            []*nsxvGroupingMember `xml:"member,omitempty"`
+}
+
+// nsxvGroupingMember is a reference to a member of a grouping object. Only ObjectId is sent, while the other fields
+// are read only
+type nsxvGroupingMember struct {
+	ObjectId       string `xml:"objectId"`
+	Name           string `xml:"name,omitempty"`
+	ObjectTypeName string `xml:"objectTypeName,omitempty"`
+}
+
+// nsxvFirewallConfig is the complete firewall configuration of NSX-V edge gateway. Only user defined rules are
+// managed, while default policy and global configuration are sent back as they were read
+type nsxvFirewallConfig struct {
+	XMLName       xml.Name          `xml:"firewall"`
+	Version       string            `xml:"version,omitempty"`
+	Enabled       bool              `xml:"enabled"`
+	GlobalConfig  *nsxvInnerXml     `xml:"globalConfig,omitempty"`
+	DefaultPolicy *nsxvInnerXml     `xml:"defaultPolicy,omitempty"`
+	FirewallRules nsxvFirewallRules `xml:"firewallRules"`
+}
+
+// nsxvFirewallRules is an ordered list of firewall rules. The order defines rule priority
+type nsxvFirewallRules struct {
+	Rules []*types.EdgeFirewallRule `xml:"firewallRule"`
+}
+
+// nsxvTrustObject is the payload for uploading a certificate, CA certificate or CRL to the truststore of NSX-V edge
+// gateway. Private key and passphrase are only used for service certificates
+type nsxvTrustObject struct {
+	XMLName     xml.Name `xml:"trustObject"`
+	Description string   `xml:"description,omitempty"`
+	PemEncoding string   `xml:"pemEncoding"`
+	PrivateKey  string   `xml:"privateKey,omitempty"`
+	Passphrase  string   `xml:"passphrase,omitempty"`
+}
+
+// nsxvTrustObjectResponse is the response of truststore upload. Certificate upload returns a list of certificates
+// (one for each certificate in the chain), while CRL upload returns the CRL itself
+type nsxvTrustObjectResponse struct {
+	ObjectId     string             `xml:"objectId"`
+	Certificates []*nsxvCertificate `xml:"certificate"`
+}
+
+// nsxvCertificates is a list of certificates in the truststore of NSX-V edge gateway
+type nsxvCertificates struct {
+	Certificates []*nsxvCertificate `xml:"certificate"`
+}
+
+// nsxvCertificate is a service or CA certificate in the truststore of NSX-V edge gateway
+type nsxvCertificate struct {
+	ObjectId        string               `xml:"objectId"`
+	Name            string               `xml:"name,omitempty"`
+	Description     string               `xml:"description,omitempty"`
+	PemEncoding     string               `xml:"pemEncoding,omitempty"`
+	CertificateType string               `xml:"certificateType,omitempty"`
+	X509Certificate *nsxvX509Certificate `xml:"x509Certificate,omitempty"`
+}
+
+// nsxvX509Certificate holds read only details of a certificate in the truststore
+type nsxvX509Certificate struct {
+	SubjectCn    string `xml:"subjectCn,omitempty"`
+	IssuerCn     string `xml:"issuerCn,omitempty"`
+	SerialNumber string `xml:"serialNumber,omitempty"`
+}
+
+// nsxvCrls is a list of CRLs in the truststore of NSX-V edge gateway
+type nsxvCrls struct {
+	Crls []*nsxvCrl `xml:"crl"`
+}
+
+// nsxvCrl is a certificate revocation list in the truststore of NSX-V edge gateway
+type nsxvCrl struct {
+	ObjectId    string `xml:"objectId"`
+	Name        string `xml:"name,omitempty"`
+	Description string `xml:"description,omitempty"`
+	PemEncoding string `xml:"pemEncoding,omitempty"`
+	IssuerCn    string `xml:"x509Crl>issuerCn,omitempty"`
+}
+
+// nsxvLbAppProfile is a load balancer application profile. It mirrors types.LbAppProfile and adds client side
+// (virtual server) and pool side SSL settings which are not available in the SDK
+type nsxvLbAppProfile struct {
+	XMLName                       xml.Name                        `xml:"applicationProfile"`
+	ID                            string                          `xml:"applicationProfileId,omitempty"`
+	Name                          string                          `xml:"name,omitempty"`
+	SslPassthrough                bool                            `xml:"sslPassthrough"`
+	Template                      string                          `xml:"template,omitempty"`
+	HttpRedirect                  *types.LbAppProfileHttpRedirect `xml:"httpRedirect,omitempty"`
+	Persistence                   *types.LbAppProfilePersistence  `xml:"persistence,omitempty"`
+	InsertXForwardedForHttpHeader bool                            `xml:"insertXForwardedFor"`
+	ServerSslEnabled              bool                            `xml:"serverSslEnabled"`
+	ClientSsl                     *nsxvLbClientSsl                `xml:"clientSsl,omitempty"`
+	ServerSsl                     *nsxvLbServerSsl                `xml:"serverSsl,omitempty"`
+}
+
+// nsxvLbAppProfiles is a list of load balancer application profiles
+type nsxvLbAppProfiles struct {
+	AppProfiles []*nsxvLbAppProfile `xml:"applicationProfile"`
+}
+
+// nsxvLbClientSsl defines certificates used to terminate SSL between clients and the load balancer
+type nsxvLbClientSsl struct {
+	ClientAuth         string   `xml:"clientAuth,omitempty"`
+	ServiceCertificate string   `xml:"serviceCertificate,omitempty"`
+	CaCertificates     []string `xml:"caCertificate,omitempty"`
+	CrlCertificates    []string `xml:"crlCertificate,omitempty"`
+}
+
+// nsxvLbServerSsl defines certificates used to authenticate the load balancer on the pool (server) side
+type nsxvLbServerSsl struct {
+	ServerAuth         string   `xml:"serverAuth,omitempty"`
+	ServiceCertificate string   `xml:"serviceCertificate,omitempty"`
+	CaCertificates     []string `xml:"caCertificate,omitempty"`
+	CrlCertificates    []string `xml:"crlCertificate,omitempty"`
+}
+
+// nsxvSslVpnConfig is the top level SSL VPN-Plus configuration of NSX-V edge gateway. Only service state is read
+// from it, while the actual settings are managed through dedicated endpoints
+type nsxvSslVpnConfig struct {
+	Enabled bool `xml:"enabled"`
+}
+
+// nsxvSslVpnServerSettings defines addresses, port, certificate and ciphers of SSL VPN-Plus server
+type nsxvSslVpnServerSettings struct {
+	XMLName           xml.Name              `xml:"serverSettings"`
+	ServerAddresses   *nsxvIpAddresses      `xml:"serverAddresses"`
+	Port              int                   `xml:"port"`
+	ServerCertificate string                `xml:"serverCertificate,omitempty"`
+	CipherList        *nsxvSslVpnCipherList `xml:"cipherList,omitempty"`
+}
+
+// nsxvIpAddresses is a list of IP addresses
+type nsxvIpAddresses struct {
+	IpAddresses []string `xml:"ipAddress"`
+}
+
+// nsxvSslVpnCipherList is a list of encryption ciphers allowed by SSL VPN-Plus server
+type nsxvSslVpnCipherList struct {
+	Ciphers []string `xml:"cipher"`
+}
+
+// nsxvSslVpnIpPools is a list of SSL VPN-Plus IP pools
+type nsxvSslVpnIpPools struct {
+	IpPools []*nsxvSslVpnIpPool `xml:"ipAddressPool"`
+}
+
+// nsxvSslVpnIpPool is a range of IP addresses assigned to remote SSL VPN-Plus clients
+type nsxvSslVpnIpPool struct {
+	XMLName      xml.Name `xml:"ipAddressPool"`
+	ObjectId     string   `xml:"objectId,omitempty"`
+	Description  string   `xml:"description,omitempty"`
+	IpRange      string   `xml:"ipRange"`
+	Netmask      string   `xml:"netmask"`
+	Gateway      string   `xml:"gateway"`
+	PrimaryDns   string   `xml:"primaryDns,omitempty"`
+	SecondaryDns string   `xml:"secondaryDns,omitempty"`
+	DnsSuffix    string   `xml:"dnsSuffix,omitempty"`
+	WinsServer   string   `xml:"winsServer,omitempty"`
+	Enabled      bool     `xml:"enabled"`
+}
+
+// nsxvSslVpnPrivateNetworks is a list of SSL VPN-Plus private networks
+type nsxvSslVpnPrivateNetworks struct {
+	PrivateNetworks []*nsxvSslVpnPrivateNetwork `xml:"privateNetwork"`
+}
+
+// nsxvSslVpnPrivateNetwork is a network which remote SSL VPN-Plus clients can access. Traffic bypasses the tunnel when
+// SendOverTunnel is not set
+type nsxvSslVpnPrivateNetwork struct {
+	XMLName        xml.Name                  `xml:"privateNetwork"`
+	ObjectId       string                    `xml:"objectId,omitempty"`
+	Description    string                    `xml:"description,omitempty"`
+	Network        string                    `xml:"network"`
+	SendOverTunnel *nsxvSslVpnSendOverTunnel `xml:"sendOverTunnel,omitempty"`
+	Enabled        bool                      `xml:"enabled"`
+}
+
+// nsxvSslVpnSendOverTunnel defines ports and TCP optimization for traffic sent over SSL VPN-Plus tunnel
+type nsxvSslVpnSendOverTunnel struct {
+	Ports    string `xml:"ports,omitempty"`
+	Optimize bool   `xml:"optimize"`
+}
+
+// nsxvSslVpnUsers is a list of SSL VPN-Plus local users
+type nsxvSslVpnUsers struct {
+	Users []*nsxvSslVpnUser `xml:"user"`
+}
+
+// nsxvSslVpnUser is a user of SSL VPN-Plus local authentication server. Password is never returned by the API
+type nsxvSslVpnUser struct {
+	XMLName              xml.Name                       `xml:"user"`
+	ObjectId             string                         `xml:"objectId,omitempty"`
+	UserId               string                         `xml:"userId"`
+	Password             string                         `xml:"password,omitempty"`
+	FirstName            string                         `xml:"firstName,omitempty"`
+	LastName             string                         `xml:"lastName,omitempty"`
+	Description          string                         `xml:"description,omitempty"`
+	DisableUserAccount   bool                           `xml:"disableUserAccount"`
+	PasswordNeverExpires bool                           `xml:"passwordNeverExpires"`
+	AllowChangePassword  *nsxvSslVpnAllowChangePassword `xml:"allowChangePassword,omitempty"`
+}
+
+// nsxvSslVpnAllowChangePassword allows SSL VPN-Plus user to change password
+type nsxvSslVpnAllowChangePassword struct {
+	ChangePasswordOnNextLogin bool `xml:"changePasswordOnNextLogin"`
+}
+
+// nsxvSslVpnInstallPackages is a list of SSL VPN-Plus client install packages
+type nsxvSslVpnInstallPackages struct {
+	InstallPackages []*nsxvSslVpnInstallPackage `xml:"clientInstallPackage"`
+}
+
+// nsxvSslVpnInstallPackage is a client installation package which remote users download from SSL VPN-Plus portal
+type nsxvSslVpnInstallPackage struct {
+	XMLName                             xml.Name               `xml:"clientInstallPackage"`
+	ObjectId                            string                 `xml:"objectId,omitempty"`
+	ProfileName                         string                 `xml:"profileName"`
+	GatewayList                         *nsxvSslVpnGatewayList `xml:"gatewayList"`
+	StartClientOnLogon                  bool                   `xml:"startClientOnLogon"`
+	HideSystrayIcon                     bool                   `xml:"hideSystrayIcon"`
+	RememberPassword                    bool                   `xml:"rememberPassword"`
+	SilentModeOperation                 bool                   `xml:"silentModeOperation"`
+	SilentModeInstallation              bool                   `xml:"silentModeInstallation"`
+	HideNetworkAdaptor                  bool                   `xml:"hideNetworkAdaptor"`
+	CreateDesktopIcon                   bool                   `xml:"createDesktopIcon"`
+	EnforceServerSecurityCertValidation bool                   `xml:"enforceServerSecurityCertValidation"`
+	CreateLinuxClient                   bool                   `xml:"createLinuxClient"`
+	CreateMacClient                     bool                   `xml:"createMacClient"`
+	Description                         string                 `xml:"description,omitempty"`
+	Enabled                             bool                   `xml:"enabled"`
+}
+
+// nsxvSslVpnGatewayList is a list of SSL VPN-Plus gateways to which the installed client connects
+type nsxvSslVpnGatewayList struct {
+	Gateways []*nsxvSslVpnGateway `xml:"gateway"`
+}
+
+// nsxvSslVpnGateway is a host name or IP address and port of SSL VPN-Plus gateway
+type nsxvSslVpnGateway struct {
+	Hostname string `xml:"hostName"`
+	Port     int    `xml:"port,omitempty"`
+}
+
+// nsxvL2Vpn is the complete L2VPN configuration of NSX-V edge gateway. An edge gateway acts either as L2VPN server
+// with multiple peer sites or as L2VPN client connecting to a single server
+type nsxvL2Vpn struct {
+	XMLName    xml.Name            `xml:"l2Vpn"`
+	Version    string              `xml:"version,omitempty"`
+	Enabled    bool                `xml:"enabled"`
+	Logging    *nsxvServiceLogging `xml:"logging,omitempty"`
+	L2VpnSites nsxvL2VpnSites      `xml:"l2VpnSites"`
+}
+
+// nsxvL2VpnSites holds L2VPN site configuration. Only one site is supported
+type nsxvL2VpnSites struct {
+	Sites []*nsxvL2VpnSite `xml:"l2VpnSite"`
+}
+
+// nsxvL2VpnSite has either server or client configuration
+type nsxvL2VpnSite struct {
+	Server *nsxvL2VpnServer `xml:"server,omitempty"`
+	Client *nsxvL2VpnClient `xml:"client,omitempty"`
+}
+
+// nsxvL2VpnServer wraps L2VPN server configuration
+type nsxvL2VpnServer struct {
+	Configuration *nsxvL2VpnServerConfiguration `xml:"configuration"`
+}
+
+// nsxvL2VpnServerConfiguration defines listener of L2VPN server and peer sites which are allowed to connect
+type nsxvL2VpnServerConfiguration struct {
+	ListenerIp          string              `xml:"listenerIp"`
+	ListenerPort        int                 `xml:"listenerPort"`
+	EncryptionAlgorithm string              `xml:"encryptionAlgorithm,omitempty"`
+	ServerCertificate   string              `xml:"serverCertificate,omitempty"`
+	PeerSites           *nsxvL2VpnPeerSites `xml:"peerSites,omitempty"`
+}
+
+// nsxvL2VpnPeerSites is a list of L2VPN peer sites
+type nsxvL2VpnPeerSites struct {
+	PeerSites []*nsxvL2VpnPeerSite `xml:"peerSite"`
+}
+
+// nsxvL2VpnPeerSite is a remote L2VPN client site which is allowed to connect and stretch networks attached to the
+// given edge gateway interfaces
+type nsxvL2VpnPeerSite struct {
+	Name               string                   `xml:"name"`
+	Description        string                   `xml:"description,omitempty"`
+	L2VpnUser          *nsxvL2VpnUser           `xml:"l2VpnUser"`
+	Vnics              *nsxvL2VpnVnics          `xml:"vnics"`
+	EgressOptimization *nsxvL2VpnEgressGateways `xml:"egressOptimization,omitempty"`
+	Enabled            bool                     `xml:"enabled"`
+}
+
+// nsxvL2VpnUser holds L2VPN session credentials. Password is never returned by the API
+type nsxvL2VpnUser struct {
+	UserId   string `xml:"userId"`
+	Password string `xml:"password,omitempty"`
+}
+
+// nsxvL2VpnVnics is a list of edge gateway interface indexes stretched over L2VPN
+type nsxvL2VpnVnics struct {
+	Indexes []int `xml:"index"`
+}
+
+// nsxvL2VpnEgressGateways defines local gateway IP addresses for egress optimization
+type nsxvL2VpnEgressGateways struct {
+	GatewayIpAddresses []string `xml:"gatewayIpAddress"`
+}
+
+// nsxvL2VpnClient wraps L2VPN client configuration and session credentials
+type nsxvL2VpnClient struct {
+	Configuration *nsxvL2VpnClientConfiguration `xml:"configuration"`
+	L2VpnUser     *nsxvL2VpnUser                `xml:"l2VpnUser"`
+}
+
+// nsxvL2VpnClientConfiguration defines L2VPN server to connect to and the stretched edge gateway interfaces
+type nsxvL2VpnClientConfiguration struct {
+	ServerAddress       string                   `xml:"serverAddress"`
+	ServerPort          int                      `xml:"serverPort"`
+	Vnics               []int                    `xml:"vnic"`
+	EncryptionAlgorithm string                   `xml:"encryptionAlgorithm,omitempty"`
+	CaCertificate       string                   `xml:"caCertificate,omitempty"`
+	EgressOptimization  *nsxvL2VpnEgressGateways `xml:"egressOptimization,omitempty"`
+}
+
+// nsxvSyslog is the syslog configuration of NSX-V edge gateway
+type nsxvSyslog struct {
+	XMLName         xml.Name         `xml:"syslog"`
+	Version         string           `xml:"version,omitempty"`
+	Enabled         bool             `xml:"enabled"`
+	Protocol        string           `xml:"protocol,omitempty"`
+	ServerAddresses *nsxvIpAddresses `xml:"serverAddresses,omitempty"`
+}
+
+// nsxvDns is the DNS service configuration of NSX-V edge gateway
+type nsxvDns struct {
+	XMLName   xml.Name `xml:"dns"`
+	Version   string   `xml:"version,omitempty"`
+	Enabled   bool     `xml:"enabled"`
+	CacheSize int      `xml:"cacheSize,omitempty"`
+	// Listeners are kept as is because they are not managed by the provider
+	Listeners *nsxvInnerXml       `xml:"listeners,omitempty"`
+	DnsViews  *nsxvDnsViews       `xml:"dnsViews,omitempty"`
+	Logging   *nsxvServiceLogging `xml:"logging,omitempty"`
+}
+
+// nsxvDnsViews is a list of DNS views
+type nsxvDnsViews struct {
+	Views []*nsxvDnsView `xml:"dnsView"`
+}
+
+// nsxvDnsView defines DNS forwarders for clients matching the view
+type nsxvDnsView struct {
+	ViewId    string          `xml:"viewId,omitempty"`
+	Name      string          `xml:"name"`
+	Enabled   bool            `xml:"enabled"`
+	ViewMatch *nsxvInnerXml   `xml:"viewMatch,omitempty"`
+	Recursion bool            `xml:"recursion"`
+	Forward   *nsxvDnsForward `xml:"forward,omitempty"`
+}
+
+// nsxvDnsForward holds DNS servers to which queries are forwarded
+type nsxvDnsForward struct {
+	DnsServers *nsxvIpAddresses `xml:"dnsServers,omitempty"`
+}
+
+// nsxvLbStatistics holds runtime status and statistics of NSX-V edge gateway load balancer
+type nsxvLbStatistics struct {
+	XMLName        xml.Name                         `xml:"loadBalancerStatusAndStats"`
+	TimeStamp      int64                            `xml:"timeStamp"`
+	Pools          []*nsxvLbPoolStatistics          `xml:"pool"`
+	VirtualServers []*nsxvLbVirtualServerStatistics `xml:"virtualServer"`
+}
+
+// nsxvLbCounters are traffic counters which are reported for virtual servers, pools and pool members
+type nsxvLbCounters struct {
+	BytesIn         int64 `xml:"bytesIn"`
+	BytesOut        int64 `xml:"bytesOut"`
+	CurrentSessions int64 `xml:"curSessions"`
+	TotalSessions   int64 `xml:"totalSessions"`
+}
+
+// nsxvLbVirtualServerStatistics holds runtime status of a virtual server (e.g. 'OPEN', 'CLOSED')
+type nsxvLbVirtualServerStatistics struct {
+	VirtualServerId string `xml:"virtualServerId"`
+	Name            string `xml:"name"`
+	IpAddress       string `xml:"ipAddress"`
+	Status          string `xml:"status"`
+	nsxvLbCounters
+}
+
+// nsxvLbPoolStatistics holds runtime status of a server pool (e.g. 'UP', 'DOWN') and its members
+type nsxvLbPoolStatistics struct {
+	PoolId  string                        `xml:"poolId"`
+	Name    string                        `xml:"name"`
+	Status  string                        `xml:"status"`
+	Members []*nsxvLbPoolMemberStatistics `xml:"member"`
+	nsxvLbCounters
+}
+
+// nsxvLbPoolMemberStatistics holds runtime status of a pool member as reported by health checks. FailureCause holds
+// the message of the last failed health check
+type nsxvLbPoolMemberStatistics struct {
+	MemberId            string `xml:"memberId"`
+	Name                string `xml:"name"`
+	IpAddress           string `xml:"ipAddress"`
+	Status              string `xml:"status"`
+	FailureCause        string `xml:"failureCause,omitempty"`
+	LastStateChangeTime string `xml:"lastStateChangeTime,omitempty"`
+	nsxvLbCounters
+}
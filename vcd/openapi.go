@@ -0,0 +1,223 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// This file contains thin wrappers around low level OpenAPI client functions of go-vcloud-director for endpoints that
+// do not have a dedicated implementation in the SDK yet. Endpoints containing '%s' placeholders are nested under a
+// parent entity (e.g. an edge gateway) and the placeholders are filled in with 'endpointParams'.
+
+const (
+	openApiEndpointEdgeGateways                 = "edgeGateways/"
+	openApiEndpointOrgVdcNetworks               = "orgVdcNetworks/"
+	openApiEndpointImportableSwitches           = "nsxTResources/importableSwitches"
+	openApiEndpointNsxtFirewallRules            = "edgeGateways/%s/firewall/rules"
+	openApiEndpointNsxtNatRules                 = "edgeGateways/%s/nat/rules/"
+	openApiEndpointNsxtIpSecVpnTunnels          = "edgeGateways/%s/ipsec/tunnels/"
+	openApiEndpointNsxtIpSecVpnTunnelProperties = "edgeGateways/%s/ipsec/tunnels/%s/connectionProperties"
+	openApiEndpointNsxtIpSecVpnTunnelStatus     = "edgeGateways/%s/ipsec/tunnels/%s/status"
+	openApiEndpointFirewallGroups               = "firewallGroups/"
+	openApiEndpointFirewallGroupAssociatedVms   = "firewallGroups/%s/associatedVMs"
+	openApiEndpointAppPortProfiles              = "applicationPortProfiles/"
+	openApiEndpointOrgVdcNetworksDhcp           = "orgVdcNetworks/%s/dhcp"
+	openApiEndpointAlbController                = "loadBalancer/controllers/"
+	openApiEndpointAlbImportableClouds          = "nsxAlbResources/importableClouds"
+	openApiEndpointAlbCloud                     = "loadBalancer/clouds/"
+	openApiEndpointAlbImportableSeGroups        = "nsxAlbResources/importableServiceEngineGroups"
+	openApiEndpointAlbServiceEngineGroups       = "loadBalancer/serviceEngineGroups/"
+	openApiEndpointAlbSeGroupAssignments        = "loadBalancer/serviceEngineGroups/assignments/"
+	openApiEndpointAlbEdgeGateway               = "edgeGateways/%s/loadBalancer"
+	openApiEndpointAlbPools                     = "loadBalancer/pools/"
+	openApiEndpointAlbPoolSummaries             = "edgeGateways/%s/loadBalancer/poolSummaries"
+	openApiEndpointAlbVirtualServices           = "loadBalancer/virtualServices/"
+	openApiEndpointAlbVirtualServiceSummaries   = "edgeGateways/%s/loadBalancer/virtualServiceSummaries"
+	openApiEndpointVdcGroups                    = "vdcGroups/"
+	openApiEndpointVdcGroupCandidateVdcs        = "vdcGroups/networkingCandidateVdcs"
+	openApiEndpointVdcGroupDfwPolicies          = "vdcGroups/%s/dfwPolicies"
+	openApiEndpointVdcGroupDfwDefaultPolicy     = "vdcGroups/%s/dfwPolicies/default"
+	openApiEndpointVdcGroupDfwRules             = "vdcGroups/%s/dfwPolicies/%s/rules"
+	openApiEndpointNsxtStaticRoutes             = "edgeGateways/%s/routing/staticRoutes/"
+	openApiEndpointNsxtBgpConfig                = "edgeGateways/%s/routing/bgp"
+	openApiEndpointNsxtBgpNeighbors             = "edgeGateways/%s/routing/bgp/neighbors/"
+	openApiEndpointNsxtRouteAdvertisement       = "edgeGateways/%s/routing/advertisement"
+	openApiEndpointNsxtEdgeClusters             = "nsxTResources/edgeClusters"
+	openApiEndpointNsxtImportableTransportZones = "nsxTResources/importableTransportZones"
+)
+
+// openApiEndpointMinVersions holds mapping of OpenAPI endpoints and API versions they were introduced in
+var openApiEndpointMinVersions = map[string]string{
+	types.OpenApiPathVersion1_0_0 + openApiEndpointEdgeGateways:                 "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointOrgVdcNetworks:               "32.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointImportableSwitches:           "33.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtFirewallRules:            "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtNatRules:                 "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtIpSecVpnTunnels:          "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtIpSecVpnTunnelProperties: "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtIpSecVpnTunnelStatus:     "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointFirewallGroups:               "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointFirewallGroupAssociatedVms:   "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAppPortProfiles:              "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointOrgVdcNetworksDhcp:           "32.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbController:                "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbImportableClouds:          "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbCloud:                     "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbImportableSeGroups:        "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbServiceEngineGroups:       "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbSeGroupAssignments:        "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbEdgeGateway:               "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbPools:                     "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbPoolSummaries:             "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbVirtualServices:           "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointAlbVirtualServiceSummaries:   "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointVdcGroups:                    "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointVdcGroupCandidateVdcs:        "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointVdcGroupDfwPolicies:          "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointVdcGroupDfwDefaultPolicy:     "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointVdcGroupDfwRules:             "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtStaticRoutes:             "37.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtBgpConfig:                "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtBgpNeighbors:             "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtRouteAdvertisement:       "35.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtEdgeClusters:             "34.0",
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtImportableTransportZones: "33.0",
+}
+
+// openApiEndpointElevatedVersions holds a list of higher API versions for endpoints which gained new fields in later
+// VCD versions. The highest version supported by VCD is used so that new fields are not dropped by the API.
+var openApiEndpointElevatedVersions = map[string][]string{
+	types.OpenApiPathVersion1_0_0 + openApiEndpointNsxtNatRules: {
+		"35.2", // Adds 'firewallMatch' and 'priority' fields
+		"36.0", // Adds 'type' field which replaces 'ruleType' and supports REFLEXIVE rules
+	},
+	types.OpenApiPathVersion1_0_0 + openApiEndpointOrgVdcNetworksDhcp: {
+		"36.1", // Adds 'dnsServers' field
+	},
+}
+
+// openApiEndpointVersion checks if VCD version (to which the client is connected) is sufficient to work with
+// specified OpenAPI endpoint and returns API version to use for calling that endpoint. If the client default API
+// version is higher than endpoint introduction version - the default one is used. Elevated versions defined in
+// openApiEndpointElevatedVersions take precedence when VCD supports them.
+func (cli *VCDClient) openApiEndpointVersion(endpoint string) (string, error) {
+	minimumApiVersion, ok := openApiEndpointMinVersions[endpoint]
+	if !ok {
+		return "", fmt.Errorf("minimum API version for endpoint '%s' is not defined", endpoint)
+	}
+
+	if cli.Client.APIVCDMaxVersionIs("< " + minimumApiVersion) {
+		return "", fmt.Errorf("endpoint '%s' requires API version to support at least '%s'",
+			endpoint, minimumApiVersion)
+	}
+
+	apiVersion := minimumApiVersion
+	if cli.Client.APIClientVersionIs("> " + minimumApiVersion) {
+		apiVersion = cli.Client.APIVersion
+	}
+
+	for _, elevatedVersion := range openApiEndpointElevatedVersions[endpoint] {
+		if cli.Client.APIVCDMaxVersionIs(">= "+elevatedVersion) && !cli.Client.APIClientVersionIs("> "+elevatedVersion) {
+			apiVersion = elevatedVersion
+		}
+	}
+
+	return apiVersion, nil
+}
+
+// openApiEndpointUrl returns API version and URL for specified endpoint. 'endpointParams' are used to fill in '%s'
+// placeholders of endpoint and 'id' (when not empty) is appended to the end of URL.
+func (cli *VCDClient) openApiEndpointUrl(endpoint, id string, endpointParams []string) (string, *url.URL, error) {
+	apiVersion, err := cli.openApiEndpointVersion(endpoint)
+	if err != nil {
+		return "", nil, err
+	}
+
+	path := endpoint
+	if len(endpointParams) > 0 {
+		params := make([]interface{}, len(endpointParams))
+		for index, param := range endpointParams {
+			if param == "" {
+				return "", nil, fmt.Errorf("empty parent ID for endpoint '%s'", endpoint)
+			}
+			params[index] = param
+		}
+		path = fmt.Sprintf(endpoint, params...)
+	}
+
+	urlRef, err := cli.Client.OpenApiBuildEndpoint(path, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return apiVersion, urlRef, nil
+}
+
+// openApiGetAllItems retrieves all items of an endpoint into 'outType' which must be a pointer to slice
+func (cli *VCDClient) openApiGetAllItems(endpoint string, queryParameters url.Values, outType interface{}, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, "", endpointParams)
+	if err != nil {
+		return err
+	}
+
+	return cli.Client.OpenApiGetAllItems(apiVersion, urlRef, queryParameters, outType)
+}
+
+// openApiGetItem retrieves a single item. 'id' can be empty for endpoints which represent a single configuration
+// object (e.g. firewall rules of an edge gateway).
+func (cli *VCDClient) openApiGetItem(endpoint, id string, outType interface{}, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, id, endpointParams)
+	if err != nil {
+		return err
+	}
+
+	return cli.Client.OpenApiGetItem(apiVersion, urlRef, nil, outType)
+}
+
+// openApiPostItem creates a new item and unmarshals the created entity into 'outType' (when it is not nil)
+func (cli *VCDClient) openApiPostItem(endpoint string, payload, outType interface{}, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, "", endpointParams)
+	if err != nil {
+		return err
+	}
+
+	return cli.Client.OpenApiPostItem(apiVersion, urlRef, nil, payload, outType)
+}
+
+// openApiPostItemAsync creates a new item and waits for the task to complete without retrieving created entity. It
+// is useful for endpoints where task owner is not the created entity (e.g. NAT rules are owned by edge gateway).
+func (cli *VCDClient) openApiPostItemAsync(endpoint string, payload interface{}, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, "", endpointParams)
+	if err != nil {
+		return err
+	}
+
+	task, err := cli.Client.OpenApiPostItemAsync(apiVersion, urlRef, nil, payload)
+	if err != nil {
+		return err
+	}
+
+	return task.WaitTaskCompletion()
+}
+
+// openApiPutItem updates an item and unmarshals the updated entity into 'outType' (when it is not nil)
+func (cli *VCDClient) openApiPutItem(endpoint, id string, payload, outType interface{}, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, id, endpointParams)
+	if err != nil {
+		return err
+	}
+
+	return cli.Client.OpenApiPutItem(apiVersion, urlRef, nil, payload, outType)
+}
+
+// openApiDeleteItem deletes an item
+func (cli *VCDClient) openApiDeleteItem(endpoint, id string, endpointParams ...string) error {
+	apiVersion, urlRef, err := cli.openApiEndpointUrl(endpoint, id, endpointParams)
+	if err != nil {
+		return err
+	}
+
+	return cli.Client.OpenApiDeleteItem(apiVersion, urlRef, nil)
+}
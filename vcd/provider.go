@@ -29,81 +29,137 @@ func Resources(nameRegexp string, includeDeprecated bool) (map[string]*schema.Re
 }
 
 var globalDataSourceMap = map[string]*schema.Resource{
-	"vcd_org":                 datasourceVcdOrg(),               // 2.5
-	"vcd_org_user":            datasourceVcdOrgUser(),           // 3.0
-	"vcd_org_vdc":             datasourceVcdOrgVdc(),            // 2.5
-	"vcd_catalog":             datasourceVcdCatalog(),           // 2.5
-	"vcd_catalog_media":       datasourceVcdCatalogMedia(),      // 2.5
-	"vcd_catalog_item":        datasourceVcdCatalogItem(),       // 2.5
-	"vcd_edgegateway":         datasourceVcdEdgeGateway(),       // 2.5
-	"vcd_external_network":    datasourceVcdExternalNetwork(),   // 2.5
-	"vcd_external_network_v2": datasourceVcdExternalNetworkV2(), // 3.0
-	"vcd_independent_disk":    datasourceVcIndependentDisk(),    // 2.5
-	"vcd_network_routed":      datasourceVcdNetworkRouted(),     // 2.5
-	"vcd_network_direct":      datasourceVcdNetworkDirect(),     // 2.5
-	"vcd_network_isolated":    datasourceVcdNetworkIsolated(),   // 2.5
-	"vcd_vapp":                datasourceVcdVApp(),              // 2.5
-	"vcd_vapp_vm":             datasourceVcdVAppVm(),            // 2.6
-	"vcd_lb_service_monitor":  datasourceVcdLbServiceMonitor(),  // 2.4
-	"vcd_lb_server_pool":      datasourceVcdLbServerPool(),      // 2.4
-	"vcd_lb_app_profile":      datasourceVcdLBAppProfile(),      // 2.4
-	"vcd_lb_app_rule":         datasourceVcdLBAppRule(),         // 2.4
-	"vcd_lb_virtual_server":   datasourceVcdLbVirtualServer(),   // 2.4
-	"vcd_nsxv_dnat":           datasourceVcdNsxvDnat(),          // 2.5
-	"vcd_nsxv_snat":           datasourceVcdNsxvSnat(),          // 2.5
-	"vcd_nsxv_firewall_rule":  datasourceVcdNsxvFirewallRule(),  // 2.5
-	"vcd_nsxv_dhcp_relay":     datasourceVcdNsxvDhcpRelay(),     // 2.6
-	"vcd_nsxv_ip_set":         datasourceVcdIpSet(),             // 2.6
-	"vcd_vapp_network":        datasourceVcdVappNetwork(),       // 2.7
-	"vcd_vapp_org_network":    datasourceVcdVappOrgNetwork(),    // 2.7
-	"vcd_vm_affinity_rule":    datasourceVcdVmAffinityRule(),    // 2.9
-	"vcd_vm_sizing_policy":    datasourceVcdVmSizingPolicy(),    // 3.0
-	"vcd_nsxt_manager":        datasourceVcdNsxtManager(),       // 3.0
-	"vcd_nsxt_tier0_router":   datasourceVcdNsxtTier0Router(),   // 3.0
-	"vcd_portgroup":           datasourceVcdPortgroup(),         // 3.0
-	"vcd_vcenter":             datasourceVcdVcenter(),           // 3.0
+	"vcd_org":                       datasourceVcdOrg(),                     // 2.5
+	"vcd_org_user":                  datasourceVcdOrgUser(),                 // 3.0
+	"vcd_org_vdc":                   datasourceVcdOrgVdc(),                  // 2.5
+	"vcd_catalog":                   datasourceVcdCatalog(),                 // 2.5
+	"vcd_catalog_media":             datasourceVcdCatalogMedia(),            // 2.5
+	"vcd_catalog_item":              datasourceVcdCatalogItem(),             // 2.5
+	"vcd_edgegateway":               datasourceVcdEdgeGateway(),             // 2.5
+	"vcd_external_network":          datasourceVcdExternalNetwork(),         // 2.5
+	"vcd_external_network_v2":       datasourceVcdExternalNetworkV2(),       // 3.0
+	"vcd_independent_disk":          datasourceVcIndependentDisk(),          // 2.5
+	"vcd_network_routed":            datasourceVcdNetworkRouted(),           // 2.5
+	"vcd_network_direct":            datasourceVcdNetworkDirect(),           // 2.5
+	"vcd_network_isolated":          datasourceVcdNetworkIsolated(),         // 2.5
+	"vcd_vapp":                      datasourceVcdVApp(),                    // 2.5
+	"vcd_vapp_vm":                   datasourceVcdVAppVm(),                  // 2.6
+	"vcd_lb_service_monitor":        datasourceVcdLbServiceMonitor(),        // 2.4
+	"vcd_lb_server_pool":            datasourceVcdLbServerPool(),            // 2.4
+	"vcd_lb_app_profile":            datasourceVcdLBAppProfile(),            // 2.4
+	"vcd_lb_app_rule":               datasourceVcdLBAppRule(),               // 2.4
+	"vcd_lb_virtual_server":         datasourceVcdLbVirtualServer(),         // 2.4
+	"vcd_nsxv_dnat":                 datasourceVcdNsxvDnat(),                // 2.5
+	"vcd_nsxv_snat":                 datasourceVcdNsxvSnat(),                // 2.5
+	"vcd_nsxv_firewall_rule":        datasourceVcdNsxvFirewallRule(),        // 2.5
+	"vcd_nsxv_dhcp_relay":           datasourceVcdNsxvDhcpRelay(),           // 2.6
+	"vcd_nsxv_ip_set":               datasourceVcdIpSet(),                   // 2.6
+	"vcd_vapp_network":              datasourceVcdVappNetwork(),             // 2.7
+	"vcd_vapp_org_network":          datasourceVcdVappOrgNetwork(),          // 2.7
+	"vcd_vm_affinity_rule":          datasourceVcdVmAffinityRule(),          // 2.9
+	"vcd_vm_sizing_policy":          datasourceVcdVmSizingPolicy(),          // 3.0
+	"vcd_nsxt_manager":              datasourceVcdNsxtManager(),             // 3.0
+	"vcd_nsxt_tier0_router":         datasourceVcdNsxtTier0Router(),         // 3.0
+	"vcd_portgroup":                 datasourceVcdPortgroup(),               // 3.0
+	"vcd_vcenter":                   datasourceVcdVcenter(),                 // 3.0
+	"vcd_nsxt_edgegateway":          datasourceVcdNsxtEdgeGateway(),         // 3.1
+	"vcd_network_routed_v2":         datasourceVcdNetworkRoutedV2(),         // 3.1
+	"vcd_network_isolated_v2":       datasourceVcdNetworkIsolatedV2(),       // 3.1
+	"vcd_nsxt_network_imported":     datasourceVcdNsxtNetworkImported(),     // 3.1
+	"vcd_nsxt_security_group":       datasourceVcdNsxtSecurityGroup(),       // 3.1
+	"vcd_nsxt_app_port_profile":     datasourceVcdNsxtAppPortProfile(),      // 3.1
+	"vcd_vdc_group":                 datasourceVcdVdcGroup(),                // 3.1
+	"vcd_nsxt_distributed_firewall": datasourceVcdNsxtDistributedFirewall(), // 3.1
+	"vcd_nsxt_edge_cluster":         datasourceVcdNsxtEdgeCluster(),         // 3.1
+	"vcd_nsxt_transport_zone":       datasourceVcdNsxtTransportZone(),       // 3.1
+	"vcd_nsxv_dhcp_leases":          datasourceVcdNsxvDhcpLeases(),          // 3.1
+	"vcd_nsxv_mac_set":              datasourceVcdNsxvMacSet(),              // 3.1
+	"vcd_nsxv_service":              datasourceVcdNsxvService(),             // 3.1
+	"vcd_nsxv_service_group":        datasourceVcdNsxvServiceGroup(),        // 3.1
+	"vcd_lb_statistics":             datasourceVcdLbStatistics(),            // 3.1
 }
 
 var globalResourceMap = map[string]*schema.Resource{
 
-	"vcd_network_routed":       resourceVcdNetworkRouted(),            // 2.0
-	"vcd_network_direct":       resourceVcdNetworkDirect(),            // 2.0
-	"vcd_network_isolated":     resourceVcdNetworkIsolated(),          // 2.0
-	"vcd_vapp_network":         resourceVcdVappNetwork(),              // 2.1
-	"vcd_vapp":                 resourceVcdVApp(),                     // 1.0
-	"vcd_edgegateway":          resourceVcdEdgeGateway(),              // 2.4
-	"vcd_edgegateway_vpn":      resourceVcdEdgeGatewayVpn(),           // 1.0
-	"vcd_edgegateway_settings": resourceVcdEdgeGatewaySettings(),      // 3.0
-	"vcd_vapp_vm":              resourceVcdVAppVm(),                   // 1.0
-	"vcd_org":                  resourceOrg(),                         // 2.0
-	"vcd_org_vdc":              resourceVcdOrgVdc(),                   // 2.2
-	"vcd_org_user":             resourceVcdOrgUser(),                  // 2.4
-	"vcd_catalog":              resourceVcdCatalog(),                  // 2.0
-	"vcd_catalog_item":         resourceVcdCatalogItem(),              // 2.0
-	"vcd_catalog_media":        resourceVcdCatalogMedia(),             // 2.0
-	"vcd_inserted_media":       resourceVcdInsertedMedia(),            // 2.1
-	"vcd_independent_disk":     resourceVcdIndependentDisk(),          // 2.1
-	"vcd_external_network":     resourceVcdExternalNetwork(),          // 2.2
-	"vcd_lb_service_monitor":   resourceVcdLbServiceMonitor(),         // 2.4
-	"vcd_lb_server_pool":       resourceVcdLBServerPool(),             // 2.4
-	"vcd_lb_app_profile":       resourceVcdLBAppProfile(),             // 2.4
-	"vcd_lb_app_rule":          resourceVcdLBAppRule(),                // 2.4
-	"vcd_lb_virtual_server":    resourceVcdLBVirtualServer(),          // 2.4
-	"vcd_nsxv_dnat":            resourceVcdNsxvDnat(),                 // 2.5
-	"vcd_nsxv_snat":            resourceVcdNsxvSnat(),                 // 2.5
-	"vcd_nsxv_firewall_rule":   resourceVcdNsxvFirewallRule(),         // 2.5
-	"vcd_nsxv_dhcp_relay":      resourceVcdNsxvDhcpRelay(),            // 2.6
-	"vcd_nsxv_ip_set":          resourceVcdIpSet(),                    // 2.6
-	"vcd_vm_internal_disk":     resourceVmInternalDisk(),              // 2.7
-	"vcd_vapp_org_network":     resourceVcdVappOrgNetwork(),           // 2.7
-	"vcd_org_group":            resourceVcdOrgGroup(),                 // 2.9
-	"vcd_vapp_firewall_rules":  resourceVcdVappFirewallRules(),        // 2.9
-	"vcd_vapp_nat_rules":       resourceVcdVappNetworkNatRules(),      // 2.9
-	"vcd_vapp_static_routing":  resourceVcdVappNetworkStaticRouting(), // 2.9
-	"vcd_vm_affinity_rule":     resourceVcdVmAffinityRule(),           // 2.9
-	"vcd_vapp_access_control":  resourceVcdAccessControlVapp(),        // 3.0
-	"vcd_external_network_v2":  resourceVcdExternalNetworkV2(),        // 3.0
-	"vcd_vm_sizing_policy":     resourceVcdVmSizingPolicy(),           // 3.0
+	"vcd_network_routed":                            resourceVcdNetworkRouted(),                        // 2.0
+	"vcd_network_direct":                            resourceVcdNetworkDirect(),                        // 2.0
+	"vcd_network_isolated":                          resourceVcdNetworkIsolated(),                      // 2.0
+	"vcd_vapp_network":                              resourceVcdVappNetwork(),                          // 2.1
+	"vcd_vapp":                                      resourceVcdVApp(),                                 // 1.0
+	"vcd_edgegateway":                               resourceVcdEdgeGateway(),                          // 2.4
+	"vcd_edgegateway_vpn":                           resourceVcdEdgeGatewayVpn(),                       // 1.0
+	"vcd_edgegateway_settings":                      resourceVcdEdgeGatewaySettings(),                  // 3.0
+	"vcd_vapp_vm":                                   resourceVcdVAppVm(),                               // 1.0
+	"vcd_org":                                       resourceOrg(),                                     // 2.0
+	"vcd_org_vdc":                                   resourceVcdOrgVdc(),                               // 2.2
+	"vcd_org_user":                                  resourceVcdOrgUser(),                              // 2.4
+	"vcd_catalog":                                   resourceVcdCatalog(),                              // 2.0
+	"vcd_catalog_item":                              resourceVcdCatalogItem(),                          // 2.0
+	"vcd_catalog_media":                             resourceVcdCatalogMedia(),                         // 2.0
+	"vcd_inserted_media":                            resourceVcdInsertedMedia(),                        // 2.1
+	"vcd_independent_disk":                          resourceVcdIndependentDisk(),                      // 2.1
+	"vcd_external_network":                          resourceVcdExternalNetwork(),                      // 2.2
+	"vcd_lb_service_monitor":                        resourceVcdLbServiceMonitor(),                     // 2.4
+	"vcd_lb_server_pool":                            resourceVcdLBServerPool(),                         // 2.4
+	"vcd_lb_app_profile":                            resourceVcdLBAppProfile(),                         // 2.4
+	"vcd_lb_app_rule":                               resourceVcdLBAppRule(),                            // 2.4
+	"vcd_lb_virtual_server":                         resourceVcdLBVirtualServer(),                      // 2.4
+	"vcd_nsxv_dnat":                                 resourceVcdNsxvDnat(),                             // 2.5
+	"vcd_nsxv_snat":                                 resourceVcdNsxvSnat(),                             // 2.5
+	"vcd_nsxv_firewall_rule":                        resourceVcdNsxvFirewallRule(),                     // 2.5
+	"vcd_nsxv_dhcp_relay":                           resourceVcdNsxvDhcpRelay(),                        // 2.6
+	"vcd_nsxv_ip_set":                               resourceVcdIpSet(),                                // 2.6
+	"vcd_vm_internal_disk":                          resourceVmInternalDisk(),                          // 2.7
+	"vcd_vapp_org_network":                          resourceVcdVappOrgNetwork(),                       // 2.7
+	"vcd_org_group":                                 resourceVcdOrgGroup(),                             // 2.9
+	"vcd_vapp_firewall_rules":                       resourceVcdVappFirewallRules(),                    // 2.9
+	"vcd_vapp_nat_rules":                            resourceVcdVappNetworkNatRules(),                  // 2.9
+	"vcd_vapp_static_routing":                       resourceVcdVappNetworkStaticRouting(),             // 2.9
+	"vcd_vm_affinity_rule":                          resourceVcdVmAffinityRule(),                       // 2.9
+	"vcd_vapp_access_control":                       resourceVcdAccessControlVapp(),                    // 3.0
+	"vcd_external_network_v2":                       resourceVcdExternalNetworkV2(),                    // 3.0
+	"vcd_vm_sizing_policy":                          resourceVcdVmSizingPolicy(),                       // 3.0
+	"vcd_nsxt_edgegateway":                          resourceVcdNsxtEdgeGateway(),                      // 3.1
+	"vcd_network_routed_v2":                         resourceVcdNetworkRoutedV2(),                      // 3.1
+	"vcd_network_isolated_v2":                       resourceVcdNetworkIsolatedV2(),                    // 3.1
+	"vcd_nsxt_network_imported":                     resourceVcdNsxtNetworkImported(),                  // 3.1
+	"vcd_nsxt_firewall":                             resourceVcdNsxtFirewall(),                         // 3.1
+	"vcd_nsxt_nat_rule":                             resourceVcdNsxtNatRule(),                          // 3.1
+	"vcd_nsxt_ipsec_vpn_tunnel":                     resourceVcdNsxtIpSecVpnTunnel(),                   // 3.1
+	"vcd_nsxt_security_group":                       resourceVcdNsxtSecurityGroup(),                    // 3.1
+	"vcd_nsxt_app_port_profile":                     resourceVcdNsxtAppPortProfile(),                   // 3.1
+	"vcd_nsxt_network_dhcp":                         resourceVcdOpenApiDhcp(),                          // 3.1
+	"vcd_nsxt_alb_controller":                       resourceVcdNsxtAlbController(),                    // 3.1
+	"vcd_nsxt_alb_cloud":                            resourceVcdNsxtAlbCloud(),                         // 3.1
+	"vcd_nsxt_alb_service_engine_group":             resourceVcdNsxtAlbServiceEngineGroup(),            // 3.1
+	"vcd_nsxt_alb_settings":                         resourceVcdNsxtAlbSettings(),                      // 3.1
+	"vcd_nsxt_alb_edgegateway_service_engine_group": resourceVcdNsxtAlbEdgeGatewayServiceEngineGroup(), // 3.1
+	"vcd_nsxt_alb_pool":                             resourceVcdNsxtAlbPool(),                          // 3.1
+	"vcd_nsxt_alb_virtual_service":                  resourceVcdNsxtAlbVirtualService(),                // 3.1
+	"vcd_vdc_group":                                 resourceVcdVdcGroup(),                             // 3.1
+	"vcd_nsxt_distributed_firewall":                 resourceVcdNsxtDistributedFirewall(),              // 3.1
+	"vcd_nsxt_edgegateway_static_route":             resourceVcdNsxtEdgeGatewayStaticRoute(),           // 3.1
+	"vcd_nsxt_edgegateway_bgp_configuration":        resourceVcdNsxtEdgeGatewayBgpConfiguration(),      // 3.1
+	"vcd_nsxt_edgegateway_bgp_neighbor":             resourceVcdNsxtEdgeGatewayBgpNeighbor(),           // 3.1
+	"vcd_nsxt_route_advertisement":                  resourceVcdNsxtRouteAdvertisement(),               // 3.1
+	"vcd_nsxv_ipsec_vpn":                            resourceVcdNsxvIpsecVpn(),                         // 3.1
+	"vcd_nsxv_routing_global":                       resourceVcdNsxvRoutingGlobal(),                    // 3.1
+	"vcd_nsxv_static_routing":                       resourceVcdNsxvStaticRouting(),                    // 3.1
+	"vcd_nsxv_ospf":                                 resourceVcdNsxvOspf(),                             // 3.1
+	"vcd_nsxv_bgp":                                  resourceVcdNsxvBgp(),                              // 3.1
+	"vcd_nsxv_dhcp_settings":                        resourceVcdNsxvDhcpSettings(),                     // 3.1
+	"vcd_nsxv_dhcp_binding":                         resourceVcdNsxvDhcpBinding(),                      // 3.1
+	"vcd_nsxv_mac_set":                              resourceVcdNsxvMacSet(),                           // 3.1
+	"vcd_nsxv_service":                              resourceVcdNsxvService(),                          // 3.1
+	"vcd_nsxv_service_group":                        resourceVcdNsxvServiceGroup(),                     // 3.1
+	"vcd_nsxv_firewall_rules":                       resourceVcdNsxvFirewallRules(),                    // 3.1
+	"vcd_nsxv_certificate":                          resourceVcdNsxvCertificate(),                      // 3.1
+	"vcd_nsxv_ssl_vpn_server":                       resourceVcdNsxvSslVpnServer(),                     // 3.1
+	"vcd_nsxv_ssl_vpn_ip_pool":                      resourceVcdNsxvSslVpnIpPool(),                     // 3.1
+	"vcd_nsxv_ssl_vpn_private_network":              resourceVcdNsxvSslVpnPrivateNetwork(),             // 3.1
+	"vcd_nsxv_ssl_vpn_user":                         resourceVcdNsxvSslVpnUser(),                       // 3.1
+	"vcd_nsxv_ssl_vpn_install_package":              resourceVcdNsxvSslVpnInstallPackage(),             // 3.1
+	"vcd_nsxv_l2vpn":                                resourceVcdNsxvL2Vpn(),                            // 3.1
 }
 
 // Provider returns a terraform.ResourceProvider.
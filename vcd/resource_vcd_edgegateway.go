@@ -2,6 +2,7 @@ package vcd
 
 //lint:file-ignore SA1019 ignore deprecated functions
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,12 +18,10 @@ var subAllocationPool = &schema.Resource{
 		"start_address": {
 			Required: true,
 			Type:     schema.TypeString,
-			ForceNew: true,
 		},
 		"end_address": {
 			Required: true,
 			Type:     schema.TypeString,
-			ForceNew: true,
 		},
 	},
 }
@@ -31,33 +30,28 @@ var subnetResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"gateway": {
 			Required:    true,
-			ForceNew:    true,
 			Description: "Gateway address for a subnet",
 			Type:        schema.TypeString,
 		},
 		"netmask": {
 			Required:    true,
-			ForceNew:    true,
 			Description: "Netmask address for a subnet",
 			Type:        schema.TypeString,
 		},
 		"ip_address": {
 			Optional:    true,
 			Type:        schema.TypeString,
-			ForceNew:    true,
 			Description: "IP address on the edge gateway - will be auto-assigned if not defined",
 		},
 		"use_for_default_route": {
 			Optional:    true,
 			Default:     false,
-			ForceNew:    true,
 			Type:        schema.TypeBool,
 			Description: "Defines if this subnet should be used as default gateway for edge",
 		},
 		"suballocate_pool": {
 			Optional:    true,
 			Type:        schema.TypeSet,
-			ForceNew:    true,
 			Description: "Define zero or more blocks to sub-allocate pools on the edge gateway",
 			Elem:        subAllocationPool,
 		},
@@ -68,35 +62,30 @@ var externalNetworkResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
 			Required:    true,
-			ForceNew:    true,
 			Type:        schema.TypeString,
 			Description: "External network name",
 		},
 		"enable_rate_limit": {
 			Optional:    true,
 			Default:     false,
-			ForceNew:    true,
 			Type:        schema.TypeBool,
 			Description: "Enable rate limiting",
 		},
 		"incoming_rate_limit": {
 			Optional:    true,
 			Default:     0,
-			ForceNew:    true,
 			Type:        schema.TypeFloat,
 			Description: "Incoming rate limit (Mbps)",
 		},
 		"outgoing_rate_limit": {
 			Optional:    true,
 			Default:     0,
-			ForceNew:    true,
 			Type:        schema.TypeFloat,
 			Description: "Outgoing rate limit (Mbps)",
 		},
 		"subnet": {
 			Optional: true,
 			Computed: true,
-			ForceNew: true,
 			Type:     schema.TypeSet,
 			MinItems: 1,
 			Elem:     subnetResource,
@@ -114,6 +103,7 @@ func resourceVcdEdgeGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceVcdEdgeGatewayImport,
 		},
+		CustomizeDiff: resourceVcdEdgeGatewayCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -142,14 +132,12 @@ func resourceVcdEdgeGateway() *schema.Resource {
 			"configuration": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: `Configuration of the vShield edge VM for this gateway. One of: compact, full ("Large"), full4 ("Quad Large"), x-large`,
 			},
 			"ha_enabled": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
-				ForceNew:    true,
+				Computed:    true,
 				Description: "Enable high availability on this edge gateway",
 			},
 			"default_external_network_ip": &schema.Schema{
@@ -219,21 +207,18 @@ func resourceVcdEdgeGateway() *schema.Resource {
 			},
 			"fips_mode_enabled": &schema.Schema{
 				Type:        schema.TypeBool,
-				ForceNew:    true,
 				Optional:    true,
-				Default:     false,
-				Description: "Enable FIPS mode. FIPS mode turns on the cipher suites that comply with FIPS. (False by default)",
+				Computed:    true,
+				Description: "Enable FIPS mode. FIPS mode turns on the cipher suites that comply with FIPS.",
 			},
 			"use_default_route_for_dns_relay": &schema.Schema{
 				Type:        schema.TypeBool,
-				ForceNew:    true,
 				Optional:    true,
 				Computed:    true,
-				Description: "If true, default gateway will be used for the edge gateways' default routing and DNS forwarding.(False by default)",
+				Description: "If true, default gateway will be used for the edge gateways' default routing and DNS forwarding.",
 			},
 			"external_network": {
 				Description: "One or more blocks with external network information to be attached to this gateway's interface",
-				ForceNew:    true,
 				Required:    true,
 				Type:        schema.TypeSet,
 				Elem:        externalNetworkResource,
@@ -420,7 +405,8 @@ func genericVcdEdgeGatewayRead(d *schema.ResourceData, meta interface{}, origin
 	return nil
 }
 
-// resourceVcdEdgeGatewayUpdate updates general load balancer settings only at the moment
+// resourceVcdEdgeGatewayUpdate updates edge gateway size, external network interfaces, HA, FIPS mode, DNS relay,
+// general load balancer and firewall settings
 func resourceVcdEdgeGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	vcdClient := meta.(*VCDClient)
 	vcdClient.lockEdgeGateway(d)
@@ -431,6 +417,21 @@ func resourceVcdEdgeGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	if d.HasChange("configuration") || d.HasChange("external_network") {
+		err := updateEdgeGatewayInterfacesAndSize(vcdClient, d, edgeGateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	// HA, FIPS mode and DNS relay are changed in place. VCD redeploys edge gateway appliances when it is needed
+	if d.HasChange("ha_enabled") || d.HasChange("fips_mode_enabled") || d.HasChange("use_default_route_for_dns_relay") {
+		err := updateEdgeGatewayConfiguration(d, edgeGateway)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("lb_enabled") || d.HasChange("lb_acceleration_enabled") ||
 		d.HasChange("lb_logging_enabled") || d.HasChange("lb_loglevel") {
 		err := updateLoadBalancer(d, *edgeGateway)
@@ -450,6 +451,104 @@ func resourceVcdEdgeGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 	return resourceVcdEdgeGatewayRead(d, meta)
 }
 
+// updateEdgeGatewayInterfacesAndSize changes edge gateway size and its uplink interfaces in place. Both are sent in a
+// single edge gateway update and VCD decides itself whether edge gateway appliances must be redeployed (e.g. when size
+// changes). Internal interfaces and the distributed routing transit interface are sent back unchanged.
+func updateEdgeGatewayInterfacesAndSize(vcdClient *VCDClient, d *schema.ResourceData, edgeGateway *govcd.EdgeGateway) error {
+	log.Printf("[TRACE] edge gateway size and interface update started")
+
+	configuration := edgeGateway.EdgeGateway.Configuration
+	configuration.GatewayBackingConfig = d.Get("configuration").(string)
+
+	if d.HasChange("external_network") {
+		uplinks, err := getGatewayInterfacesType(vcdClient, d.Get("external_network").(*schema.Set))
+		if err != nil {
+			return fmt.Errorf("could not process 'external_network' block(s): %s", err)
+		}
+
+		configuration.GatewayInterfaces.GatewayInterface = mergeEdgeGatewayUplinks(d.Get("name").(string),
+			configuration.GatewayInterfaces.GatewayInterface, uplinks)
+	}
+
+	err := edgeGateway.Update()
+	if err != nil {
+		return fmt.Errorf("error updating edge gateway size and interfaces: %s", err)
+	}
+
+	log.Printf("[TRACE] edge gateway size and interface update completed")
+	return nil
+}
+
+// mergeEdgeGatewayUplinks replaces uplink interfaces in 'current' with the ones in 'uplinks'. Interfaces keep their
+// original order so that uplinks which are still used stay on the same vNic, removed uplinks are dropped and new
+// uplinks are appended. When a subnet of an existing uplink has no IP address in configuration, the IP address which
+// VCD allocated before is kept, so that it does not change on every update.
+func mergeEdgeGatewayUplinks(edgeGatewayName string, current, uplinks []*types.GatewayInterface) []*types.GatewayInterface {
+	uplinksByNetwork := make(map[string]*types.GatewayInterface, len(uplinks))
+	for _, uplink := range uplinks {
+		uplinksByNetwork[uplink.Network.Name] = uplink
+	}
+
+	var merged []*types.GatewayInterface
+	for _, gwInterface := range current {
+		// Internal interfaces and the transit interface of distributed routing are not managed in
+		// `external_network` blocks
+		if gwInterface.InterfaceType != "uplink" || gwInterface.Network.Name == fmt.Sprintf("DLR_to_EDGE_%s", edgeGatewayName) {
+			merged = append(merged, gwInterface)
+			continue
+		}
+
+		uplink, found := uplinksByNetwork[gwInterface.Network.Name]
+		if !found {
+			log.Printf("[TRACE] edge gateway - removing uplink interface of network %s", gwInterface.Network.Name)
+			continue
+		}
+		delete(uplinksByNetwork, gwInterface.Network.Name)
+
+		for _, subnet := range uplink.SubnetParticipation {
+			if subnet.IPAddress != "" {
+				continue
+			}
+			for _, currentSubnet := range gwInterface.SubnetParticipation {
+				if currentSubnet.Gateway == subnet.Gateway && currentSubnet.Netmask == subnet.Netmask {
+					subnet.IPAddress = currentSubnet.IPAddress
+				}
+			}
+		}
+		merged = append(merged, uplink)
+	}
+
+	// Uplinks are appended in the order they were defined to keep the update predictable
+	for _, uplink := range uplinks {
+		if _, isNew := uplinksByNetwork[uplink.Network.Name]; isNew {
+			log.Printf("[TRACE] edge gateway - adding uplink interface of network %s", uplink.Network.Name)
+			merged = append(merged, uplink)
+		}
+	}
+
+	return merged
+}
+
+// resourceVcdEdgeGatewayCustomizeDiff marks IP addresses of edge gateway as unknown when external networks change,
+// because they are only known after VCD allocates them
+func resourceVcdEdgeGatewayCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("external_network") {
+		return nil
+	}
+
+	err := diff.SetNewComputed("external_network_ips")
+	if err != nil {
+		return fmt.Errorf("unable to mark external_network_ips as computed: %s", err)
+	}
+
+	err = diff.SetNewComputed("default_external_network_ip")
+	if err != nil {
+		return fmt.Errorf("unable to mark default_external_network_ip as computed: %s", err)
+	}
+
+	return nil
+}
+
 // Deletes a edge gateway, optionally removing all objects in it as well
 func resourceVcdEdgeGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[TRACE] edge gateway delete started")
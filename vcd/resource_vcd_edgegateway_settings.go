@@ -95,6 +95,55 @@ func resourceVcdEdgeGatewaySettings() *schema.Resource {
 				Description:  "'accept' or 'deny'. Default 'deny'",
 				ValidateFunc: validation.StringInSlice([]string{"accept", "deny"}, false),
 			},
+			"ha_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable high availability. Left unchanged when not set",
+			},
+			"fips_mode_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable FIPS mode. Left unchanged when not set",
+			},
+			"use_default_route_for_dns_relay": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Use the default gateway of the edge gateway for DNS relay. Left unchanged when not set",
+			},
+			"syslog_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable sending edge gateway logs to syslog servers. Left unchanged when not set",
+			},
+			"syslog_protocol": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"udp", "tcp"}, false),
+				Description:  "Protocol used to send logs to syslog servers. One of 'udp', 'tcp'",
+			},
+			"syslog_server_ips": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+				Description: "IP addresses of syslog servers",
+			},
+			"dns_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable DNS service which forwards queries of clients to DNS forwarders. Left unchanged when not set",
+			},
+			"dns_forwarder_ips": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+				Description: "IP addresses of DNS servers to which queries are forwarded",
+			},
 		},
 	}
 }
@@ -148,6 +197,19 @@ func resourceVcdEdgeGatewaySettingsRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	vcdClient := meta.(*VCDClient)
+	if err := setEdgeGatewaySyslogData(d, vcdClient, edgeGateway); err != nil {
+		return err
+	}
+
+	if err := setEdgeGatewayDnsData(d, vcdClient, edgeGateway); err != nil {
+		return err
+	}
+
+	_ = d.Set("ha_enabled", edgeGateway.EdgeGateway.Configuration.HaEnabled)
+	_ = d.Set("fips_mode_enabled", edgeGateway.EdgeGateway.Configuration.FipsModeEnabled)
+	_ = d.Set("use_default_route_for_dns_relay", edgeGateway.EdgeGateway.Configuration.UseDefaultRouteForDNSRelay)
+
 	_ = d.Set("edge_gateway_id", edgeGateway.EdgeGateway.ID)
 	_ = d.Set("edge_gateway_name", edgeGateway.EdgeGateway.Name)
 	d.SetId(edgeGateway.EdgeGateway.ID)
@@ -178,6 +240,30 @@ func resourceVcdEdgeGatewaySettingsUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	vcdClient := meta.(*VCDClient)
+	if d.IsNewResource() || d.HasChanges("syslog_enabled", "syslog_protocol", "syslog_server_ips") {
+		err := updateEdgeGatewaySyslog(d, vcdClient, edgeGateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.IsNewResource() || d.HasChanges("dns_enabled", "dns_forwarder_ips") {
+		err := updateEdgeGatewayDns(d, vcdClient, edgeGateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	// HA, FIPS mode and DNS relay are changed in place on the edge gateway itself. VCD redeploys edge gateway
+	// appliances when it is needed (e.g. when FIPS mode changes), but the edge gateway is never recreated
+	if d.IsNewResource() || d.HasChanges("ha_enabled", "fips_mode_enabled", "use_default_route_for_dns_relay") {
+		err := updateEdgeGatewayConfiguration(d, edgeGateway)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[TRACE] edge gateway settings update completed: %#v", edgeGateway.EdgeGateway)
 	return resourceVcdEdgeGatewaySettingsRead(d, meta)
 }
@@ -229,3 +315,143 @@ func resourceVcdEdgeGatewaySettingsImport(d *schema.ResourceData, meta interface
 	d.SetId(edgeGateway.EdgeGateway.ID)
 	return []*schema.ResourceData{d}, nil
 }
+
+// setEdgeGatewaySyslogData sets syslog settings of edge gateway into the resource
+func setEdgeGatewaySyslogData(d *schema.ResourceData, vcdClient *VCDClient, egw *govcd.EdgeGateway) error {
+	syslog, err := getNsxvSyslog(vcdClient, egw)
+	if err != nil {
+		return fmt.Errorf("unable to read syslog settings: %s", err)
+	}
+
+	var serverIps []string
+	if syslog.ServerAddresses != nil {
+		serverIps = syslog.ServerAddresses.IpAddresses
+	}
+
+	_ = d.Set("syslog_enabled", syslog.Enabled)
+	_ = d.Set("syslog_protocol", syslog.Protocol)
+	err = d.Set("syslog_server_ips", convertToTypeSet(serverIps))
+	if err != nil {
+		return fmt.Errorf("unable to set syslog_server_ips: %s", err)
+	}
+
+	return nil
+}
+
+// setEdgeGatewayDnsData sets DNS service state and forwarders of the default DNS view into the resource
+func setEdgeGatewayDnsData(d *schema.ResourceData, vcdClient *VCDClient, egw *govcd.EdgeGateway) error {
+	dns, err := getNsxvDns(vcdClient, egw)
+	if err != nil {
+		return fmt.Errorf("unable to read DNS settings: %s", err)
+	}
+
+	var forwarderIps []string
+	view := getNsxvDnsDefaultView(dns)
+	if view.Forward != nil && view.Forward.DnsServers != nil {
+		forwarderIps = view.Forward.DnsServers.IpAddresses
+	}
+
+	_ = d.Set("dns_enabled", dns.Enabled)
+	err = d.Set("dns_forwarder_ips", convertToTypeSet(forwarderIps))
+	if err != nil {
+		return fmt.Errorf("unable to set dns_forwarder_ips: %s", err)
+	}
+
+	return nil
+}
+
+// updateEdgeGatewaySyslog updates syslog settings of edge gateway. Fields which are not set keep their current values,
+// except for the server list which is cleared when it is not set
+func updateEdgeGatewaySyslog(d *schema.ResourceData, vcdClient *VCDClient, egw *govcd.EdgeGateway) error {
+	syslog, err := getNsxvSyslog(vcdClient, egw)
+	if err != nil {
+		return fmt.Errorf("unable to read syslog settings: %s", err)
+	}
+
+	if enabled, ok := d.GetOkExists("syslog_enabled"); ok {
+		syslog.Enabled = enabled.(bool)
+	}
+	if protocol, ok := d.GetOk("syslog_protocol"); ok {
+		syslog.Protocol = protocol.(string)
+	}
+	// Server list is always sent when it changes so that it can be cleared by removing it from configuration
+	if d.IsNewResource() || d.HasChange("syslog_server_ips") {
+		syslog.ServerAddresses = nil
+		serverIps := convertSchemaSetToSliceOfStrings(d.Get("syslog_server_ips").(*schema.Set))
+		if len(serverIps) > 0 {
+			syslog.ServerAddresses = &nsxvIpAddresses{IpAddresses: serverIps}
+		}
+	}
+
+	err = updateNsxvSyslog(vcdClient, egw, syslog)
+	if err != nil {
+		return fmt.Errorf("unable to update syslog settings: %s", err)
+	}
+
+	return nil
+}
+
+// updateEdgeGatewayDns updates DNS service state and forwarders of the default DNS view. DNS service state keeps its
+// current value when it is not set, while forwarder list is cleared
+func updateEdgeGatewayDns(d *schema.ResourceData, vcdClient *VCDClient, egw *govcd.EdgeGateway) error {
+	dns, err := getNsxvDns(vcdClient, egw)
+	if err != nil {
+		return fmt.Errorf("unable to read DNS settings: %s", err)
+	}
+
+	if enabled, ok := d.GetOkExists("dns_enabled"); ok {
+		dns.Enabled = enabled.(bool)
+	}
+	// Forwarder list is always sent when it changes so that it can be cleared by removing it from configuration
+	if d.IsNewResource() || d.HasChange("dns_forwarder_ips") {
+		view := getNsxvDnsDefaultView(dns)
+		view.Forward = nil
+		forwarderIps := convertSchemaSetToSliceOfStrings(d.Get("dns_forwarder_ips").(*schema.Set))
+		if len(forwarderIps) > 0 {
+			view.Forward = &nsxvDnsForward{
+				DnsServers: &nsxvIpAddresses{IpAddresses: forwarderIps},
+			}
+		}
+	}
+
+	err = updateNsxvDns(vcdClient, egw, dns)
+	if err != nil {
+		return fmt.Errorf("unable to update DNS settings: %s", err)
+	}
+
+	return nil
+}
+
+// updateEdgeGatewayConfiguration updates HA, FIPS mode and DNS relay of edge gateway. Only fields which are set and
+// differ from current values are sent to VCD, so that edge gateway is not updated (and possibly redeployed)
+// needlessly
+func updateEdgeGatewayConfiguration(d *schema.ResourceData, egw *govcd.EdgeGateway) error {
+	configuration := egw.EdgeGateway.Configuration
+	needsUpdate := false
+
+	updateBool := func(field string, current **bool) {
+		value, ok := d.GetOkExists(field)
+		if !ok {
+			return
+		}
+		if *current == nil || **current != value.(bool) {
+			*current = takeBoolPointer(value.(bool))
+			needsUpdate = true
+		}
+	}
+
+	updateBool("ha_enabled", &configuration.HaEnabled)
+	updateBool("fips_mode_enabled", &configuration.FipsModeEnabled)
+	updateBool("use_default_route_for_dns_relay", &configuration.UseDefaultRouteForDNSRelay)
+
+	if !needsUpdate {
+		return nil
+	}
+
+	err := egw.Update()
+	if err != nil {
+		return fmt.Errorf("unable to update edge gateway configuration: %s", err)
+	}
+
+	return nil
+}
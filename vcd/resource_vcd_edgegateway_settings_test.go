@@ -100,6 +100,9 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 	var existingEgw *govcd.EdgeGateway
 	var fwSettings *types.FirewallConfigWithXml
 	var lbSettings *types.LbGeneralParamsWithXml
+	var syslogSettings *nsxvSyslog
+	var dnsSettings *nsxvDns
+	var haEnabled, fipsModeEnabled, useDefaultRouteForDnsRelay bool
 	var err error
 	if !vcdShortTest {
 		// Gets current settings from the edge gateway
@@ -115,6 +118,19 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 		if err != nil {
 			t.Errorf("error retrieving edge gateway load balancing parameters: %s", err)
 		}
+		vcdClient := createTemporaryVCDConnection()
+		syslogSettings, err = getNsxvSyslog(vcdClient, existingEgw)
+		if err != nil {
+			t.Errorf("error retrieving edge gateway syslog settings: %s", err)
+		}
+		dnsSettings, err = getNsxvDns(vcdClient, existingEgw)
+		if err != nil {
+			t.Errorf("error retrieving edge gateway DNS settings: %s", err)
+		}
+		configuration := existingEgw.EdgeGateway.Configuration
+		haEnabled = configuration.HaEnabled != nil && *configuration.HaEnabled
+		fipsModeEnabled = configuration.FipsModeEnabled != nil && *configuration.FipsModeEnabled
+		useDefaultRouteForDnsRelay = configuration.UseDefaultRouteForDNSRelay != nil && *configuration.UseDefaultRouteForDNSRelay
 		// Restore original values in edge gateway after the test
 		defer func() {
 			_, err = existingEgw.UpdateLBGeneralParams(lbSettings.Enabled, lbSettings.AccelerationEnabled, lbSettings.Logging.Enable, lbSettings.Logging.LogLevel)
@@ -125,6 +141,27 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 			if err != nil {
 				t.Logf("WARNING: restore of firewall settings failed: %s\n", err)
 			}
+			err = updateNsxvSyslog(vcdClient, existingEgw, syslogSettings)
+			if err != nil {
+				t.Logf("WARNING: restore of syslog settings failed: %s\n", err)
+			}
+			err = updateNsxvDns(vcdClient, existingEgw, dnsSettings)
+			if err != nil {
+				t.Logf("WARNING: restore of DNS settings failed: %s\n", err)
+			}
+			err = existingEgw.Refresh()
+			if err != nil {
+				t.Logf("WARNING: refresh of edge gateway failed: %s\n", err)
+				return
+			}
+			configuration := existingEgw.EdgeGateway.Configuration
+			configuration.HaEnabled = takeBoolPointer(haEnabled)
+			configuration.FipsModeEnabled = takeBoolPointer(fipsModeEnabled)
+			configuration.UseDefaultRouteForDNSRelay = takeBoolPointer(useDefaultRouteForDnsRelay)
+			err = existingEgw.Update()
+			if err != nil {
+				t.Logf("WARNING: restore of HA, FIPS mode and DNS relay settings failed: %s\n", err)
+			}
 		}()
 	}
 
@@ -153,10 +190,25 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 		"FwRuleEnabled":         true,
 		"FwRuleAction":          "accept",
 		"EgwSettings":           testName,
+		"HaEnabled":             haEnabled,
+		"FipsModeEnabled":       fipsModeEnabled,
+		"UseDefaultRoute":       useDefaultRouteForDnsRelay,
+		"SyslogServerIps":       `"192.168.1.100"`,
+		"DnsForwarderIps":       `"8.8.8.8", "8.8.4.4"`,
 
 		"Tags": "gateway",
 	}
+	params["FuncName"] = t.Name() + "-step1"
 	configText := templateFill(testAccEdgeGatewaySettingsSimple, params)
+
+	// Step 2 toggles HA, FIPS mode and DNS relay and clears syslog servers and DNS forwarders
+	params["FuncName"] = t.Name() + "-step2"
+	params["HaEnabled"] = !haEnabled
+	params["FipsModeEnabled"] = !fipsModeEnabled
+	params["UseDefaultRoute"] = !useDefaultRouteForDnsRelay
+	params["SyslogServerIps"] = ""
+	params["DnsForwarderIps"] = ""
+	configText2 := templateFill(testAccEdgeGatewaySettingsSimple, params)
 	if vcdShortTest {
 		t.Skip(acceptanceTestsSkipped)
 		return
@@ -164,6 +216,7 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 
 	egwSettingsResource := "vcd_edgegateway_settings." + testName
 	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+	debugPrintf("#[DEBUG] CONFIGURATION step 2: %s", configText2)
 	// Note: this test can't run in parallel, as it updates the main edge gateway in the vCD
 	// and it could interfere with other tests
 	resource.Test(t, resource.TestCase{
@@ -182,6 +235,17 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(egwSettingsResource, "fw_enabled", "true"),
 					resource.TestCheckResourceAttr(egwSettingsResource, "fw_default_rule_logging_enabled", "true"),
 					resource.TestCheckResourceAttr(egwSettingsResource, "fw_default_rule_action", "accept"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "syslog_enabled", "true"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "syslog_protocol", "udp"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "syslog_server_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(egwSettingsResource, "syslog_server_ips.*", "192.168.1.100"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "dns_enabled", "true"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "dns_forwarder_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(egwSettingsResource, "dns_forwarder_ips.*", "8.8.8.8"),
+					resource.TestCheckTypeSetElemAttr(egwSettingsResource, "dns_forwarder_ips.*", "8.8.4.4"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "ha_enabled", fmt.Sprintf("%t", haEnabled)),
+					resource.TestCheckResourceAttr(egwSettingsResource, "fips_mode_enabled", fmt.Sprintf("%t", fipsModeEnabled)),
+					resource.TestCheckResourceAttr(egwSettingsResource, "use_default_route_for_dns_relay", fmt.Sprintf("%t", useDefaultRouteForDnsRelay)),
 
 					// Check that the edge gateway has the expected values
 					checkEdgeGatewaySettingsCorrespondence("lb_enabled", "true"),
@@ -191,6 +255,23 @@ func TestAccVcdEdgeGatewaySettingsBasic(t *testing.T) {
 					checkEdgeGatewaySettingsCorrespondence("fw_enabled", "true"),
 					checkEdgeGatewaySettingsCorrespondence("fw_default_rule_logging_enabled", "true"),
 					checkEdgeGatewaySettingsCorrespondence("fw_default_rule_action", "accept"),
+					checkEdgeGatewaySettingsCorrespondence("ha_enabled", fmt.Sprintf("%t", haEnabled)),
+					checkEdgeGatewaySettingsCorrespondence("fips_mode_enabled", fmt.Sprintf("%t", fipsModeEnabled)),
+					checkEdgeGatewaySettingsCorrespondence("use_default_route_for_dns_relay", fmt.Sprintf("%t", useDefaultRouteForDnsRelay)),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(egwSettingsResource, "syslog_server_ips.#", "0"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "dns_forwarder_ips.#", "0"),
+					resource.TestCheckResourceAttr(egwSettingsResource, "ha_enabled", fmt.Sprintf("%t", !haEnabled)),
+					resource.TestCheckResourceAttr(egwSettingsResource, "fips_mode_enabled", fmt.Sprintf("%t", !fipsModeEnabled)),
+					resource.TestCheckResourceAttr(egwSettingsResource, "use_default_route_for_dns_relay", fmt.Sprintf("%t", !useDefaultRouteForDnsRelay)),
+
+					checkEdgeGatewaySettingsCorrespondence("ha_enabled", fmt.Sprintf("%t", !haEnabled)),
+					checkEdgeGatewaySettingsCorrespondence("fips_mode_enabled", fmt.Sprintf("%t", !fipsModeEnabled)),
+					checkEdgeGatewaySettingsCorrespondence("use_default_route_for_dns_relay", fmt.Sprintf("%t", !useDefaultRouteForDnsRelay)),
 				),
 			},
 			resource.TestStep{
@@ -257,6 +338,15 @@ func checkEdgeGatewaySettingsCorrespondence(field, value string) resource.TestCh
 			return boolComparisonToErr(field, value, fwSettings.DefaultPolicy.LoggingEnabled)
 		case "fw_default_rule_action":
 			return strComparisonToErr(field, value, fwSettings.DefaultPolicy.Action)
+		case "ha_enabled":
+			return boolComparisonToErr(field, value, egw.EdgeGateway.Configuration.HaEnabled != nil &&
+				*egw.EdgeGateway.Configuration.HaEnabled)
+		case "fips_mode_enabled":
+			return boolComparisonToErr(field, value, egw.EdgeGateway.Configuration.FipsModeEnabled != nil &&
+				*egw.EdgeGateway.Configuration.FipsModeEnabled)
+		case "use_default_route_for_dns_relay":
+			return boolComparisonToErr(field, value, egw.EdgeGateway.Configuration.UseDefaultRouteForDNSRelay != nil &&
+				*egw.EdgeGateway.Configuration.UseDefaultRouteForDNSRelay)
 		}
 		return nil
 	}
@@ -339,6 +429,10 @@ resource "vcd_edgegateway_settings" "{{.EgwSettings}}" {
   fw_default_rule_logging_enabled = true
   fw_default_rule_action          = "deny"
 
+  ha_enabled        = true
+  syslog_enabled    = true
+  syslog_server_ips = ["192.168.30.50"]
+
   # The plan for vcd_edgegateway_settings may fail because of logging fields not being visible to tenants
   lifecycle {
     ignore_changes = [lb_logging_enabled, lb_loglevel]
@@ -374,5 +468,16 @@ resource "vcd_edgegateway_settings" "{{.EgwSettings}}" {
   fw_enabled                      = {{.FwEnabled}}
   fw_default_rule_logging_enabled = {{.FwRuleEnabled}}
   fw_default_rule_action          = "{{.FwRuleAction}}"
+
+  ha_enabled                      = {{.HaEnabled}}
+  fips_mode_enabled               = {{.FipsModeEnabled}}
+  use_default_route_for_dns_relay = {{.UseDefaultRoute}}
+
+  syslog_enabled    = true
+  syslog_protocol   = "udp"
+  syslog_server_ips = [{{.SyslogServerIps}}]
+
+  dns_enabled       = true
+  dns_forwarder_ips = [{{.DnsForwarderIps}}]
 }
 `
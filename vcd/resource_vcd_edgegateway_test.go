@@ -180,6 +180,9 @@ func TestAccVcdEdgeGatewayExternalNetworks(t *testing.T) {
 	}
 	configText := templateFill(testAccEdgeGatewayNetworks, params)
 
+	params["FuncName"] = t.Name() + "-step1"
+	configTextUpdate := templateFill(testAccEdgeGatewayNetworksUpdate, params)
+
 	params["FuncName"] = t.Name() + "-step2"
 	configText1 := templateFill(testAccEdgeGatewayNetworks2, params)
 
@@ -193,6 +196,10 @@ func TestAccVcdEdgeGatewayExternalNetworks(t *testing.T) {
 		return
 	}
 	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+	debugPrintf("#[DEBUG] CONFIGURATION update: %s", configTextUpdate)
+
+	// Edge gateway ID is cached to check that size and external network changes are done in place
+	cachedId := &testCachedFieldValue{}
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
@@ -201,6 +208,7 @@ func TestAccVcdEdgeGatewayExternalNetworks(t *testing.T) {
 			resource.TestStep{
 				Config: configText,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.cacheTestResourceFieldValue("vcd_edgegateway.egw", "id"),
 					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "name", "edge-with-complex-networks"),
 					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "description", "new edge gateway"),
 					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "configuration", "compact"),
@@ -276,6 +284,23 @@ func TestAccVcdEdgeGatewayExternalNetworks(t *testing.T) {
 					resource.TestCheckResourceAttrPair("vcd_edgegateway.egw", "use_default_route_for_dns_relay", "data.vcd_edgegateway.egw", "use_default_route_for_dns_relay"),
 				),
 			},
+			resource.TestStep{
+				Config: configTextUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.testCheckCachedResourceFieldValue("vcd_edgegateway.egw", "id"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "configuration", "full"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "ha_enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "default_external_network_ip", "192.168.30.51"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "external_network.#", "1"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "external_network.0.subnet.0.suballocate_pool.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcd_edgegateway.egw", "external_network.0.subnet.0.suballocate_pool.*", map[string]string{
+						"start_address": "192.168.30.61",
+						"end_address":   "192.168.30.62",
+					}),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "external_network_ips.#", "1"),
+					resource.TestCheckResourceAttr("vcd_edgegateway.egw", "external_network_ips.0", "192.168.30.51"),
+				),
+			},
 			resource.TestStep{
 				Taint:  []string{"vcd_edgegateway.egw"},
 				Config: configText1,
@@ -672,6 +697,59 @@ data "vcd_external_network" "ds-network" {
 }
 `
 
+const testAccEdgeGatewayNetworksUpdate = testAccEdgeGatewayComplexNetwork + `
+resource "vcd_edgegateway" "egw" {
+	org                     = "{{.Org}}"
+	vdc                     = "{{.Vdc}}"
+
+	name                    = "edge-with-complex-networks"
+	description             = "new edge gateway"
+	configuration           = "full"
+
+	# HA is enabled in place
+	ha_enabled                      = true
+	fips_mode_enabled               = false
+	use_default_route_for_dns_relay = true
+	distributed_routing             = false
+
+    lb_enabled              = "true"
+    lb_acceleration_enabled = "true"
+    lb_logging_enabled      = "true"
+    lb_loglevel             = "critical"
+
+    fw_enabled                      = "true"
+    fw_default_rule_logging_enabled = "true"
+    fw_default_rule_action          = "accept"
+
+	# The second external network is removed and one more pool is sub-allocated in place
+	external_network {
+	  name = vcd_external_network.{{.NewExternalNetwork}}.name
+  
+	  subnet {
+		ip_address = "192.168.30.51"
+		gateway = "192.168.30.49"
+		netmask = "255.255.255.240"
+		use_for_default_route = true
+
+		suballocate_pool {
+			start_address = "192.168.30.53"
+			end_address   = "192.168.30.55"
+		}
+
+		suballocate_pool {
+			start_address = "192.168.30.58"
+			end_address   = "192.168.30.60"
+		}
+
+		suballocate_pool {
+			start_address = "192.168.30.61"
+			end_address   = "192.168.30.62"
+		}
+	  }
+	}
+}
+`
+
 const testAccEdgeGatewayNetworks2 = testAccEdgeGatewayComplexNetwork + `
 resource "vcd_edgegateway" "egw" {
 	org                     = "{{.Org}}"
@@ -16,6 +16,9 @@ func resourceVcdEdgeGatewayVpn() *schema.Resource {
 		Read:   resourceVcdEdgeGatewayVpnRead,
 		Delete: resourceVcdEdgeGatewayVpnDelete,
 
+		DeprecationMessage: "vcd_edgegateway_vpn is deprecated and will be removed in future versions. " +
+			"Use vcd_nsxv_ipsec_vpn instead",
+
 		Schema: map[string]*schema.Schema{
 
 			"edge_gateway": &schema.Schema{
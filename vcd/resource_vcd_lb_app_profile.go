@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v2/types/v56"
 )
 
@@ -97,9 +98,6 @@ func resourceVcdLBAppProfile() *schema.Resource {
 					" address of a client connecting to a Web server through the load balancer. " +
 					"Only applies for types HTTP and HTTPS",
 			},
-			// TODO https://github.com/vmware/terraform-provider-vcd/issues/258
-			// This will not give much use without SSL certs being available. The only method to
-			// make use of it is by manually attaching certificates.
 			"enable_pool_side_ssl": &schema.Schema{
 				Type:     schema.TypeBool,
 				Default:  false,
@@ -107,6 +105,50 @@ func resourceVcdLBAppProfile() *schema.Resource {
 				Description: "Enable to define the certificate, CAs, or CRLs used to authenticate" +
 					" the load balancer from the server side",
 			},
+			"service_certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "ID of service certificate (vcd_nsxv_certificate) which is presented to clients " +
+					"for SSL offload. Only applies for type 'https'",
+			},
+			"service_ca_certificate_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CA certificates used to verify client certificates",
+			},
+			"service_crl_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CRLs used to verify client certificates",
+			},
+			"client_auth": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "required"}, false),
+				Description:  "Client authentication mode. One of 'ignore' or 'required'",
+			},
+			"pool_certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "ID of service certificate (vcd_nsxv_certificate) which the load balancer presents " +
+					"to pool members. Requires 'enable_pool_side_ssl'",
+			},
+			"pool_ca_certificate_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CA certificates used to verify pool member certificates. Server " +
+					"authentication is enforced when at least one is set",
+			},
+			"pool_crl_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of CRLs used to verify pool member certificates",
+			},
 		},
 	}
 }
@@ -126,17 +168,12 @@ func resourceVcdLBAppProfileCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("unable to create load balancer application profile type: %s", err)
 	}
 
-	createdPool, err := edgeGateway.CreateLbAppProfile(LBProfile)
+	createdId, err := createNsxvLbAppProfile(vcdClient, edgeGateway, LBProfile)
 	if err != nil {
 		return fmt.Errorf("error creating new load balancer application profile: %s", err)
 	}
 
-	// We store the values once again because response include pool member IDs
-	err = setLBAppProfileData(d, createdPool)
-	if err != nil {
-		return err
-	}
-	d.SetId(createdPool.ID)
+	d.SetId(createdId)
 	return resourceVcdLBAppProfileRead(d, meta)
 }
 
@@ -148,7 +185,7 @@ func resourceVcdLBAppProfileRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
 	}
 
-	readLBProfile, err := edgeGateway.GetLbAppProfileById(d.Id())
+	readLBProfile, err := getNsxvLbAppProfileById(vcdClient, edgeGateway, d.Id())
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("unable to find load balancer application profile with ID %s: %s", d.Id(), err)
@@ -168,21 +205,17 @@ func resourceVcdLBAppProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	updateLBProfileConfig, err := getLBAppProfileType(d)
-	updateLBProfileConfig.ID = d.Id() // We already know an ID for update and it allows to change name
 	if err != nil {
 		return fmt.Errorf("unable to create load balancer application profile type for update: %s", err)
 	}
+	updateLBProfileConfig.ID = d.Id() // We already know an ID for update and it allows to change name
 
-	updatedLBProfile, err := edgeGateway.UpdateLbAppProfile(updateLBProfileConfig)
+	err = updateNsxvLbAppProfile(vcdClient, edgeGateway, updateLBProfileConfig)
 	if err != nil {
 		return fmt.Errorf("unable to update load balancer application profile with ID %s: %s", d.Id(), err)
 	}
 
-	if err := setLBAppProfileData(d, updatedLBProfile); err != nil {
-		return err
-	}
-
-	return nil
+	return resourceVcdLBAppProfileRead(d, meta)
 }
 
 func resourceVcdLBAppProfileDelete(d *schema.ResourceData, meta interface{}) error {
@@ -195,7 +228,7 @@ func resourceVcdLBAppProfileDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
 	}
 
-	err = edgeGateway.DeleteLbAppProfileById(d.Id())
+	err = deleteNsxvLbAppProfile(vcdClient, edgeGateway, d.Id())
 	if err != nil {
 		return fmt.Errorf("error deleting load balancer application profile: %s", err)
 	}
@@ -224,7 +257,7 @@ func resourceVcdLBAppProfileImport(d *schema.ResourceData, meta interface{}) ([]
 		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
 	}
 
-	readLBProfile, err := edgeGateway.GetLbAppProfileByName(appProfileName)
+	readLBProfile, err := getNsxvLbAppProfileByName(vcdClient, edgeGateway, appProfileName)
 	if err != nil {
 		return []*schema.ResourceData{}, fmt.Errorf("unable to find load balancer application profile with name %s: %s",
 			d.Id(), err)
@@ -239,8 +272,8 @@ func resourceVcdLBAppProfileImport(d *schema.ResourceData, meta interface{}) ([]
 	return []*schema.ResourceData{d}, nil
 }
 
-func getLBAppProfileType(d *schema.ResourceData) (*types.LbAppProfile, error) {
-	LBProfile := &types.LbAppProfile{
+func getLBAppProfileType(d *schema.ResourceData) (*nsxvLbAppProfile, error) {
+	LBProfile := &nsxvLbAppProfile{
 		Name: d.Get("name").(string),
 		// Both cases can be sent, but vCD UI does not populate the field during edit
 		// properly if it is sent in lower case.
@@ -265,10 +298,44 @@ func getLBAppProfileType(d *schema.ResourceData) (*types.LbAppProfile, error) {
 		}
 	}
 
+	serviceCaCertificates := convertSchemaSetToSliceOfStrings(d.Get("service_ca_certificate_ids").(*schema.Set))
+	serviceCrls := convertSchemaSetToSliceOfStrings(d.Get("service_crl_ids").(*schema.Set))
+	if d.Get("service_certificate_id").(string) != "" || len(serviceCaCertificates) > 0 || len(serviceCrls) > 0 {
+		clientAuth := d.Get("client_auth").(string)
+		if clientAuth == "" {
+			clientAuth = "ignore"
+		}
+		LBProfile.ClientSsl = &nsxvLbClientSsl{
+			ClientAuth:         clientAuth,
+			ServiceCertificate: d.Get("service_certificate_id").(string),
+			CaCertificates:     serviceCaCertificates,
+			CrlCertificates:    serviceCrls,
+		}
+	}
+
+	poolCaCertificates := convertSchemaSetToSliceOfStrings(d.Get("pool_ca_certificate_ids").(*schema.Set))
+	poolCrls := convertSchemaSetToSliceOfStrings(d.Get("pool_crl_ids").(*schema.Set))
+	if d.Get("pool_certificate_id").(string) != "" || len(poolCaCertificates) > 0 || len(poolCrls) > 0 {
+		if !LBProfile.ServerSslEnabled {
+			return nil, fmt.Errorf("pool side certificates require 'enable_pool_side_ssl' to be set")
+		}
+		// Pool member certificates can only be verified when there is a CA to verify them with
+		serverAuth := "ignore"
+		if len(poolCaCertificates) > 0 {
+			serverAuth = "required"
+		}
+		LBProfile.ServerSsl = &nsxvLbServerSsl{
+			ServerAuth:         serverAuth,
+			ServiceCertificate: d.Get("pool_certificate_id").(string),
+			CaCertificates:     poolCaCertificates,
+			CrlCertificates:    poolCrls,
+		}
+	}
+
 	return LBProfile, nil
 }
 
-func setLBAppProfileData(d *schema.ResourceData, LBProfile *types.LbAppProfile) error {
+func setLBAppProfileData(d *schema.ResourceData, LBProfile *nsxvLbAppProfile) error {
 	d.Set("name", LBProfile.Name)
 	// The 'type' field is lowercased for 'd.Set()' because we want to be consistent
 	// and ask the same casing for type in all resources, but they behave differently.
@@ -298,5 +365,34 @@ func setLBAppProfileData(d *schema.ResourceData, LBProfile *types.LbAppProfile)
 		d.Set("http_redirect_url", "")
 	}
 
+	clientSsl := LBProfile.ClientSsl
+	if clientSsl == nil {
+		clientSsl = &nsxvLbClientSsl{}
+	}
+	d.Set("service_certificate_id", clientSsl.ServiceCertificate)
+	d.Set("client_auth", clientSsl.ClientAuth)
+	err := d.Set("service_ca_certificate_ids", schema.NewSet(schema.HashString, convertToTypeSet(clientSsl.CaCertificates)))
+	if err != nil {
+		return fmt.Errorf("unable to set 'service_ca_certificate_ids': %s", err)
+	}
+	err = d.Set("service_crl_ids", schema.NewSet(schema.HashString, convertToTypeSet(clientSsl.CrlCertificates)))
+	if err != nil {
+		return fmt.Errorf("unable to set 'service_crl_ids': %s", err)
+	}
+
+	serverSsl := LBProfile.ServerSsl
+	if serverSsl == nil {
+		serverSsl = &nsxvLbServerSsl{}
+	}
+	d.Set("pool_certificate_id", serverSsl.ServiceCertificate)
+	err = d.Set("pool_ca_certificate_ids", schema.NewSet(schema.HashString, convertToTypeSet(serverSsl.CaCertificates)))
+	if err != nil {
+		return fmt.Errorf("unable to set 'pool_ca_certificate_ids': %s", err)
+	}
+	err = d.Set("pool_crl_ids", schema.NewSet(schema.HashString, convertToTypeSet(serverSsl.CrlCertificates)))
+	if err != nil {
+		return fmt.Errorf("unable to set 'pool_crl_ids': %s", err)
+	}
+
 	return nil
 }
@@ -109,6 +109,17 @@ func TestAccVcdLbVirtualServer(t *testing.T) {
 					resource.TestMatchResourceAttr("data.vcd_lb_virtual_server.http", "app_rule_ids.0", regexp.MustCompile(`^applicationRule-\d*$`)),
 					resource.TestMatchResourceAttr("data.vcd_lb_virtual_server.http", "server_pool_id", regexp.MustCompile(`^pool-\d*$`)),
 					resource.TestCheckResourceAttr("data.vcd_lb_virtual_server.http", "app_profile_id", ""),
+
+					// Statistics data source
+					resource.TestMatchResourceAttr("data.vcd_lb_statistics.stats", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:lbStatistics$`)),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcd_lb_statistics.stats", "virtual_server.*", map[string]string{
+						"name":       t.Name() + "-step2",
+						"ip_address": params["EdgeGatewayIp"].(string),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcd_lb_statistics.stats", "pool.*", map[string]string{
+						"name":     "web-servers",
+						"member.#": "2",
+					}),
 				),
 			},
 		},
@@ -186,6 +197,13 @@ data "vcd_lb_virtual_server" "http" {
   name         = vcd_lb_virtual_server.http.name
   depends_on   = [vcd_lb_virtual_server.http]
 }
+
+data "vcd_lb_statistics" "stats" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  depends_on   = [vcd_lb_virtual_server.http]
+}
 `
 
 const testAccVcdLbVirtualServer_prereqs = `
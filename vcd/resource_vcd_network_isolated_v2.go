@@ -0,0 +1,223 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNetworkIsolatedV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNetworkIsolatedV2Create,
+		Read:   resourceVcdNetworkIsolatedV2Read,
+		Update: resourceVcdNetworkIsolatedV2Update,
+		Delete: resourceVcdNetworkIsolatedV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNetworkIsolatedV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Network name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Network description",
+			},
+			"gateway": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Gateway IP address",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"prefix_length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Network prefix length (e.g. 24 for 255.255.255.0)",
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"dns1": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 1",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns2": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 2",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS suffix",
+			},
+			"static_ip_pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP ranges used for static pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+		},
+	}
+}
+
+func resourceVcdNetworkIsolatedV2Create(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] isolated network V2 creation initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	networkType := getOpenApiOrgVdcIsolatedNetworkType(d, vdc)
+	orgNetwork, err := createOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 create] error creating Org VDC isolated network: %s", err)
+	}
+
+	d.SetId(orgNetwork.ID)
+
+	return resourceVcdNetworkIsolatedV2Read(d, meta)
+}
+
+func resourceVcdNetworkIsolatedV2Update(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] isolated network V2 update initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	networkType := getOpenApiOrgVdcIsolatedNetworkType(d, vdc)
+	networkType.ID = d.Id()
+
+	_, err = updateOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 update] error updating Org VDC network: %s", err)
+	}
+
+	return resourceVcdNetworkIsolatedV2Read(d, meta)
+}
+
+func resourceVcdNetworkIsolatedV2Read(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] isolated network V2 read initiated")
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkById(vcdClient, d.Id())
+	// If the network is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] Org VDC network with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 read] error getting Org VDC network: %s", err)
+	}
+
+	err = setOpenApiOrgVdcIsolatedNetworkData(d, orgNetwork)
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 read] error setting Org VDC network data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNetworkIsolatedV2Delete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] isolated network V2 deletion initiated")
+
+	err := deleteOpenApiOrgVdcNetwork(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[isolated network v2 delete] error deleting Org VDC network: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNetworkIsolatedV2Import is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_network_isolated_v2.my-network
+// Example import path (_the_id_string_): org.vdc.my-network
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNetworkIsolatedV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[isolated network v2 import] resource name must be specified as org-name.vdc-name.network-name")
+	}
+	orgName, vdcName, networkName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[isolated network v2 import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkByName(vcdClient, vdc, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("[isolated network v2 import] error reading network with name '%s': %s", networkName, err)
+	}
+
+	if orgNetwork.NetworkType != openApiOrgVdcNetworkTypeIsolated {
+		return nil, fmt.Errorf("[isolated network v2 import] Org VDC network with name '%s' found, but is not of type Isolated (type is '%s')",
+			networkName, orgNetwork.NetworkType)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(orgNetwork.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setOpenApiOrgVdcIsolatedNetworkData stores isolated Org VDC network structure in Terraform schema
+func setOpenApiOrgVdcIsolatedNetworkData(d *schema.ResourceData, orgVdcNetwork *openApiOrgVdcNetwork) error {
+	_ = d.Set("name", orgVdcNetwork.Name)
+	_ = d.Set("description", orgVdcNetwork.Description)
+
+	return setOpenApiOrgVdcNetworkSubnetData(d, orgVdcNetwork)
+}
+
+// getOpenApiOrgVdcIsolatedNetworkType converts Terraform schema into isolated Org VDC network structure
+func getOpenApiOrgVdcIsolatedNetworkType(d *schema.ResourceData, vdc *govcd.Vdc) *openApiOrgVdcNetwork {
+	return &openApiOrgVdcNetwork{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		OrgVdc:      &openApiReference{ID: vdc.Vdc.ID},
+		NetworkType: openApiOrgVdcNetworkTypeIsolated,
+		Subnets:     getOpenApiOrgVdcNetworkSubnetsType(d),
+	}
+}
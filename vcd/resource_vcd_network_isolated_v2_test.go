@@ -0,0 +1,158 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVcdNetworkIsolatedV2Nsxt(t *testing.T) {
+	skipNoNsxtVdcConfiguration(t)
+
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"NsxtVdc":     testConfig.Nsxt.Vdc,
+		"NetworkName": t.Name(),
+		"Tags":        "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNetworkIsolatedV2NsxtStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["NetworkName"] = t.Name() + "-updated"
+	configText2 := templateFill(testAccVcdNetworkIsolatedV2NsxtStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcdNetworkIsolatedV2NsxtStep3, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_network_isolated_v2.net1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOpenApiVcdNetworkDestroy(testConfig.Nsxt.Vdc, t.Name()+"-updated"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "NSX-T isolated network test"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "2.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "dns1", ""),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "2.1.1.10",
+						"end_address":   "2.1.1.20",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated NSX-T isolated network test"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "2.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "dns1", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "dns2", "8.8.4.4"),
+					resource.TestCheckResourceAttr(resourceName, "dns_suffix", "example.org"),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "2.1.1.10",
+						"end_address":   "2.1.1.30",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "2.1.1.100",
+						"end_address":   "2.1.1.103",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()+"-updated"),
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcd_network_isolated_v2.ds-name", []string{"%"}),
+					resourceFieldsEqual(resourceName, "data.vcd_network_isolated_v2.ds-filter", []string{"%"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcdNetworkIsolatedV2NsxtStep1 = `
+resource "vcd_network_isolated_v2" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "NSX-T isolated network test"
+
+  gateway       = "2.1.1.1"
+  prefix_length = 24
+
+  static_ip_pool {
+    start_address = "2.1.1.10"
+    end_address   = "2.1.1.20"
+  }
+}
+`
+
+const testAccVcdNetworkIsolatedV2NsxtStep2 = `
+resource "vcd_network_isolated_v2" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "updated NSX-T isolated network test"
+
+  gateway       = "2.1.1.1"
+  prefix_length = 24
+  dns1          = "8.8.8.8"
+  dns2          = "8.8.4.4"
+  dns_suffix    = "example.org"
+
+  static_ip_pool {
+    start_address = "2.1.1.10"
+    end_address   = "2.1.1.30"
+  }
+
+  static_ip_pool {
+    start_address = "2.1.1.100"
+    end_address   = "2.1.1.103"
+  }
+}
+`
+
+const testAccVcdNetworkIsolatedV2NsxtStep3 = testAccVcdNetworkIsolatedV2NsxtStep2 + `
+data "vcd_network_isolated_v2" "ds-name" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = vcd_network_isolated_v2.net1.name
+}
+
+data "vcd_network_isolated_v2" "ds-filter" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  filter {
+    name_regex = "^${vcd_network_isolated_v2.net1.name}$"
+  }
+}
+`
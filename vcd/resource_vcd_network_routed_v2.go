@@ -0,0 +1,308 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNetworkRoutedV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNetworkRoutedV2Create,
+		Read:   resourceVcdNetworkRoutedV2Read,
+		Update: resourceVcdNetworkRoutedV2Update,
+		Delete: resourceVcdNetworkRoutedV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNetworkRoutedV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Network name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Network description",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Edge gateway ID in which Routed network should be located",
+			},
+			"interface_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "internal",
+				Description:  "Optional interface type (only for NSX-V networks). One of 'internal' (default), 'subinterface', 'distributed'",
+				ValidateFunc: validation.StringInSlice([]string{"internal", "subinterface", "distributed"}, true),
+				// Interface type is case insensitive in API
+				DiffSuppressFunc: suppressCase,
+			},
+			"gateway": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Gateway IP address",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"prefix_length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Network prefix length (e.g. 24 for 255.255.255.0)",
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"dns1": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 1",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns2": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 2",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS suffix",
+			},
+			"static_ip_pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP ranges used for static pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+		},
+	}
+}
+
+func resourceVcdNetworkRoutedV2Create(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] routed network V2 creation initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	networkType := getOpenApiOrgVdcRoutedNetworkType(d, vdc)
+	orgNetwork, err := createOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[routed network v2 create] error creating Org VDC routed network: %s", err)
+	}
+
+	d.SetId(orgNetwork.ID)
+
+	return resourceVcdNetworkRoutedV2Read(d, meta)
+}
+
+func resourceVcdNetworkRoutedV2Update(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] routed network V2 update initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	networkType := getOpenApiOrgVdcRoutedNetworkType(d, vdc)
+	networkType.ID = d.Id()
+
+	_, err = updateOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[routed network v2 update] error updating Org VDC network: %s", err)
+	}
+
+	return resourceVcdNetworkRoutedV2Read(d, meta)
+}
+
+func resourceVcdNetworkRoutedV2Read(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] routed network V2 read initiated")
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkById(vcdClient, d.Id())
+	// If the network is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] Org VDC network with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[routed network v2 read] error getting Org VDC network: %s", err)
+	}
+
+	err = setOpenApiOrgVdcRoutedNetworkData(d, orgNetwork)
+	if err != nil {
+		return fmt.Errorf("[routed network v2 read] error setting Org VDC network data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNetworkRoutedV2Delete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] routed network V2 deletion initiated")
+
+	err := deleteOpenApiOrgVdcNetwork(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[routed network v2 delete] error deleting Org VDC network: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNetworkRoutedV2Import is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_network_routed_v2.my-network
+// Example import path (_the_id_string_): org.vdc.my-network
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNetworkRoutedV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[routed network v2 import] resource name must be specified as org-name.vdc-name.network-name")
+	}
+	orgName, vdcName, networkName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[routed network v2 import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkByName(vcdClient, vdc, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("[routed network v2 import] error reading network with name '%s': %s", networkName, err)
+	}
+
+	if orgNetwork.NetworkType != openApiOrgVdcNetworkTypeRouted {
+		return nil, fmt.Errorf("[routed network v2 import] Org VDC network with name '%s' found, but is not of type Routed (type is '%s')",
+			networkName, orgNetwork.NetworkType)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(orgNetwork.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setOpenApiOrgVdcRoutedNetworkData stores routed Org VDC network structure in Terraform schema
+func setOpenApiOrgVdcRoutedNetworkData(d *schema.ResourceData, orgVdcNetwork *openApiOrgVdcNetwork) error {
+	_ = d.Set("name", orgVdcNetwork.Name)
+	_ = d.Set("description", orgVdcNetwork.Description)
+
+	if orgVdcNetwork.Connection != nil {
+		_ = d.Set("edge_gateway_id", orgVdcNetwork.Connection.RouterRef.ID)
+		_ = d.Set("interface_type", strings.ToLower(orgVdcNetwork.Connection.ConnectionType))
+	}
+
+	return setOpenApiOrgVdcNetworkSubnetData(d, orgVdcNetwork)
+}
+
+// setOpenApiOrgVdcNetworkSubnetData stores IP configuration (gateway, prefix, DNS and static IP pools) of an Org VDC
+// network in Terraform schema. Only the first subnet is used because routed and isolated networks have exactly one.
+func setOpenApiOrgVdcNetworkSubnetData(d *schema.ResourceData, orgVdcNetwork *openApiOrgVdcNetwork) error {
+	if len(orgVdcNetwork.Subnets.Values) == 0 {
+		return fmt.Errorf("Org VDC network '%s' has no subnets", orgVdcNetwork.Name)
+	}
+	subnet := orgVdcNetwork.Subnets.Values[0]
+
+	_ = d.Set("gateway", subnet.Gateway)
+	_ = d.Set("prefix_length", subnet.PrefixLength)
+	_ = d.Set("dns1", subnet.DNSServer1)
+	_ = d.Set("dns2", subnet.DNSServer2)
+	_ = d.Set("dns_suffix", subnet.DNSSuffix)
+
+	ipRangeSlice := make([]interface{}, len(subnet.IPRanges.Values))
+	for index, ipRange := range subnet.IPRanges.Values {
+		ipRangeMap := make(map[string]interface{})
+		ipRangeMap["start_address"] = ipRange.StartAddress
+		ipRangeMap["end_address"] = ipRange.EndAddress
+
+		ipRangeSlice[index] = ipRangeMap
+	}
+	ipRangeSet := schema.NewSet(schema.HashResource(networkV2IpRange), ipRangeSlice)
+
+	err := d.Set("static_ip_pool", ipRangeSet)
+	if err != nil {
+		return fmt.Errorf("error setting 'static_ip_pool': %s", err)
+	}
+
+	return nil
+}
+
+// getOpenApiOrgVdcRoutedNetworkType converts Terraform schema into routed Org VDC network structure
+func getOpenApiOrgVdcRoutedNetworkType(d *schema.ResourceData, vdc *govcd.Vdc) *openApiOrgVdcNetwork {
+	return &openApiOrgVdcNetwork{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		OrgVdc:      &openApiReference{ID: vdc.Vdc.ID},
+		NetworkType: openApiOrgVdcNetworkTypeRouted,
+		Connection: &openApiOrgVdcNetworkConnection{
+			RouterRef:      openApiReference{ID: d.Get("edge_gateway_id").(string)},
+			ConnectionType: strings.ToUpper(d.Get("interface_type").(string)),
+		},
+		Subnets: getOpenApiOrgVdcNetworkSubnetsType(d),
+	}
+}
+
+// getOpenApiOrgVdcNetworkSubnetsType converts IP configuration of Terraform schema into a single subnet structure
+func getOpenApiOrgVdcNetworkSubnetsType(d *schema.ResourceData) openApiOrgVdcNetworkSubnets {
+	return openApiOrgVdcNetworkSubnets{
+		Values: []openApiOrgVdcNetworkSubnet{
+			openApiOrgVdcNetworkSubnet{
+				Gateway:      d.Get("gateway").(string),
+				PrefixLength: d.Get("prefix_length").(int),
+				DNSServer1:   d.Get("dns1").(string),
+				DNSServer2:   d.Get("dns2").(string),
+				DNSSuffix:    d.Get("dns_suffix").(string),
+				IPRanges:     openApiIpRanges{Values: getOpenApiIpRangesType(d.Get("static_ip_pool").(*schema.Set))},
+			},
+		},
+	}
+}
+
+// getOpenApiIpRangesType converts a set of 'start_address' and 'end_address' blocks into a slice of IP ranges
+func getOpenApiIpRangesType(ipRangeSet *schema.Set) []openApiIpRange {
+	ipRanges := ipRangeSet.List()
+	ipRangeSlice := make([]openApiIpRange, len(ipRanges))
+	for index, ipRange := range ipRanges {
+		ipRangeStrings := convertToStringMap(ipRange.(map[string]interface{}))
+		ipRangeSlice[index] = openApiIpRange{
+			StartAddress: ipRangeStrings["start_address"],
+			EndAddress:   ipRangeStrings["end_address"],
+		}
+	}
+	return ipRangeSlice
+}
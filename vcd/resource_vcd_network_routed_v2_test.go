@@ -0,0 +1,197 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNetworkRoutedV2Nsxt(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"NsxtVdc":     testConfig.Nsxt.Vdc,
+		"EdgeGw":      testConfig.Nsxt.EdgeGateway,
+		"NetworkName": t.Name(),
+		"Tags":        "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNetworkRoutedV2NsxtStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccVcdNetworkRoutedV2NsxtStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcdNetworkRoutedV2NsxtStep3, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_network_routed_v2.net1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOpenApiVcdNetworkDestroy(testConfig.Nsxt.Vdc, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "NSX-T routed network test OpenAPI"),
+					resource.TestCheckResourceAttrPair(resourceName, "edge_gateway_id", "data.vcd_nsxt_edgegateway.existing", "id"),
+					resource.TestCheckResourceAttr(resourceName, "interface_type", "internal"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.10",
+						"end_address":   "1.1.1.20",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated NSX-T routed network test OpenAPI"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "dns1", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "dns2", "8.8.4.4"),
+					resource.TestCheckResourceAttr(resourceName, "dns_suffix", "example.org"),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.10",
+						"end_address":   "1.1.1.20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.100",
+						"end_address":   "1.1.1.103",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()),
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcd_network_routed_v2.ds-name", []string{"%"}),
+					resourceFieldsEqual(resourceName, "data.vcd_network_routed_v2.ds-filter", []string{"%"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcdNetworkRoutedV2NsxtEdge = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.EdgeGw}}"
+}
+`
+
+const testAccVcdNetworkRoutedV2NsxtStep1 = testAccVcdNetworkRoutedV2NsxtEdge + `
+resource "vcd_network_routed_v2" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "NSX-T routed network test OpenAPI"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  gateway       = "1.1.1.1"
+  prefix_length = 24
+
+  static_ip_pool {
+    start_address = "1.1.1.10"
+    end_address   = "1.1.1.20"
+  }
+}
+`
+
+const testAccVcdNetworkRoutedV2NsxtStep2 = testAccVcdNetworkRoutedV2NsxtEdge + `
+resource "vcd_network_routed_v2" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "updated NSX-T routed network test OpenAPI"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  gateway       = "1.1.1.1"
+  prefix_length = 24
+  dns1          = "8.8.8.8"
+  dns2          = "8.8.4.4"
+  dns_suffix    = "example.org"
+
+  static_ip_pool {
+    start_address = "1.1.1.10"
+    end_address   = "1.1.1.20"
+  }
+
+  static_ip_pool {
+    start_address = "1.1.1.100"
+    end_address   = "1.1.1.103"
+  }
+}
+`
+
+const testAccVcdNetworkRoutedV2NsxtStep3 = testAccVcdNetworkRoutedV2NsxtStep2 + `
+data "vcd_network_routed_v2" "ds-name" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = vcd_network_routed_v2.net1.name
+}
+
+data "vcd_network_routed_v2" "ds-filter" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  filter {
+    name_regex = "^${vcd_network_routed_v2.net1.name}$"
+  }
+}
+`
+
+// testAccCheckOpenApiVcdNetworkDestroy checks that Org VDC network with given name no longer exists in a VDC. It
+// suits all OpenAPI based network resources
+func testAccCheckOpenApiVcdNetworkDestroy(vdcName, networkName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		_, err = getOpenApiOrgVdcNetworkByName(conn, vdc, networkName)
+		if err == nil {
+			return fmt.Errorf("network %s was not deleted", networkName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("error checking if network %s was deleted: %s", networkName, err)
+		}
+
+		return nil
+	}
+}
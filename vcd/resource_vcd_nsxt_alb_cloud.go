@@ -0,0 +1,204 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtAlbCloud() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbCloudCreate,
+		Read:   resourceVcdNsxtAlbCloudRead,
+		Update: resourceVcdNsxtAlbCloudUpdate,
+		Delete: resourceVcdNsxtAlbCloudDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbCloudImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "NSX-T ALB Cloud name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "NSX-T ALB Cloud description",
+			},
+			"controller_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of NSX-T ALB Controller",
+			},
+			"importable_cloud_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of NSX-T cloud defined in NSX-T ALB Controller which is imported",
+			},
+			"importable_cloud_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of NSX-T cloud defined in NSX-T ALB Controller which is imported",
+			},
+			"network_pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Network pool ID for NSX-T ALB Cloud. Network pool of importable cloud is used when not set",
+			},
+			"backing_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backing type of NSX-T ALB Cloud",
+			},
+			"health_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health status of NSX-T ALB Cloud",
+			},
+			"health_message": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Detailed health message of NSX-T ALB Cloud",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbCloudCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB cloud creation initiated")
+
+	if !vcdClient.Client.IsSysAdmin {
+		return fmt.Errorf("this resource requires System user")
+	}
+
+	controllerId := d.Get("controller_id").(string)
+	importableCloudName := d.Get("importable_cloud_name").(string)
+	importableCloud, err := getNsxtAlbImportableCloudByName(vcdClient, controllerId, importableCloudName)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud create] unable to find importable cloud '%s': %s", importableCloudName, err)
+	}
+
+	networkPoolId := d.Get("network_pool_id").(string)
+	if networkPoolId == "" && importableCloud.NetworkPoolRef != nil {
+		networkPoolId = importableCloud.NetworkPoolRef.ID
+	}
+
+	cloud := &nsxtAlbCloud{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		LoadBalancerCloudBacking: nsxtAlbCloudBacking{
+			BackingId:                 importableCloud.ID,
+			LoadBalancerControllerRef: &openApiReference{ID: controllerId},
+		},
+		NetworkPoolRef: &openApiReference{ID: networkPoolId},
+	}
+
+	createdCloud, err := createNsxtAlbCloud(vcdClient, cloud)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud create] error creating NSX-T ALB cloud: %s", err)
+	}
+
+	d.SetId(createdCloud.ID)
+
+	return resourceVcdNsxtAlbCloudRead(d, meta)
+}
+
+func resourceVcdNsxtAlbCloudUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB cloud update initiated")
+
+	// Backing details cannot be changed, but must be sent as they are in update request
+	cloud, err := getNsxtAlbCloudById(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud update] error retrieving NSX-T ALB cloud: %s", err)
+	}
+
+	cloud.Name = d.Get("name").(string)
+	cloud.Description = d.Get("description").(string)
+
+	_, err = updateNsxtAlbCloud(vcdClient, cloud)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud update] error updating NSX-T ALB cloud: %s", err)
+	}
+
+	return resourceVcdNsxtAlbCloudRead(d, meta)
+}
+
+func resourceVcdNsxtAlbCloudRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB cloud read initiated")
+
+	cloud, err := getNsxtAlbCloudById(vcdClient, d.Id())
+	// If the cloud is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB cloud with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud read] error retrieving NSX-T ALB cloud: %s", err)
+	}
+
+	_ = d.Set("name", cloud.Name)
+	_ = d.Set("description", cloud.Description)
+	_ = d.Set("importable_cloud_id", cloud.LoadBalancerCloudBacking.BackingId)
+	_ = d.Set("backing_type", cloud.LoadBalancerCloudBacking.BackingType)
+	_ = d.Set("health_status", cloud.HealthStatus)
+	_ = d.Set("health_message", cloud.DetailedHealthMessage)
+	if cloud.LoadBalancerCloudBacking.LoadBalancerControllerRef != nil {
+		_ = d.Set("controller_id", cloud.LoadBalancerCloudBacking.LoadBalancerControllerRef.ID)
+	}
+	if cloud.NetworkPoolRef != nil {
+		_ = d.Set("network_pool_id", cloud.NetworkPoolRef.ID)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtAlbCloudDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB cloud deletion initiated")
+
+	err := deleteNsxtAlbCloud(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb cloud delete] error deleting NSX-T ALB cloud: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbCloudImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains the name of NSX-T ALB cloud
+// 3. The function looks up the object by name
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_cloud.my-cloud
+// Example import path (_the_id_string_): my-alb-cloud-name
+//
+// Note: importable cloud name is not stored in VCD and 'importable_cloud_name' is not populated after import
+func resourceVcdNsxtAlbCloudImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vcdClient := meta.(*VCDClient)
+
+	cloud, err := getNsxtAlbCloudByName(vcdClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb cloud import] unable to find NSX-T ALB cloud '%s': %s", d.Id(), err)
+	}
+
+	d.SetId(cloud.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,172 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtAlbController() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbControllerCreate,
+		Read:   resourceVcdNsxtAlbControllerRead,
+		Update: resourceVcdNsxtAlbControllerUpdate,
+		Delete: resourceVcdNsxtAlbControllerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbControllerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "NSX-T ALB Controller name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "NSX-T ALB Controller description",
+			},
+			"url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of NSX-T ALB Controller",
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username for NSX-T ALB Controller",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password for NSX-T ALB Controller",
+			},
+			"license_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "NSX-T ALB License type. One of 'BASIC', 'ENTERPRISE'",
+				ValidateFunc: validation.StringInSlice([]string{"BASIC", "ENTERPRISE"}, false),
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NSX-T ALB Controller version",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbControllerCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB controller creation initiated")
+
+	if !vcdClient.Client.IsSysAdmin {
+		return fmt.Errorf("this resource requires System user")
+	}
+
+	controller, err := createNsxtAlbController(vcdClient, getNsxtAlbControllerType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt alb controller create] error creating NSX-T ALB controller: %s", err)
+	}
+
+	d.SetId(controller.ID)
+
+	return resourceVcdNsxtAlbControllerRead(d, meta)
+}
+
+func resourceVcdNsxtAlbControllerUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB controller update initiated")
+
+	controller := getNsxtAlbControllerType(d)
+	controller.ID = d.Id()
+
+	_, err := updateNsxtAlbController(vcdClient, controller)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb controller update] error updating NSX-T ALB controller: %s", err)
+	}
+
+	return resourceVcdNsxtAlbControllerRead(d, meta)
+}
+
+func resourceVcdNsxtAlbControllerRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB controller read initiated")
+
+	controller, err := getNsxtAlbControllerById(vcdClient, d.Id())
+	// If the controller is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB controller with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb controller read] error retrieving NSX-T ALB controller: %s", err)
+	}
+
+	_ = d.Set("name", controller.Name)
+	_ = d.Set("description", controller.Description)
+	_ = d.Set("url", controller.Url)
+	_ = d.Set("username", controller.Username)
+	_ = d.Set("license_type", controller.LicenseType)
+	_ = d.Set("version", controller.Version)
+
+	return nil
+}
+
+func resourceVcdNsxtAlbControllerDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB controller deletion initiated")
+
+	err := deleteNsxtAlbController(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb controller delete] error deleting NSX-T ALB controller: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbControllerImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains the name of NSX-T ALB controller
+// 3. The function looks up the object by name
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_controller.my-controller
+// Example import path (_the_id_string_): my-controller-name
+//
+// Note: 'password' is not returned by VCD and is not populated after import
+func resourceVcdNsxtAlbControllerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vcdClient := meta.(*VCDClient)
+
+	controller, err := getNsxtAlbControllerByName(vcdClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb controller import] unable to find NSX-T ALB controller '%s': %s", d.Id(), err)
+	}
+
+	d.SetId(controller.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtAlbControllerType converts Terraform schema into NSX-T ALB controller structure
+func getNsxtAlbControllerType(d *schema.ResourceData) *nsxtAlbController {
+	return &nsxtAlbController{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Url:         d.Get("url").(string),
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+		LicenseType: d.Get("license_type").(string),
+	}
+}
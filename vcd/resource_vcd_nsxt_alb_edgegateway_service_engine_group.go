@@ -0,0 +1,223 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupCreate,
+		Read:   resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupRead,
+		Update: resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupUpdate,
+		Delete: resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge Gateway ID in which ALB Service Engine Group should be assigned",
+			},
+			"service_engine_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service Engine Group ID to assign to Edge Gateway",
+			},
+			"service_engine_group_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service Engine Group Name which is assigned to Edge Gateway",
+			},
+			"max_virtual_services": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of virtual services to be used in this Service Engine Group (only for 'SHARED' reservation model)",
+			},
+			"reserved_virtual_services": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of reserved virtual services for this Edge Gateway (only for 'SHARED' reservation model)",
+			},
+			"deployed_virtual_services": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of deployed virtual services in this Service Engine Group",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group assignment creation initiated")
+
+	if !vcdClient.Client.IsSysAdmin {
+		return fmt.Errorf("this resource requires System user")
+	}
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	assignment, err := createNsxtAlbServiceEngineGroupAssignment(vcdClient, getNsxtAlbServiceEngineGroupAssignmentType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt alb edge gateway service engine group create] error assigning service engine group: %s", err)
+	}
+
+	d.SetId(assignment.ID)
+
+	return resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupRead(d, meta)
+}
+
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group assignment update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	assignment := getNsxtAlbServiceEngineGroupAssignmentType(d)
+	assignment.ID = d.Id()
+
+	_, err := updateNsxtAlbServiceEngineGroupAssignment(vcdClient, assignment)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb edge gateway service engine group update] error updating service engine group assignment: %s", err)
+	}
+
+	return resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupRead(d, meta)
+}
+
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group assignment read initiated")
+
+	assignment, err := getNsxtAlbServiceEngineGroupAssignmentById(vcdClient, d.Id())
+	// If the assignment is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB service engine group assignment with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb edge gateway service engine group read] error retrieving service engine group assignment: %s", err)
+	}
+
+	if assignment.GatewayRef != nil {
+		_ = d.Set("edge_gateway_id", assignment.GatewayRef.ID)
+	}
+	if assignment.ServiceEngineGroupRef != nil {
+		_ = d.Set("service_engine_group_id", assignment.ServiceEngineGroupRef.ID)
+		_ = d.Set("service_engine_group_name", assignment.ServiceEngineGroupRef.Name)
+	}
+	if assignment.MaxVirtualServices != nil {
+		_ = d.Set("max_virtual_services", *assignment.MaxVirtualServices)
+	}
+	if assignment.MinVirtualServices != nil {
+		_ = d.Set("reserved_virtual_services", *assignment.MinVirtualServices)
+	}
+	if assignment.NumDeployedVirtualServices != nil {
+		_ = d.Set("deployed_virtual_services", *assignment.NumDeployedVirtualServices)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group assignment deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtAlbServiceEngineGroupAssignment(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb edge gateway service engine group delete] error removing service engine group assignment: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_edgegateway_service_engine_group.my-assignment
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.service-engine-group-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtAlbEdgeGatewayServiceEngineGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt alb edge gateway service engine group import] resource name must be specified as " +
+			"org-name.vdc-name.edge-gw-name.service-engine-group-name")
+	}
+	orgName, vdcName, edgeName, groupName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb edge gateway service engine group import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb edge gateway service engine group import] unable to find NSX-T edge gateway '%s': %s",
+			edgeName, err)
+	}
+
+	assignment, err := getNsxtAlbServiceEngineGroupAssignmentByName(vcdClient, edgeGateway.ID, groupName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb edge gateway service engine group import] unable to find assignment of service engine group '%s': %s",
+			groupName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(assignment.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtAlbServiceEngineGroupAssignmentType converts Terraform schema into service engine group assignment structure
+func getNsxtAlbServiceEngineGroupAssignmentType(d *schema.ResourceData) *nsxtAlbServiceEngineGroupAssignment {
+	assignment := &nsxtAlbServiceEngineGroupAssignment{
+		GatewayRef:            &openApiReference{ID: d.Get("edge_gateway_id").(string)},
+		ServiceEngineGroupRef: &openApiReference{ID: d.Get("service_engine_group_id").(string)},
+	}
+
+	// Virtual service limits are only applicable for 'SHARED' reservation model
+	if maxVirtualServices, isSet := d.GetOk("max_virtual_services"); isSet {
+		assignment.MaxVirtualServices = takeIntPointer(maxVirtualServices.(int))
+	}
+	if reservedVirtualServices, isSet := d.GetOk("reserved_virtual_services"); isSet {
+		assignment.MinVirtualServices = takeIntPointer(reservedVirtualServices.(int))
+	}
+
+	return assignment
+}
@@ -0,0 +1,401 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtAlbPoolMemberSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Defines if pool member accepts traffic. Default 'true'",
+		},
+		"ip_address": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of pool member",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"port": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Member port. Pool 'default_port' is used when not set",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"ratio": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Ratio of selecting eligible servers in the pool. Default '1'",
+			ValidateFunc: validation.IntBetween(1, 20),
+		},
+	},
+}
+
+var nsxtAlbPoolHealthMonitorSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Type of health monitor. One of 'HTTP', 'HTTPS', 'TCP', 'UDP', 'PING'",
+			ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "TCP", "UDP", "PING"}, false),
+		},
+	},
+}
+
+func resourceVcdNsxtAlbPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbPoolCreate,
+		Read:   resourceVcdNsxtAlbPoolRead,
+		Update: resourceVcdNsxtAlbPoolUpdate,
+		Delete: resourceVcdNsxtAlbPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbPoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which ALB Pool should be created",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of ALB Pool",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of ALB Pool",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Defines if ALB Pool is enabled. Default 'true'",
+			},
+			"algorithm": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "LEAST_CONNECTIONS",
+				Description: "Load balancing algorithm. Default 'LEAST_CONNECTIONS'",
+				ValidateFunc: validation.StringInSlice([]string{"LEAST_CONNECTIONS", "ROUND_ROBIN", "CONSISTENT_HASH",
+					"FASTEST_RESPONSE", "LEAST_LOAD", "FEWEST_SERVERS", "RANDOM", "FEWEST_TASKS", "CORE_AFFINITY"}, false),
+			},
+			"default_port": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      80,
+				Description:  "Destination server port used by the traffic sent to the member. Default '80'",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"graceful_timeout_period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "Maximum time in minutes to gracefully disable pool member ('-1' means infinite, '0' - immediate). Default '1'",
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"member": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of ALB Pool Members",
+				Elem:        nsxtAlbPoolMemberSchema,
+			},
+			"health_monitor": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of health monitors to use for checking pool members",
+				Elem:        nsxtAlbPoolHealthMonitorSchema,
+			},
+			"member_status": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Runtime status of each pool member",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of pool member",
+						},
+						"port": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Port of pool member",
+						},
+						"health_status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of pool member",
+						},
+						"detailed_health_message": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Detailed health message of pool member",
+						},
+						"marked_down_by": &schema.Schema{
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Names of health monitors which marked the member as down",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"member_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of members in the pool",
+			},
+			"enabled_member_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of enabled members in the pool",
+			},
+			"up_member_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of members in the pool which are up",
+			},
+			"health_message": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health message of the pool",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB pool creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	pool, err := createNsxtAlbPool(vcdClient, getNsxtAlbPoolType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt alb pool create] error creating ALB pool '%s': %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(pool.ID)
+
+	return resourceVcdNsxtAlbPoolRead(d, meta)
+}
+
+func resourceVcdNsxtAlbPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB pool update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	pool := getNsxtAlbPoolType(d)
+	pool.ID = d.Id()
+
+	_, err := updateNsxtAlbPool(vcdClient, pool)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb pool update] error updating ALB pool '%s': %s", pool.Name, err)
+	}
+
+	return resourceVcdNsxtAlbPoolRead(d, meta)
+}
+
+func resourceVcdNsxtAlbPoolRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB pool read initiated")
+
+	pool, err := getNsxtAlbPoolById(vcdClient, d.Id())
+	// If the pool is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB pool with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb pool read] error retrieving ALB pool: %s", err)
+	}
+
+	return setNsxtAlbPoolData(d, pool)
+}
+
+func resourceVcdNsxtAlbPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB pool deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtAlbPool(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb pool delete] error deleting ALB pool: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbPoolImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_pool.my-pool
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.pool-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtAlbPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt alb pool import] resource name must be specified as org-name.vdc-name.edge-gw-name.pool-name")
+	}
+	orgName, vdcName, edgeName, poolName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb pool import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb pool import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	pool, err := getNsxtAlbPoolByName(vcdClient, edgeGateway.ID, poolName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb pool import] unable to find ALB pool '%s': %s", poolName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(pool.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtAlbPoolType converts Terraform schema into ALB pool structure
+func getNsxtAlbPoolType(d *schema.ResourceData) *nsxtAlbPool {
+	pool := &nsxtAlbPool{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Enabled:               takeBoolPointer(d.Get("enabled").(bool)),
+		Algorithm:             d.Get("algorithm").(string),
+		DefaultPort:           takeIntPointer(d.Get("default_port").(int)),
+		GracefulTimeoutPeriod: takeIntPointer(d.Get("graceful_timeout_period").(int)),
+		GatewayRef:            openApiReference{ID: d.Get("edge_gateway_id").(string)},
+	}
+
+	memberSet := d.Get("member").(*schema.Set)
+	pool.Members = make([]nsxtAlbPoolMember, memberSet.Len())
+	for index, member := range memberSet.List() {
+		memberMap := member.(map[string]interface{})
+		pool.Members[index] = nsxtAlbPoolMember{
+			Enabled:   memberMap["enabled"].(bool),
+			IpAddress: memberMap["ip_address"].(string),
+			Port:      memberMap["port"].(int),
+			Ratio:     takeIntPointer(memberMap["ratio"].(int)),
+		}
+	}
+
+	healthMonitorSet := d.Get("health_monitor").(*schema.Set)
+	pool.HealthMonitors = make([]nsxtAlbPoolHealthMonitor, healthMonitorSet.Len())
+	for index, healthMonitor := range healthMonitorSet.List() {
+		healthMonitorMap := healthMonitor.(map[string]interface{})
+		pool.HealthMonitors[index] = nsxtAlbPoolHealthMonitor{Type: healthMonitorMap["type"].(string)}
+	}
+
+	return pool
+}
+
+// setNsxtAlbPoolData stores ALB pool structure in Terraform schema
+func setNsxtAlbPoolData(d *schema.ResourceData, pool *nsxtAlbPool) error {
+	_ = d.Set("edge_gateway_id", pool.GatewayRef.ID)
+	_ = d.Set("name", pool.Name)
+	_ = d.Set("description", pool.Description)
+	if pool.Enabled != nil {
+		_ = d.Set("enabled", *pool.Enabled)
+	}
+	_ = d.Set("algorithm", pool.Algorithm)
+	if pool.DefaultPort != nil {
+		_ = d.Set("default_port", *pool.DefaultPort)
+	}
+	if pool.GracefulTimeoutPeriod != nil {
+		_ = d.Set("graceful_timeout_period", *pool.GracefulTimeoutPeriod)
+	}
+	_ = d.Set("member_count", pool.MemberCount)
+	_ = d.Set("enabled_member_count", pool.EnabledMemberCount)
+	_ = d.Set("up_member_count", pool.UpMemberCount)
+	_ = d.Set("health_message", pool.HealthMessage)
+
+	members := make([]interface{}, len(pool.Members))
+	memberStatuses := make([]interface{}, len(pool.Members))
+	for index, member := range pool.Members {
+		ratio := 1
+		if member.Ratio != nil {
+			ratio = *member.Ratio
+		}
+		members[index] = map[string]interface{}{
+			"enabled":    member.Enabled,
+			"ip_address": member.IpAddress,
+			"port":       member.Port,
+			"ratio":      ratio,
+		}
+		memberStatuses[index] = map[string]interface{}{
+			"ip_address":              member.IpAddress,
+			"port":                    member.Port,
+			"health_status":           member.HealthStatus,
+			"detailed_health_message": member.DetailedHealthMessage,
+			"marked_down_by":          convertToTypeSet(member.MarkedDownBy),
+		}
+	}
+
+	err := d.Set("member", schema.NewSet(schema.HashResource(nsxtAlbPoolMemberSchema), members))
+	if err != nil {
+		return fmt.Errorf("error setting 'member': %s", err)
+	}
+	err = d.Set("member_status", memberStatuses)
+	if err != nil {
+		return fmt.Errorf("error setting 'member_status': %s", err)
+	}
+
+	healthMonitors := make([]interface{}, len(pool.HealthMonitors))
+	for index, healthMonitor := range pool.HealthMonitors {
+		healthMonitors[index] = map[string]interface{}{"type": healthMonitor.Type}
+	}
+	err = d.Set("health_monitor", schema.NewSet(schema.HashResource(nsxtAlbPoolHealthMonitorSchema), healthMonitors))
+	if err != nil {
+		return fmt.Errorf("error setting 'health_monitor': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtAlbServiceEngineGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbServiceEngineGroupCreate,
+		Read:   resourceVcdNsxtAlbServiceEngineGroupRead,
+		Update: resourceVcdNsxtAlbServiceEngineGroupUpdate,
+		Delete: resourceVcdNsxtAlbServiceEngineGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbServiceEngineGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "NSX-T ALB Service Engine Group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "NSX-T ALB Service Engine Group description",
+			},
+			"alb_cloud_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "NSX-T ALB backing Cloud ID",
+			},
+			"importable_service_engine_group_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of Service Engine Group defined in NSX-T ALB Cloud which is imported",
+			},
+			"importable_service_engine_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of Service Engine Group defined in NSX-T ALB Cloud which is imported",
+			},
+			"reservation_model": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Service Engine Group reservation model. One of 'DEDICATED', 'SHARED'",
+				ValidateFunc: validation.StringInSlice([]string{"DEDICATED", "SHARED"}, false),
+			},
+			"ha_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Defines High Availability Mode for Service Engine Group",
+			},
+			"max_virtual_services": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of virtual services supported by this Service Engine Group",
+			},
+			"deployed_virtual_services": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of deployed virtual services on this Service Engine Group",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbServiceEngineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group creation initiated")
+
+	if !vcdClient.Client.IsSysAdmin {
+		return fmt.Errorf("this resource requires System user")
+	}
+
+	cloudId := d.Get("alb_cloud_id").(string)
+	importableGroupName := d.Get("importable_service_engine_group_name").(string)
+	importableGroup, err := getNsxtAlbImportableServiceEngineGroupByName(vcdClient, cloudId, importableGroupName)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group create] unable to find importable service engine group '%s': %s",
+			importableGroupName, err)
+	}
+
+	serviceEngineGroup := &nsxtAlbServiceEngineGroup{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ServiceEngineGroupBacking: nsxtAlbServiceEngineGroupBacking{
+			BackingId:            importableGroup.ID,
+			LoadBalancerCloudRef: &openApiReference{ID: cloudId},
+		},
+		ReservationType: d.Get("reservation_model").(string),
+	}
+
+	createdGroup, err := createNsxtAlbServiceEngineGroup(vcdClient, serviceEngineGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group create] error creating NSX-T ALB service engine group: %s", err)
+	}
+
+	d.SetId(createdGroup.ID)
+
+	return resourceVcdNsxtAlbServiceEngineGroupRead(d, meta)
+}
+
+func resourceVcdNsxtAlbServiceEngineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group update initiated")
+
+	// Backing details cannot be changed, but must be sent as they are in update request
+	serviceEngineGroup, err := getNsxtAlbServiceEngineGroupById(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group update] error retrieving NSX-T ALB service engine group: %s", err)
+	}
+
+	serviceEngineGroup.Name = d.Get("name").(string)
+	serviceEngineGroup.Description = d.Get("description").(string)
+
+	_, err = updateNsxtAlbServiceEngineGroup(vcdClient, serviceEngineGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group update] error updating NSX-T ALB service engine group: %s", err)
+	}
+
+	return resourceVcdNsxtAlbServiceEngineGroupRead(d, meta)
+}
+
+func resourceVcdNsxtAlbServiceEngineGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group read initiated")
+
+	serviceEngineGroup, err := getNsxtAlbServiceEngineGroupById(vcdClient, d.Id())
+	// If the service engine group is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB service engine group with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group read] error retrieving NSX-T ALB service engine group: %s", err)
+	}
+
+	_ = d.Set("name", serviceEngineGroup.Name)
+	_ = d.Set("description", serviceEngineGroup.Description)
+	_ = d.Set("importable_service_engine_group_id", serviceEngineGroup.ServiceEngineGroupBacking.BackingId)
+	_ = d.Set("reservation_model", serviceEngineGroup.ReservationType)
+	_ = d.Set("ha_mode", serviceEngineGroup.HaMode)
+	if serviceEngineGroup.ServiceEngineGroupBacking.LoadBalancerCloudRef != nil {
+		_ = d.Set("alb_cloud_id", serviceEngineGroup.ServiceEngineGroupBacking.LoadBalancerCloudRef.ID)
+	}
+	if serviceEngineGroup.MaxVirtualServices != nil {
+		_ = d.Set("max_virtual_services", *serviceEngineGroup.MaxVirtualServices)
+	}
+	if serviceEngineGroup.NumDeployedVirtualServices != nil {
+		_ = d.Set("deployed_virtual_services", *serviceEngineGroup.NumDeployedVirtualServices)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtAlbServiceEngineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB service engine group deletion initiated")
+
+	err := deleteNsxtAlbServiceEngineGroup(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb service engine group delete] error deleting NSX-T ALB service engine group: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbServiceEngineGroupImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains the name of NSX-T ALB service engine group
+// 3. The function looks up the object by name
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_service_engine_group.my-group
+// Example import path (_the_id_string_): my-service-engine-group-name
+//
+// Note: importable service engine group name is not stored in VCD and 'importable_service_engine_group_name' is not
+// populated after import
+func resourceVcdNsxtAlbServiceEngineGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vcdClient := meta.(*VCDClient)
+
+	serviceEngineGroup, err := getNsxtAlbServiceEngineGroupByName(vcdClient, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb service engine group import] unable to find NSX-T ALB service engine group '%s': %s",
+			d.Id(), err)
+	}
+
+	d.SetId(serviceEngineGroup.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
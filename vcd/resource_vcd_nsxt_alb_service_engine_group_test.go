@@ -0,0 +1,152 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// TestAccVcdNsxtAlbServiceEngineGroup tests provider side ALB infrastructure - controller, NSX-T cloud and service
+// engine group
+func TestAccVcdNsxtAlbServiceEngineGroup(t *testing.T) {
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtAlbConfiguration(t)
+
+	var params = StringMap{
+		"ControllerName":        t.Name(),
+		"ControllerUrl":         testConfig.Nsxt.NsxtAlbControllerUrl,
+		"ControllerUser":        testConfig.Nsxt.NsxtAlbControllerUser,
+		"ControllerPassword":    testConfig.Nsxt.NsxtAlbControllerPassword,
+		"ImportableCloud":       testConfig.Nsxt.NsxtAlbImportableCloud,
+		"ServiceEngineGroup":    testConfig.Nsxt.NsxtAlbServiceEngineGroup,
+		"ControllerDescription": "first",
+		"Tags":                  "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxtAlbProviderPrereqs, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["ControllerDescription"] = "updated"
+	configText2 := templateFill(testAccVcdNsxtAlbProviderPrereqs, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	controllerName := "vcd_nsxt_alb_controller.first"
+	cloudName := "vcd_nsxt_alb_cloud.first"
+	segName := "vcd_nsxt_alb_service_engine_group.first"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtAlbControllerDestroy(t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(controllerName, "id", regexp.MustCompile(`^urn:vcloud:loadBalancerController:`)),
+					resource.TestCheckResourceAttr(controllerName, "name", t.Name()),
+					resource.TestCheckResourceAttr(controllerName, "description", "first"),
+					resource.TestCheckResourceAttrSet(controllerName, "version"),
+					resource.TestMatchResourceAttr(cloudName, "id", regexp.MustCompile(`^urn:vcloud:loadBalancerCloud:`)),
+					resource.TestCheckResourceAttrPair(cloudName, "controller_id", controllerName, "id"),
+					resource.TestCheckResourceAttrSet(cloudName, "importable_cloud_id"),
+					resource.TestCheckResourceAttrSet(cloudName, "network_pool_id"),
+					resource.TestMatchResourceAttr(segName, "id", regexp.MustCompile(`^urn:vcloud:serviceEngineGroup:`)),
+					resource.TestCheckResourceAttrPair(segName, "alb_cloud_id", cloudName, "id"),
+					resource.TestCheckResourceAttr(segName, "reservation_model", "SHARED"),
+					resource.TestCheckResourceAttrSet(segName, "ha_mode"),
+					resource.TestCheckResourceAttrSet(segName, "max_virtual_services"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(controllerName, "description", "updated"),
+					resource.TestCheckResourceAttr(cloudName, "description", "updated"),
+					resource.TestCheckResourceAttr(segName, "description", "updated"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            controllerName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           t.Name(),
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			resource.TestStep{
+				ResourceName:            cloudName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           t.Name(),
+				ImportStateVerifyIgnore: []string{"importable_cloud_name"},
+			},
+			resource.TestStep{
+				ResourceName:            segName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           t.Name(),
+				ImportStateVerifyIgnore: []string{"importable_service_engine_group_name"},
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtAlbControllerDestroy checks that ALB controller with given name is removed
+func testAccCheckNsxtAlbControllerDestroy(controllerName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, err := getNsxtAlbControllerByName(conn, controllerName)
+		if err == nil {
+			return fmt.Errorf("ALB controller '%s' still exists", controllerName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking ALB controller '%s': %s", controllerName, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccVcdNsxtAlbProviderPrereqs sets up provider side ALB infrastructure and is reused by tenant side ALB tests
+const testAccVcdNsxtAlbProviderPrereqs = `
+resource "vcd_nsxt_alb_controller" "first" {
+  name        = "{{.ControllerName}}"
+  description = "{{.ControllerDescription}}"
+  url         = "{{.ControllerUrl}}"
+  username    = "{{.ControllerUser}}"
+  password    = "{{.ControllerPassword}}"
+}
+
+resource "vcd_nsxt_alb_cloud" "first" {
+  name        = "{{.ControllerName}}"
+  description = "{{.ControllerDescription}}"
+
+  controller_id         = vcd_nsxt_alb_controller.first.id
+  importable_cloud_name = "{{.ImportableCloud}}"
+}
+
+resource "vcd_nsxt_alb_service_engine_group" "first" {
+  name        = "{{.ControllerName}}"
+  description = "{{.ControllerDescription}}"
+
+  alb_cloud_id                         = vcd_nsxt_alb_cloud.first.id
+  importable_service_engine_group_name = "{{.ServiceEngineGroup}}"
+  reservation_model                    = "SHARED"
+}
+`
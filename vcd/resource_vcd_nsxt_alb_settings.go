@@ -0,0 +1,155 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtAlbSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbSettingsCreateUpdate,
+		Read:   resourceVcdNsxtAlbSettingsRead,
+		Update: resourceVcdNsxtAlbSettingsCreateUpdate,
+		Delete: resourceVcdNsxtAlbSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which ALB General Settings should be configured",
+			},
+			"is_active": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Defines if ALB is enabled on Edge Gateway",
+			},
+			"service_network_specification": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Optional custom network CIDR definition for ALB Service Engine placement (VCD default is 192.168.255.1/25)",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbSettingsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB edge gateway settings update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+	settings := &nsxtAlbEdgeGatewaySettings{
+		Enabled:                  d.Get("is_active").(bool),
+		ServiceNetworkDefinition: d.Get("service_network_specification").(string),
+	}
+
+	_, err := updateNsxtAlbEdgeGatewaySettings(vcdClient, edgeGatewayId, settings)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb settings update] error updating ALB settings: %s", err)
+	}
+
+	d.SetId(edgeGatewayId)
+
+	return resourceVcdNsxtAlbSettingsRead(d, meta)
+}
+
+func resourceVcdNsxtAlbSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB edge gateway settings read initiated")
+
+	settings, err := getNsxtAlbEdgeGatewaySettings(vcdClient, d.Id())
+	// If the edge gateway is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T edge gateway with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb settings read] error retrieving ALB settings: %s", err)
+	}
+
+	_ = d.Set("edge_gateway_id", d.Id())
+	_ = d.Set("is_active", settings.Enabled)
+	_ = d.Set("service_network_specification", settings.ServiceNetworkDefinition)
+
+	return nil
+}
+
+// resourceVcdNsxtAlbSettingsDelete disables ALB on the edge gateway as ALB settings cannot be removed
+func resourceVcdNsxtAlbSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB edge gateway settings delete initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	_, err := updateNsxtAlbEdgeGatewaySettings(vcdClient, d.Id(), &nsxtAlbEdgeGatewaySettings{Enabled: false})
+	if err != nil {
+		return fmt.Errorf("[nsxt alb settings delete] error disabling ALB: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbSettingsImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_settings.my-settings
+// Example import path (_the_id_string_): org.vdc.edge-gw-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtAlbSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[nsxt alb settings import] resource name must be specified as org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb settings import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb settings import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(edgeGateway.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
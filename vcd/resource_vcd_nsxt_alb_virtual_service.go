@@ -0,0 +1,344 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtAlbVirtualServicePortSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"start_port": &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Starting port in the range",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"end_port": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Last port in the range. Only a single 'start_port' is used when not set",
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"type": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Transport layer profile type. One of 'TCP_PROXY', 'TCP_FAST_PATH', 'UDP_FAST_PATH'",
+			ValidateFunc: validation.StringInSlice([]string{"TCP_PROXY", "TCP_FAST_PATH", "UDP_FAST_PATH"}, false),
+		},
+		"ssl_enabled": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enables SSL termination on the port. Requires 'ca_certificate_id'. Default 'false'",
+		},
+	},
+}
+
+func resourceVcdNsxtAlbVirtualService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAlbVirtualServiceCreate,
+		Read:   resourceVcdNsxtAlbVirtualServiceRead,
+		Update: resourceVcdNsxtAlbVirtualServiceUpdate,
+		Delete: resourceVcdNsxtAlbVirtualServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAlbVirtualServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which ALB Virtual Service should be created",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of ALB Virtual Service",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of ALB Virtual Service",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Defines if ALB Virtual Service is enabled. Default 'true'",
+			},
+			"pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ALB Pool ID which serves the traffic of Virtual Service",
+			},
+			"service_engine_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service Engine Group ID (must be assigned to the Edge Gateway)",
+			},
+			"virtual_ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Virtual IP address (VIP) of Virtual Service",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"application_profile_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "HTTP, HTTPS, L4, L4_TLS",
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "L4", "L4_TLS"}, false),
+			},
+			"ca_certificate_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of certificate in library. Required when 'ssl_enabled' is set on any of the service ports",
+			},
+			"service_port": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "A set of ports (or port ranges) on which Virtual Service listens",
+				Elem:        nsxtAlbVirtualServicePortSchema,
+			},
+			"health_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health status of Virtual Service",
+			},
+			"health_message": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health message of Virtual Service",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAlbVirtualServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB virtual service creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	virtualService, err := createNsxtAlbVirtualService(vcdClient, getNsxtAlbVirtualServiceType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt alb virtual service create] error creating ALB virtual service '%s': %s",
+			d.Get("name").(string), err)
+	}
+
+	d.SetId(virtualService.ID)
+
+	return resourceVcdNsxtAlbVirtualServiceRead(d, meta)
+}
+
+func resourceVcdNsxtAlbVirtualServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB virtual service update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	virtualService := getNsxtAlbVirtualServiceType(d)
+	virtualService.ID = d.Id()
+
+	_, err := updateNsxtAlbVirtualService(vcdClient, virtualService)
+	if err != nil {
+		return fmt.Errorf("[nsxt alb virtual service update] error updating ALB virtual service '%s': %s",
+			virtualService.Name, err)
+	}
+
+	return resourceVcdNsxtAlbVirtualServiceRead(d, meta)
+}
+
+func resourceVcdNsxtAlbVirtualServiceRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB virtual service read initiated")
+
+	virtualService, err := getNsxtAlbVirtualServiceById(vcdClient, d.Id())
+	// If the virtual service is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T ALB virtual service with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt alb virtual service read] error retrieving ALB virtual service: %s", err)
+	}
+
+	return setNsxtAlbVirtualServiceData(d, virtualService)
+}
+
+func resourceVcdNsxtAlbVirtualServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T ALB virtual service deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtAlbVirtualService(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt alb virtual service delete] error deleting ALB virtual service: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAlbVirtualServiceImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_alb_virtual_service.my-virtual-service
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.virtual-service-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtAlbVirtualServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt alb virtual service import] resource name must be specified as " +
+			"org-name.vdc-name.edge-gw-name.virtual-service-name")
+	}
+	orgName, vdcName, edgeName, virtualServiceName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb virtual service import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb virtual service import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	virtualService, err := getNsxtAlbVirtualServiceByName(vcdClient, edgeGateway.ID, virtualServiceName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt alb virtual service import] unable to find ALB virtual service '%s': %s",
+			virtualServiceName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(virtualService.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtAlbVirtualServiceType converts Terraform schema into ALB virtual service structure
+func getNsxtAlbVirtualServiceType(d *schema.ResourceData) *nsxtAlbVirtualService {
+	virtualService := &nsxtAlbVirtualService{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		Enabled:               takeBoolPointer(d.Get("enabled").(bool)),
+		ApplicationProfile:    nsxtAlbVirtualServiceAppProfile{Type: d.Get("application_profile_type").(string), SystemDefined: true},
+		GatewayRef:            openApiReference{ID: d.Get("edge_gateway_id").(string)},
+		LoadBalancerPoolRef:   openApiReference{ID: d.Get("pool_id").(string)},
+		ServiceEngineGroupRef: openApiReference{ID: d.Get("service_engine_group_id").(string)},
+		VirtualIpAddress:      d.Get("virtual_ip_address").(string),
+	}
+
+	if certificateId := d.Get("ca_certificate_id").(string); certificateId != "" {
+		virtualService.CertificateRef = &openApiReference{ID: certificateId}
+	}
+
+	servicePortSet := d.Get("service_port").(*schema.Set)
+	virtualService.ServicePorts = make([]nsxtAlbVirtualServicePort, servicePortSet.Len())
+	for index, servicePort := range servicePortSet.List() {
+		servicePortMap := servicePort.(map[string]interface{})
+		startPort := servicePortMap["start_port"].(int)
+		// A single port is defined by the same start and end ports
+		endPort := servicePortMap["end_port"].(int)
+		if endPort == 0 {
+			endPort = startPort
+		}
+		virtualService.ServicePorts[index] = nsxtAlbVirtualServicePort{
+			PortStart:  takeIntPointer(startPort),
+			PortEnd:    takeIntPointer(endPort),
+			SslEnabled: takeBoolPointer(servicePortMap["ssl_enabled"].(bool)),
+			TcpUdpProfile: &nsxtAlbVirtualServiceL4Profile{
+				Type:          servicePortMap["type"].(string),
+				SystemDefined: true,
+			},
+		}
+	}
+
+	return virtualService
+}
+
+// setNsxtAlbVirtualServiceData stores ALB virtual service structure in Terraform schema
+func setNsxtAlbVirtualServiceData(d *schema.ResourceData, virtualService *nsxtAlbVirtualService) error {
+	_ = d.Set("edge_gateway_id", virtualService.GatewayRef.ID)
+	_ = d.Set("name", virtualService.Name)
+	_ = d.Set("description", virtualService.Description)
+	if virtualService.Enabled != nil {
+		_ = d.Set("enabled", *virtualService.Enabled)
+	}
+	_ = d.Set("pool_id", virtualService.LoadBalancerPoolRef.ID)
+	_ = d.Set("service_engine_group_id", virtualService.ServiceEngineGroupRef.ID)
+	_ = d.Set("virtual_ip_address", virtualService.VirtualIpAddress)
+	_ = d.Set("application_profile_type", virtualService.ApplicationProfile.Type)
+	_ = d.Set("health_status", virtualService.HealthStatus)
+	_ = d.Set("health_message", virtualService.HealthMessage)
+
+	certificateId := ""
+	if virtualService.CertificateRef != nil {
+		certificateId = virtualService.CertificateRef.ID
+	}
+	_ = d.Set("ca_certificate_id", certificateId)
+
+	servicePorts := make([]interface{}, len(virtualService.ServicePorts))
+	for index, servicePort := range virtualService.ServicePorts {
+		startPort, endPort := 0, 0
+		if servicePort.PortStart != nil {
+			startPort = *servicePort.PortStart
+		}
+		// End port is only stored when it defines a range so that single port definitions do not cause drift
+		if servicePort.PortEnd != nil && *servicePort.PortEnd != startPort {
+			endPort = *servicePort.PortEnd
+		}
+		sslEnabled := false
+		if servicePort.SslEnabled != nil {
+			sslEnabled = *servicePort.SslEnabled
+		}
+		profileType := ""
+		if servicePort.TcpUdpProfile != nil {
+			profileType = servicePort.TcpUdpProfile.Type
+		}
+		servicePorts[index] = map[string]interface{}{
+			"start_port":  startPort,
+			"end_port":    endPort,
+			"type":        profileType,
+			"ssl_enabled": sslEnabled,
+		}
+	}
+
+	err := d.Set("service_port", schema.NewSet(schema.HashResource(nsxtAlbVirtualServicePortSchema), servicePorts))
+	if err != nil {
+		return fmt.Errorf("error setting 'service_port': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccVcdNsxtAlbVirtualService tests tenant side ALB configuration - enabling ALB on edge gateway, service engine
+// group assignment, pool and virtual service. Provider side infrastructure is set up in the same configuration.
+func TestAccVcdNsxtAlbVirtualService(t *testing.T) {
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtAlbConfiguration(t)
+
+	var params = StringMap{
+		"Org":                   testConfig.VCD.Org,
+		"NsxtVdc":               testConfig.Nsxt.Vdc,
+		"EdgeGw":                testConfig.Nsxt.EdgeGateway,
+		"ControllerName":        t.Name(),
+		"ControllerUrl":         testConfig.Nsxt.NsxtAlbControllerUrl,
+		"ControllerUser":        testConfig.Nsxt.NsxtAlbControllerUser,
+		"ControllerPassword":    testConfig.Nsxt.NsxtAlbControllerPassword,
+		"ImportableCloud":       testConfig.Nsxt.NsxtAlbImportableCloud,
+		"ServiceEngineGroup":    testConfig.Nsxt.NsxtAlbServiceEngineGroup,
+		"ControllerDescription": "",
+		"PoolAlgorithm":         "LEAST_CONNECTIONS",
+		"VirtualServicePort":    "80",
+		"Tags":                  "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxtAlbProviderPrereqs+testAccVcdNsxtAlbVirtualService, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["PoolAlgorithm"] = "ROUND_ROBIN"
+	params["VirtualServicePort"] = "8080"
+	configText2 := templateFill(testAccVcdNsxtAlbProviderPrereqs+testAccVcdNsxtAlbVirtualService, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	settingsName := "vcd_nsxt_alb_settings.test"
+	assignmentName := "vcd_nsxt_alb_edgegateway_service_engine_group.test"
+	poolName := "vcd_nsxt_alb_pool.test"
+	virtualServiceName := "vcd_nsxt_alb_virtual_service.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtAlbControllerDestroy(t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(settingsName, "id", "data.vcd_nsxt_edgegateway.existing", "id"),
+					resource.TestCheckResourceAttr(settingsName, "is_active", "true"),
+					resource.TestCheckResourceAttrSet(settingsName, "service_network_specification"),
+					resource.TestCheckResourceAttrPair(assignmentName, "service_engine_group_id", "vcd_nsxt_alb_service_engine_group.first", "id"),
+					resource.TestCheckResourceAttr(assignmentName, "service_engine_group_name", t.Name()),
+					resource.TestCheckResourceAttr(assignmentName, "max_virtual_services", "10"),
+					resource.TestCheckResourceAttr(assignmentName, "reserved_virtual_services", "2"),
+					resource.TestMatchResourceAttr(poolName, "id", regexp.MustCompile(`^urn:vcloud:loadBalancerPool:`)),
+					resource.TestCheckResourceAttr(poolName, "algorithm", "LEAST_CONNECTIONS"),
+					resource.TestCheckResourceAttr(poolName, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(poolName, "member.*", map[string]string{
+						"ip_address": "192.168.1.10",
+						"port":       "8000",
+						"ratio":      "1",
+					}),
+					resource.TestCheckResourceAttr(poolName, "health_monitor.#", "1"),
+					resource.TestCheckResourceAttr(poolName, "member_count", "2"),
+					resource.TestMatchResourceAttr(virtualServiceName, "id", regexp.MustCompile(`^urn:vcloud:loadBalancerVirtualService:`)),
+					resource.TestCheckResourceAttrPair(virtualServiceName, "pool_id", poolName, "id"),
+					resource.TestCheckResourceAttrPair(virtualServiceName, "virtual_ip_address", "data.vcd_nsxt_edgegateway.existing", "primary_ip"),
+					resource.TestCheckResourceAttr(virtualServiceName, "application_profile_type", "HTTP"),
+					resource.TestCheckTypeSetElemNestedAttrs(virtualServiceName, "service_port.*", map[string]string{
+						"start_port": "80",
+						"type":       "TCP_PROXY",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(poolName, "algorithm", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttr(virtualServiceName, "service_port.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(virtualServiceName, "service_port.*", map[string]string{
+						"start_port": "8080",
+						"type":       "TCP_PROXY",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      settingsName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, testConfig.Nsxt.EdgeGateway),
+			},
+			resource.TestStep{
+				ResourceName:      assignmentName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+			},
+			resource.TestStep{
+				ResourceName:      poolName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+				// Runtime status of members may change between reads
+				ImportStateVerifyIgnore: []string{"member_status", "up_member_count", "health_message"},
+			},
+			resource.TestStep{
+				ResourceName:            virtualServiceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+				ImportStateVerifyIgnore: []string{"health_status", "health_message"},
+			},
+		},
+	})
+}
+
+const testAccVcdNsxtAlbVirtualService = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.EdgeGw}}"
+}
+
+resource "vcd_nsxt_alb_settings" "test" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+  is_active       = true
+
+  # This dependency is required to make sure that provider part of operations is done
+  depends_on = [vcd_nsxt_alb_service_engine_group.first]
+}
+
+resource "vcd_nsxt_alb_edgegateway_service_engine_group" "test" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id           = vcd_nsxt_alb_settings.test.edge_gateway_id
+  service_engine_group_id   = vcd_nsxt_alb_service_engine_group.first.id
+  max_virtual_services      = 10
+  reserved_virtual_services = 2
+}
+
+resource "vcd_nsxt_alb_pool" "test" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = vcd_nsxt_alb_settings.test.edge_gateway_id
+  name            = "{{.ControllerName}}"
+  algorithm       = "{{.PoolAlgorithm}}"
+
+  member {
+    ip_address = "192.168.1.10"
+    port       = 8000
+  }
+
+  member {
+    enabled    = false
+    ip_address = "192.168.1.11"
+    ratio      = 3
+  }
+
+  health_monitor {
+    type = "HTTP"
+  }
+}
+
+resource "vcd_nsxt_alb_virtual_service" "test" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = vcd_nsxt_alb_settings.test.edge_gateway_id
+  name            = "{{.ControllerName}}"
+
+  pool_id                  = vcd_nsxt_alb_pool.test.id
+  service_engine_group_id  = vcd_nsxt_alb_edgegateway_service_engine_group.test.service_engine_group_id
+  virtual_ip_address       = data.vcd_nsxt_edgegateway.existing.primary_ip
+  application_profile_type = "HTTP"
+
+  service_port {
+    start_port = {{.VirtualServicePort}}
+    type       = "TCP_PROXY"
+  }
+}
+`
@@ -0,0 +1,286 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtAppPortProfilePortSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"protocol": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Protocol. One of 'ICMPv4', 'ICMPv6', 'TCP', 'UDP'",
+			ValidateFunc: validation.StringInSlice([]string{"ICMPv4", "ICMPv6", "TCP", "UDP"}, false),
+		},
+		"port": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set of ports or ranges (e.g. '80', '8080-8090'). Not used for ICMP protocols",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+func resourceVcdNsxtAppPortProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtAppPortProfileCreate,
+		Read:   resourceVcdNsxtAppPortProfileRead,
+		Update: resourceVcdNsxtAppPortProfileUpdate,
+		Delete: resourceVcdNsxtAppPortProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtAppPortProfileImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Application Port Profile name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application Port Profile description",
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nsxtAppPortProfileScopeTenant,
+				Description:  "Scope - 'TENANT' (default) or 'PROVIDER'",
+				ValidateFunc: validation.StringInSlice([]string{nsxtAppPortProfileScopeTenant, nsxtAppPortProfileScopeProvider}, false),
+			},
+			"nsxt_manager_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of NSX-T manager. Required for 'PROVIDER' scope",
+			},
+			"app_port": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of protocol and port definitions",
+				Elem:        nsxtAppPortProfilePortSchema,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtAppPortProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T application port profile creation initiated")
+
+	appPortProfile, err := getNsxtAppPortProfileType(vcdClient, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile create] %s", err)
+	}
+
+	createdProfile, err := createNsxtAppPortProfile(vcdClient, appPortProfile)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile create] error creating application port profile: %s", err)
+	}
+
+	d.SetId(createdProfile.ID)
+
+	return resourceVcdNsxtAppPortProfileRead(d, meta)
+}
+
+func resourceVcdNsxtAppPortProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T application port profile update initiated")
+
+	appPortProfile, err := getNsxtAppPortProfileType(vcdClient, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile update] %s", err)
+	}
+	appPortProfile.ID = d.Id()
+
+	_, err = updateNsxtAppPortProfile(vcdClient, appPortProfile)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile update] error updating application port profile: %s", err)
+	}
+
+	return resourceVcdNsxtAppPortProfileRead(d, meta)
+}
+
+func resourceVcdNsxtAppPortProfileRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T application port profile read initiated")
+
+	appPortProfile, err := getNsxtAppPortProfileById(vcdClient, d.Id())
+	// If the profile is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T application port profile with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile read] error retrieving application port profile: %s", err)
+	}
+
+	err = setNsxtAppPortProfileData(d, appPortProfile)
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile read] error storing application port profile data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtAppPortProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T application port profile deletion initiated")
+
+	err := deleteNsxtAppPortProfile(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt app port profile delete] error deleting application port profile: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtAppPortProfileImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_app_port_profile.my-profile
+// Example import path (_the_id_string_) for TENANT scope: org.vdc.profile-name
+// Example import path (_the_id_string_) for PROVIDER scope: nsxt-manager-name.profile-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtAppPortProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vcdClient := meta.(*VCDClient)
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+
+	switch len(resourceURI) {
+	case 3:
+		orgName, vdcName, profileName := resourceURI[0], resourceURI[1], resourceURI[2]
+		_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+		if err != nil {
+			return nil, fmt.Errorf("[nsxt app port profile import] unable to find VDC %s: %s ", vdcName, err)
+		}
+
+		appPortProfile, err := getNsxtAppPortProfileByName(vcdClient, profileName, nsxtAppPortProfileScopeTenant, vdc.Vdc.ID)
+		if err != nil {
+			return nil, fmt.Errorf("[nsxt app port profile import] unable to find application port profile '%s': %s", profileName, err)
+		}
+
+		_ = d.Set("org", orgName)
+		_ = d.Set("vdc", vdcName)
+		d.SetId(appPortProfile.ID)
+	case 2:
+		nsxtManagerName, profileName := resourceURI[0], resourceURI[1]
+		nsxtManagerId, err := getNsxtManagerIdByName(vcdClient, nsxtManagerName)
+		if err != nil {
+			return nil, fmt.Errorf("[nsxt app port profile import] %s", err)
+		}
+
+		appPortProfile, err := getNsxtAppPortProfileByName(vcdClient, profileName, nsxtAppPortProfileScopeProvider, nsxtManagerId)
+		if err != nil {
+			return nil, fmt.Errorf("[nsxt app port profile import] unable to find application port profile '%s': %s", profileName, err)
+		}
+
+		d.SetId(appPortProfile.ID)
+	default:
+		return nil, fmt.Errorf("[nsxt app port profile import] resource name must be specified as org-name.vdc-name.profile-name " +
+			"(TENANT scope) or nsxt-manager-name.profile-name (PROVIDER scope)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtAppPortProfileType converts Terraform schema into application port profile structure. Context entity is
+// VDC for TENANT scope and NSX-T manager for PROVIDER scope.
+func getNsxtAppPortProfileType(vcdClient *VCDClient, d *schema.ResourceData) (*nsxtAppPortProfile, error) {
+	appPortProfile := &nsxtAppPortProfile{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Scope:            d.Get("scope").(string),
+		ApplicationPorts: getNsxtAppPortProfilePortsType(d.Get("app_port").(*schema.Set)),
+	}
+
+	switch appPortProfile.Scope {
+	case nsxtAppPortProfileScopeProvider:
+		if !vcdClient.Client.IsSysAdmin {
+			return nil, fmt.Errorf("'PROVIDER' scope requires System user")
+		}
+		nsxtManagerId := d.Get("nsxt_manager_id").(string)
+		if nsxtManagerId == "" {
+			return nil, fmt.Errorf("'nsxt_manager_id' is required for 'PROVIDER' scope")
+		}
+		appPortProfile.ContextEntityId = nsxtManagerId
+	default:
+		org, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+		if err != nil {
+			return nil, fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+		appPortProfile.OrgRef = &openApiReference{ID: org.Org.ID}
+		appPortProfile.ContextEntityId = vdc.Vdc.ID
+	}
+
+	return appPortProfile, nil
+}
+
+// getNsxtAppPortProfilePortsType converts 'app_port' set into a slice of application ports
+func getNsxtAppPortProfilePortsType(appPortSet *schema.Set) []nsxtAppPortProfilePort {
+	appPorts := make([]nsxtAppPortProfilePort, appPortSet.Len())
+	for index, appPort := range appPortSet.List() {
+		appPortMap := appPort.(map[string]interface{})
+		appPorts[index] = nsxtAppPortProfilePort{
+			Protocol:         appPortMap["protocol"].(string),
+			DestinationPorts: convertSchemaSetToSliceOfStrings(appPortMap["port"].(*schema.Set)),
+		}
+	}
+	return appPorts
+}
+
+// setNsxtAppPortProfileData stores application port profile structure in Terraform schema
+func setNsxtAppPortProfileData(d *schema.ResourceData, appPortProfile *nsxtAppPortProfile) error {
+	_ = d.Set("name", appPortProfile.Name)
+	_ = d.Set("description", appPortProfile.Description)
+	_ = d.Set("scope", appPortProfile.Scope)
+	if appPortProfile.Scope == nsxtAppPortProfileScopeProvider {
+		_ = d.Set("nsxt_manager_id", appPortProfile.ContextEntityId)
+	}
+
+	appPorts := make([]interface{}, len(appPortProfile.ApplicationPorts))
+	for index, appPort := range appPortProfile.ApplicationPorts {
+		appPorts[index] = map[string]interface{}{
+			"protocol": appPort.Protocol,
+			"port":     convertToTypeSet(appPort.DestinationPorts),
+		}
+	}
+
+	appPortSet := schema.NewSet(schema.HashResource(nsxtAppPortProfilePortSchema), appPorts)
+	err := d.Set("app_port", appPortSet)
+	if err != nil {
+		return fmt.Errorf("error setting 'app_port': %s", err)
+	}
+
+	return nil
+}
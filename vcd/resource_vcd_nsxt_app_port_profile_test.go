@@ -0,0 +1,239 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtAppPortProfileTenant(t *testing.T) {
+	skipNoNsxtVdcConfiguration(t)
+
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"NsxtVdc":     testConfig.Nsxt.Vdc,
+		"ProfileName": t.Name(),
+		"Tags":        "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtAppPortProfileTenantStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtAppPortProfileTenantStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccNsxtAppPortProfileTenantStep3, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_app_port_profile.custom"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtAppPortProfileDestroy(t.Name(), nsxtAppPortProfileScopeTenant),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:applicationPortProfile:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "Application port profile for custom"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "TENANT"),
+					resource.TestCheckResourceAttr(resourceName, "app_port.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "app_port.*", map[string]string{
+						"protocol": "ICMPv4",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:applicationPortProfile:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated application port profile"),
+					resource.TestCheckResourceAttr(resourceName, "app_port.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "app_port.*", map[string]string{
+						"protocol": "ICMPv6",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "app_port.*", map[string]string{
+						"protocol": "TCP",
+						"port.#":   "3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "app_port.*", map[string]string{
+						"protocol": "UDP",
+						"port.#":   "1",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()),
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcd_nsxt_app_port_profile.custom", []string{"org", "vdc"}),
+					resource.TestCheckResourceAttr("data.vcd_nsxt_app_port_profile.ssh", "scope", "SYSTEM"),
+					resource.TestCheckResourceAttr("data.vcd_nsxt_app_port_profile.ssh", "app_port.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcd_nsxt_app_port_profile.ssh", "app_port.*", map[string]string{
+						"protocol": "TCP",
+						"port.#":   "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVcdNsxtAppPortProfileProvider(t *testing.T) {
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtVdcConfiguration(t)
+
+	var params = StringMap{
+		"NsxtManager": testConfig.Nsxt.Manager,
+		"ProfileName": t.Name(),
+		"Tags":        "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtAppPortProfileProvider, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_app_port_profile.custom"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtAppPortProfileDestroy(t.Name(), nsxtAppPortProfileScopeProvider),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:applicationPortProfile:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "scope", "PROVIDER"),
+					resource.TestCheckResourceAttrPair(resourceName, "nsxt_manager_id", "data.vcd_nsxt_manager.main", "id"),
+					resource.TestCheckResourceAttr(resourceName, "app_port.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.Nsxt.Manager + ImportSeparator + t.Name(),
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtAppPortProfileDestroy checks that no application port profiles with given name are left in
+// given scope
+func testAccCheckNsxtAppPortProfileDestroy(profileName, scope string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, err := getNsxtAppPortProfileByName(conn, profileName, scope, "")
+		if err == nil {
+			return fmt.Errorf("application port profile '%s' still exists", profileName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking application port profile '%s': %s", profileName, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtAppPortProfileTenantStep1 = `
+resource "vcd_nsxt_app_port_profile" "custom" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  name        = "{{.ProfileName}}"
+  description = "Application port profile for custom"
+
+  app_port {
+    protocol = "ICMPv4"
+  }
+}
+`
+
+const testAccNsxtAppPortProfileTenantStep2 = `
+resource "vcd_nsxt_app_port_profile" "custom" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  name        = "{{.ProfileName}}"
+  description = "Updated application port profile"
+
+  app_port {
+    protocol = "ICMPv6"
+  }
+
+  app_port {
+    protocol = "TCP"
+    port     = ["2000", "2010-2020", "12345"]
+  }
+
+  app_port {
+    protocol = "UDP"
+    port     = ["40000-60000"]
+  }
+}
+`
+
+const testAccNsxtAppPortProfileTenantStep3 = testAccNsxtAppPortProfileTenantStep2 + `
+data "vcd_nsxt_app_port_profile" "custom" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  name  = vcd_nsxt_app_port_profile.custom.name
+  scope = "TENANT"
+}
+
+data "vcd_nsxt_app_port_profile" "ssh" {
+  name  = "SSH"
+  scope = "SYSTEM"
+}
+`
+
+const testAccNsxtAppPortProfileProvider = `
+data "vcd_nsxt_manager" "main" {
+  name = "{{.NsxtManager}}"
+}
+
+resource "vcd_nsxt_app_port_profile" "custom" {
+  name  = "{{.ProfileName}}"
+  scope = "PROVIDER"
+
+  nsxt_manager_id = data.vcd_nsxt_manager.main.id
+
+  app_port {
+    protocol = "TCP"
+    port     = ["8443"]
+  }
+}
+`
@@ -0,0 +1,137 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtDistributedFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtDistributedFirewallCreateUpdate,
+		Read:   resourceVcdNsxtDistributedFirewallRead,
+		Update: resourceVcdNsxtDistributedFirewallCreateUpdate,
+		Delete: resourceVcdNsxtDistributedFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtDistributedFirewallImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of VDC group with enabled distributed firewall",
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Ordered list of distributed firewall rules. Rules are processed in the order they are defined",
+				Elem:        nsxtFirewallRuleSchema,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtDistributedFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T distributed firewall create/update initiated")
+
+	vdcGroupId := d.Get("vdc_group_id").(string)
+	rules := &nsxtDistributedFirewallRules{Values: getNsxtFirewallType(d).UserDefinedRules}
+
+	_, err := updateNsxtDistributedFirewall(vcdClient, vdcGroupId, rules)
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall create/update] error setting distributed firewall rules: %s", err)
+	}
+
+	// Distributed firewall rules are a single configuration object of VDC group therefore VDC group ID is used as ID
+	d.SetId(vdcGroupId)
+
+	return resourceVcdNsxtDistributedFirewallRead(d, meta)
+}
+
+func resourceVcdNsxtDistributedFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T distributed firewall read initiated")
+
+	rules, err := getNsxtDistributedFirewall(vcdClient, d.Id())
+	// If the VDC group is not found - remove distributed firewall from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] VDC group with ID %s no longer exists. Removing distributed firewall from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall read] error retrieving distributed firewall rules: %s", err)
+	}
+
+	_ = d.Set("vdc_group_id", d.Id())
+	err = setNsxtFirewallData(rules.Values, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall read] error storing distributed firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtDistributedFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T distributed firewall deletion initiated")
+
+	err := deleteNsxtDistributedFirewall(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt distributed firewall delete] error deleting distributed firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtDistributedFirewallImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_distributed_firewall.my-dfw
+// Example import path (_the_id_string_): org.vdc-group-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtDistributedFirewallImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 2 {
+		return nil, fmt.Errorf("[nsxt distributed firewall import] resource name must be specified as org-name.vdc-group-name")
+	}
+	orgName, vdcGroupName := resourceURI[0], resourceURI[1]
+
+	vcdClient := meta.(*VCDClient)
+	adminOrg, err := vcdClient.GetAdminOrg(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt distributed firewall import] unable to find Org %s: %s", orgName, err)
+	}
+
+	vdcGroup, err := getVdcGroupByName(vcdClient, adminOrg.AdminOrg.ID, vdcGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt distributed firewall import] unable to find VDC group '%s': %s", vdcGroupName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc_group_id", vdcGroup.ID)
+	d.SetId(vdcGroup.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
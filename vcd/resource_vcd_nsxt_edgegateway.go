@@ -0,0 +1,341 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtEdgeSubnetRange = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"start_address": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"end_address": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+	},
+}
+
+var nsxtEdgeSubnet = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"gateway": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Gateway address for a subnet",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"prefix_length": &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Netmask address for a subnet (e.g. 24 for /24)",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"primary_ip": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Primary IP address for the edge gateway - will be auto-assigned if not defined",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"allocated_ips": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Define zero or more blocks to allocate IPs for this subnet",
+			Elem:        nsxtEdgeSubnetRange,
+		},
+	},
+}
+
+func resourceVcdNsxtEdgeGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtEdgeGatewayCreate,
+		Read:   resourceVcdNsxtEdgeGatewayRead,
+		Update: resourceVcdNsxtEdgeGatewayUpdate,
+		Delete: resourceVcdNsxtEdgeGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtEdgeGatewayImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Edge Gateway name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Edge Gateway description",
+			},
+			"dedicate_external_network": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Dedicating the External Network will enable Route Advertisement for this Edge Gateway.",
+			},
+			"external_network_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "External network ID",
+			},
+			"subnet": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "One or more blocks with external network information to be attached to this gateway's interface",
+				Elem:        nsxtEdgeSubnet,
+			},
+			"primary_ip": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary IP address of edge gateway. Read-only (use explicit 'subnet.primary_ip' to set it)",
+			},
+			"edge_cluster_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Select specific NSX-T Edge Cluster. Will be inherited from external network if not specified",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtEdgeGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T edge gateway creation initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	edgeGatewayConfig := getNsxtEdgeGatewayType(d, vdc)
+
+	createdEdgeGateway, err := createNsxtEdgeGateway(vcdClient, edgeGatewayConfig)
+	if err != nil {
+		return fmt.Errorf("error creating NSX-T edge gateway: %s", err)
+	}
+
+	d.SetId(createdEdgeGateway.ID)
+	log.Printf("[TRACE] NSX-T edge gateway created: %#v", createdEdgeGateway)
+
+	return resourceVcdNsxtEdgeGatewayRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T edge gateway update initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	edgeGatewayConfig := getNsxtEdgeGatewayType(d, vdc)
+	edgeGatewayConfig.ID = d.Id()
+
+	_, err = updateNsxtEdgeGateway(vcdClient, edgeGatewayConfig)
+	if err != nil {
+		return fmt.Errorf("error updating NSX-T edge gateway: %s", err)
+	}
+
+	return resourceVcdNsxtEdgeGatewayRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T edge gateway read initiated")
+
+	edgeGateway, err := getNsxtEdgeGatewayById(vcdClient, d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not retrieve NSX-T edge gateway by ID '%s': %s", d.Id(), err)
+	}
+
+	return setNsxtEdgeGatewayData(edgeGateway, d)
+}
+
+func resourceVcdNsxtEdgeGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T edge gateway deletion initiated")
+
+	return deleteNsxtEdgeGateway(vcdClient, d.Id())
+}
+
+// resourceVcdNsxtEdgeGatewayImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_edgegateway.nsxt-edge
+// Example import path (_the_id_string_): org-name.vdc-name.nsxt-edge-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtEdgeGatewayImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[NSX-T edge gateway import] resource name must be specified as org-name.vdc-name.nsxt-edge-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[NSX-T edge gateway import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[NSX-T edge gateway import] error retrieving edge gateway %s: %s", edgeName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(edgeGateway.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtEdgeGatewayType converts Terraform schema into NSX-T edge gateway structure
+func getNsxtEdgeGatewayType(d *schema.ResourceData, vdc *govcd.Vdc) *nsxtEdgeGateway {
+	edgeGateway := &nsxtEdgeGateway{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		OrgVdc:      &openApiReference{ID: vdc.Vdc.ID},
+		EdgeGatewayUplinks: []nsxtEdgeGatewayUplink{
+			nsxtEdgeGatewayUplink{
+				UplinkID:  d.Get("external_network_id").(string),
+				Subnets:   nsxtEdgeGatewaySubnets{Values: getNsxtEdgeGatewayUplinksType(d)},
+				Connected: true,
+				Dedicated: d.Get("dedicate_external_network").(bool),
+			},
+		},
+	}
+
+	if clusterId, isSet := d.GetOk("edge_cluster_id"); isSet {
+		edgeGateway.EdgeClusterConfig = &nsxtEdgeGatewayEdgeClusterConfig{
+			PrimaryEdgeCluster: nsxtEdgeGatewayEdgeCluster{
+				BackingID: clusterId.(string),
+			},
+		}
+	}
+
+	return edgeGateway
+}
+
+// getNsxtEdgeGatewayUplinksType converts 'subnet' blocks into a slice of uplink subnets
+func getNsxtEdgeGatewayUplinksType(d *schema.ResourceData) []nsxtEdgeGatewaySubnet {
+	subnets := d.Get("subnet").(*schema.Set).List()
+	subnetSlice := make([]nsxtEdgeGatewaySubnet, len(subnets))
+
+	for subnetIndex, subnet := range subnets {
+		subnetMap := subnet.(map[string]interface{})
+
+		oneSubnet := nsxtEdgeGatewaySubnet{
+			Gateway:      subnetMap["gateway"].(string),
+			PrefixLength: subnetMap["prefix_length"].(int),
+			PrimaryIP:    subnetMap["primary_ip"].(string),
+			Enabled:      true,
+		}
+
+		allocatedIps := subnetMap["allocated_ips"].(*schema.Set).List()
+		if len(allocatedIps) > 0 {
+			ipRanges := make([]openApiIpRange, len(allocatedIps))
+			for rangeIndex, ipRange := range allocatedIps {
+				ipRangeStrings := convertToStringMap(ipRange.(map[string]interface{}))
+				ipRanges[rangeIndex] = openApiIpRange{
+					StartAddress: ipRangeStrings["start_address"],
+					EndAddress:   ipRangeStrings["end_address"],
+				}
+			}
+			oneSubnet.IPRanges = &openApiIpRanges{Values: ipRanges}
+		}
+
+		subnetSlice[subnetIndex] = oneSubnet
+	}
+
+	return subnetSlice
+}
+
+// setNsxtEdgeGatewayData stores NSX-T edge gateway structure in Terraform schema
+func setNsxtEdgeGatewayData(edgeGateway *nsxtEdgeGateway, d *schema.ResourceData) error {
+	_ = d.Set("name", edgeGateway.Name)
+	_ = d.Set("description", edgeGateway.Description)
+
+	if edgeGateway.EdgeClusterConfig != nil {
+		_ = d.Set("edge_cluster_id", edgeGateway.EdgeClusterConfig.PrimaryEdgeCluster.BackingID)
+	}
+
+	// NSX-T edge gateways support only one uplink
+	if len(edgeGateway.EdgeGatewayUplinks) == 0 {
+		return fmt.Errorf("NSX-T edge gateway '%s' has no uplinks", edgeGateway.Name)
+	}
+	uplink := edgeGateway.EdgeGatewayUplinks[0]
+	_ = d.Set("dedicate_external_network", uplink.Dedicated)
+	_ = d.Set("external_network_id", uplink.UplinkID)
+
+	subnetSlice := make([]interface{}, len(uplink.Subnets.Values))
+	for subnetIndex, subnet := range uplink.Subnets.Values {
+		subnetMap := make(map[string]interface{})
+		subnetMap["gateway"] = subnet.Gateway
+		subnetMap["prefix_length"] = subnet.PrefixLength
+		subnetMap["primary_ip"] = subnet.PrimaryIP
+
+		// Top level 'primary_ip' is a read-only field for convenient access
+		if subnet.PrimaryIP != "" {
+			_ = d.Set("primary_ip", subnet.PrimaryIP)
+		}
+
+		if subnet.IPRanges != nil && len(subnet.IPRanges.Values) > 0 {
+			ipRangeSlice := make([]interface{}, len(subnet.IPRanges.Values))
+			for rangeIndex, ipRange := range subnet.IPRanges.Values {
+				ipRangeMap := make(map[string]interface{})
+				ipRangeMap["start_address"] = ipRange.StartAddress
+				ipRangeMap["end_address"] = ipRange.EndAddress
+
+				ipRangeSlice[rangeIndex] = ipRangeMap
+			}
+			subnetMap["allocated_ips"] = schema.NewSet(schema.HashResource(nsxtEdgeSubnetRange), ipRangeSlice)
+		}
+
+		subnetSlice[subnetIndex] = subnetMap
+	}
+
+	subnetSet := schema.NewSet(schema.HashResource(nsxtEdgeSubnet), subnetSlice)
+	err := d.Set("subnet", subnetSet)
+	if err != nil {
+		return fmt.Errorf("error setting 'subnet' block: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtEdgeGatewayBgpConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtEdgeGatewayBgpConfigurationCreateUpdate,
+		Read:   resourceVcdNsxtEdgeGatewayBgpConfigurationRead,
+		Update: resourceVcdNsxtEdgeGatewayBgpConfigurationCreateUpdate,
+		Delete: resourceVcdNsxtEdgeGatewayBgpConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtEdgeGatewayBgpConfigurationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID for BGP configuration",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Defines if BGP service is enabled",
+			},
+			"local_as_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Autonomous system number in ASPLAIN or ASDOT format. Only configurable for edge gateways with dedicated Tier-0 gateway",
+			},
+			"ecmp_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Defines if Equal Cost Multi-Path routing is enabled. Default 'false'",
+			},
+			"graceful_restart_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HELPER_ONLY",
+				Description:  "Graceful restart mode. One of 'DISABLE', 'HELPER_ONLY', 'GRACEFUL_AND_HELPER'. Default 'HELPER_ONLY'",
+				ValidateFunc: validation.StringInSlice([]string{"DISABLE", "HELPER_ONLY", "GRACEFUL_AND_HELPER"}, false),
+			},
+			"graceful_restart_timer": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Maximum time (in seconds) taken for a BGP session to be re-established after a restart",
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+			"stale_route_timer": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Maximum time (in seconds) before stale routes are removed after a BGP restart",
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtEdgeGatewayBgpConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP configuration create/update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+	bgpConfig := &nsxtBgpConfig{
+		Enabled:       d.Get("enabled").(bool),
+		Ecmp:          d.Get("ecmp_enabled").(bool),
+		LocalASNumber: d.Get("local_as_number").(string),
+		GracefulRestart: &nsxtBgpGracefulRestart{
+			Mode:            d.Get("graceful_restart_mode").(string),
+			RestartTimer:    d.Get("graceful_restart_timer").(int),
+			StaleRouteTimer: d.Get("stale_route_timer").(int),
+		},
+	}
+
+	_, err := updateNsxtBgpConfig(vcdClient, edgeGatewayId, bgpConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp configuration create/update] error updating BGP configuration: %s", err)
+	}
+
+	// BGP configuration is a single configuration object of edge gateway therefore edge gateway ID is used as ID
+	d.SetId(edgeGatewayId)
+
+	return resourceVcdNsxtEdgeGatewayBgpConfigurationRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayBgpConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP configuration read initiated")
+
+	bgpConfig, err := getNsxtBgpConfig(vcdClient, d.Id())
+	// If the edge gateway is not found - remove BGP configuration from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T edge gateway with ID %s no longer exists. Removing BGP configuration from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp configuration read] error retrieving BGP configuration: %s", err)
+	}
+
+	_ = d.Set("edge_gateway_id", d.Id())
+	_ = d.Set("enabled", bgpConfig.Enabled)
+	_ = d.Set("ecmp_enabled", bgpConfig.Ecmp)
+	_ = d.Set("local_as_number", bgpConfig.LocalASNumber)
+	if bgpConfig.GracefulRestart != nil {
+		_ = d.Set("graceful_restart_mode", bgpConfig.GracefulRestart.Mode)
+		_ = d.Set("graceful_restart_timer", bgpConfig.GracefulRestart.RestartTimer)
+		_ = d.Set("stale_route_timer", bgpConfig.GracefulRestart.StaleRouteTimer)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtEdgeGatewayBgpConfigurationDelete disables BGP service as BGP configuration cannot be removed
+func resourceVcdNsxtEdgeGatewayBgpConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP configuration deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	bgpConfig, err := getNsxtBgpConfig(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp configuration delete] error retrieving BGP configuration: %s", err)
+	}
+
+	bgpConfig.Enabled = false
+	bgpConfig.Ecmp = false
+	_, err = updateNsxtBgpConfig(vcdClient, d.Id(), bgpConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp configuration delete] error disabling BGP: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtEdgeGatewayBgpConfigurationImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_edgegateway_bgp_configuration.my-bgp
+// Example import path (_the_id_string_): org.vdc.edge-gw-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtEdgeGatewayBgpConfigurationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[nsxt bgp configuration import] resource name must be specified as org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt bgp configuration import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt bgp configuration import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(edgeGateway.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
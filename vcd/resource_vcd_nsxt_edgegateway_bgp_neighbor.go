@@ -0,0 +1,259 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtEdgeGatewayBgpNeighbor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtEdgeGatewayBgpNeighborCreate,
+		Read:   resourceVcdNsxtEdgeGatewayBgpNeighborRead,
+		Update: resourceVcdNsxtEdgeGatewayBgpNeighborUpdate,
+		Delete: resourceVcdNsxtEdgeGatewayBgpNeighborDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtEdgeGatewayBgpNeighborImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which BGP neighbor is located",
+			},
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "BGP neighbor IP address (IPv4 or IPv6)",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"remote_as_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Remote autonomous system number in ASPLAIN or ASDOT format",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Neighbor password. It is not read back from VCD",
+			},
+			"keep_alive_timer": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Time interval (in seconds) between sending keep alive messages to the neighbor",
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"hold_down_timer": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Time interval (in seconds) before declaring a neighbor dead. Must be at least three times 'keep_alive_timer'",
+				ValidateFunc: validation.IntBetween(3, 65535),
+			},
+			"graceful_restart_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Overrides graceful restart mode of BGP configuration. One of 'DISABLE', 'HELPER_ONLY', 'GRACEFUL_AND_HELPER'",
+				ValidateFunc: validation.StringInSlice([]string{"DISABLE", "HELPER_ONLY", "GRACEFUL_AND_HELPER"}, false),
+			},
+			"allow_as_in": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Defines if routes with the same autonomous system number as local one are accepted. Default 'false'",
+			},
+			"ip_address_type_filtering": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Defines which address families are exchanged. One of 'IPV4', 'IPV6', 'DISABLED'",
+				ValidateFunc: validation.StringInSlice([]string{"IPV4", "IPV6", "DISABLED"}, false),
+			},
+			"bfd_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Defines if Bidirectional Forwarding Detection is enabled. Default 'false'",
+			},
+			"bfd_interval": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time interval (in milliseconds) between sending BFD heartbeat packets",
+			},
+			"bfd_dead_multiple": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of missed BFD heartbeats after which the neighbor is declared dead",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtEdgeGatewayBgpNeighborCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP neighbor creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	neighbor, err := createNsxtBgpNeighbor(vcdClient, d.Get("edge_gateway_id").(string), getNsxtBgpNeighborType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp neighbor create] error creating BGP neighbor '%s': %s", d.Get("ip_address").(string), err)
+	}
+
+	d.SetId(neighbor.ID)
+
+	return resourceVcdNsxtEdgeGatewayBgpNeighborRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayBgpNeighborUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP neighbor update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	neighbor := getNsxtBgpNeighborType(d)
+	neighbor.ID = d.Id()
+
+	_, err := updateNsxtBgpNeighbor(vcdClient, d.Get("edge_gateway_id").(string), neighbor)
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp neighbor update] error updating BGP neighbor '%s': %s", neighbor.NeighborAddress, err)
+	}
+
+	return resourceVcdNsxtEdgeGatewayBgpNeighborRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayBgpNeighborRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP neighbor read initiated")
+
+	neighbor, err := getNsxtBgpNeighborById(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	// If the BGP neighbor is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T BGP neighbor with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp neighbor read] error retrieving BGP neighbor: %s", err)
+	}
+
+	_ = d.Set("ip_address", neighbor.NeighborAddress)
+	_ = d.Set("remote_as_number", neighbor.RemoteASNumber)
+	_ = d.Set("keep_alive_timer", neighbor.KeepAliveTimer)
+	_ = d.Set("hold_down_timer", neighbor.HoldDownTimer)
+	_ = d.Set("graceful_restart_mode", neighbor.GracefulRestartMode)
+	_ = d.Set("allow_as_in", neighbor.AllowASIn)
+	_ = d.Set("ip_address_type_filtering", neighbor.IpAddressTypeFiltering)
+	if neighbor.Bfd != nil {
+		_ = d.Set("bfd_enabled", neighbor.Bfd.Enabled)
+		_ = d.Set("bfd_interval", neighbor.Bfd.BfdInterval)
+		_ = d.Set("bfd_dead_multiple", neighbor.Bfd.DeclareDeadMultiple)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtEdgeGatewayBgpNeighborDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T BGP neighbor deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtBgpNeighbor(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt bgp neighbor delete] error deleting BGP neighbor: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtEdgeGatewayBgpNeighborImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_edgegateway_bgp_neighbor.my-neighbor
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.neighbor-ip-address
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR. As IP
+// addresses contain dots, everything after the third separator is treated as neighbor IP address.
+func resourceVcdNsxtEdgeGatewayBgpNeighborImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.SplitN(d.Id(), ImportSeparator, 4)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt bgp neighbor import] resource name must be specified as org-name.vdc-name.edge-gw-name.neighbor-ip-address")
+	}
+	orgName, vdcName, edgeName, neighborAddress := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt bgp neighbor import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt bgp neighbor import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	neighbor, err := getNsxtBgpNeighborByAddress(vcdClient, edgeGateway.ID, neighborAddress)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt bgp neighbor import] unable to find BGP neighbor '%s': %s", neighborAddress, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(neighbor.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtBgpNeighborType converts Terraform schema into BGP neighbor structure
+func getNsxtBgpNeighborType(d *schema.ResourceData) *nsxtBgpNeighbor {
+	return &nsxtBgpNeighbor{
+		NeighborAddress:        d.Get("ip_address").(string),
+		RemoteASNumber:         d.Get("remote_as_number").(string),
+		KeepAliveTimer:         d.Get("keep_alive_timer").(int),
+		HoldDownTimer:          d.Get("hold_down_timer").(int),
+		NeighborPassword:       d.Get("password").(string),
+		AllowASIn:              d.Get("allow_as_in").(bool),
+		GracefulRestartMode:    d.Get("graceful_restart_mode").(string),
+		IpAddressTypeFiltering: d.Get("ip_address_type_filtering").(string),
+		Bfd: &nsxtBgpNeighborBfd{
+			Enabled:             d.Get("bfd_enabled").(bool),
+			BfdInterval:         d.Get("bfd_interval").(int),
+			DeclareDeadMultiple: d.Get("bfd_dead_multiple").(int),
+		},
+	}
+}
@@ -0,0 +1,390 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtEdgeGatewayStaticRoute(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"RouteName":       t.Name(),
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtEdgeGatewayStaticRouteStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtEdgeGatewayStaticRouteStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_edgegateway_static_route.route1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtStaticRouteDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "static route description"),
+					resource.TestCheckResourceAttr(resourceName, "network_cidr", "10.10.20.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "next_hop.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "next_hop.*", map[string]string{
+						"ip_address":     "4.3.2.1",
+						"admin_distance": "1",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_cidr", "10.10.30.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "next_hop.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "next_hop.*", map[string]string{
+						"ip_address":     "4.3.2.1",
+						"admin_distance": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "next_hop.*", map[string]string{
+						"ip_address":     "4.3.2.2",
+						"admin_distance": "5",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+			},
+		},
+	})
+}
+
+func TestAccVcdNsxtEdgeGatewayBgp(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtEdgeGatewayBgpStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtEdgeGatewayBgpStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	bgpResourceName := "vcd_nsxt_edgegateway_bgp_configuration.bgp"
+	neighborResourceName := "vcd_nsxt_edgegateway_bgp_neighbor.neighbor1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtBgpNeighborDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, "1.1.1.1"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(bgpResourceName, "id", "data.vcd_nsxt_edgegateway.existing", "id"),
+					resource.TestCheckResourceAttr(bgpResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(bgpResourceName, "ecmp_enabled", "true"),
+					resource.TestCheckResourceAttr(bgpResourceName, "graceful_restart_mode", "HELPER_ONLY"),
+					resource.TestMatchResourceAttr(neighborResourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(neighborResourceName, "ip_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(neighborResourceName, "remote_as_number", "62513"),
+					resource.TestCheckResourceAttr(neighborResourceName, "keep_alive_timer", "80"),
+					resource.TestCheckResourceAttr(neighborResourceName, "hold_down_timer", "241"),
+					resource.TestCheckResourceAttr(neighborResourceName, "allow_as_in", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(bgpResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(bgpResourceName, "ecmp_enabled", "false"),
+					resource.TestCheckResourceAttr(bgpResourceName, "graceful_restart_mode", "DISABLE"),
+					resource.TestCheckResourceAttr(neighborResourceName, "remote_as_number", "62514"),
+					resource.TestCheckResourceAttr(neighborResourceName, "keep_alive_timer", "60"),
+					resource.TestCheckResourceAttr(neighborResourceName, "hold_down_timer", "180"),
+					resource.TestCheckResourceAttr(neighborResourceName, "allow_as_in", "true"),
+					resource.TestCheckResourceAttr(neighborResourceName, "graceful_restart_mode", "GRACEFUL_AND_HELPER"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      bgpResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.VCD.Org + ImportSeparator + testConfig.Nsxt.Vdc + ImportSeparator + testConfig.Nsxt.EdgeGateway,
+			},
+			resource.TestStep{
+				ResourceName:      neighborResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, "1.1.1.1"),
+				// Password is never returned by VCD
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+// TestAccVcdNsxtRouteAdvertisement requires that the configured NSX-T edge gateway uses a dedicated Tier-0 gateway
+func TestAccVcdNsxtRouteAdvertisement(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtRouteAdvertisementStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtRouteAdvertisementStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_route_advertisement.adv"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "data.vcd_nsxt_edgegateway.existing", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subnets.*", "192.168.1.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subnets.*", "192.168.2.0/24"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subnets.*", "192.168.3.0/24"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.VCD.Org + ImportSeparator + testConfig.Nsxt.Vdc + ImportSeparator + testConfig.Nsxt.EdgeGateway,
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtStaticRouteDestroy checks that no static routes with given name are left on NSX-T edge gateway
+func testAccCheckNsxtStaticRouteDestroy(vdcName, edgeGatewayName, routeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		_, err = getNsxtStaticRouteByName(conn, edgeGateway.ID, routeName)
+		if err == nil {
+			return fmt.Errorf("static route '%s' still exists", routeName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking static route '%s': %s", routeName, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNsxtBgpNeighborDestroy checks that BGP neighbor with given address is removed from NSX-T edge gateway
+func testAccCheckNsxtBgpNeighborDestroy(vdcName, edgeGatewayName, neighborAddress string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		_, err = getNsxtBgpNeighborByAddress(conn, edgeGateway.ID, neighborAddress)
+		if err == nil {
+			return fmt.Errorf("BGP neighbor '%s' still exists", neighborAddress)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking BGP neighbor '%s': %s", neighborAddress, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtEdgeGatewayRoutingDataSource = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+`
+
+const testAccNsxtEdgeGatewayStaticRouteStep1 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_edgegateway_static_route" "route1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name         = "{{.RouteName}}"
+  description  = "static route description"
+  network_cidr = "10.10.20.0/24"
+
+  next_hop {
+    ip_address = "4.3.2.1"
+  }
+}
+`
+
+const testAccNsxtEdgeGatewayStaticRouteStep2 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_edgegateway_static_route" "route1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name         = "{{.RouteName}}"
+  network_cidr = "10.10.30.0/24"
+
+  next_hop {
+    ip_address     = "4.3.2.1"
+    admin_distance = 2
+  }
+
+  next_hop {
+    ip_address     = "4.3.2.2"
+    admin_distance = 5
+  }
+}
+`
+
+const testAccNsxtEdgeGatewayBgpStep1 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_edgegateway_bgp_configuration" "bgp" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  enabled      = true
+  ecmp_enabled = true
+}
+
+resource "vcd_nsxt_edgegateway_bgp_neighbor" "neighbor1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = vcd_nsxt_edgegateway_bgp_configuration.bgp.edge_gateway_id
+
+  ip_address       = "1.1.1.1"
+  remote_as_number = "62513"
+  password         = "neighbor-password"
+  keep_alive_timer = 80
+  hold_down_timer  = 241
+}
+`
+
+const testAccNsxtEdgeGatewayBgpStep2 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_edgegateway_bgp_configuration" "bgp" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  enabled               = false
+  graceful_restart_mode = "DISABLE"
+}
+
+resource "vcd_nsxt_edgegateway_bgp_neighbor" "neighbor1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = vcd_nsxt_edgegateway_bgp_configuration.bgp.edge_gateway_id
+
+  ip_address            = "1.1.1.1"
+  remote_as_number      = "62514"
+  keep_alive_timer      = 60
+  hold_down_timer       = 180
+  allow_as_in           = true
+  graceful_restart_mode = "GRACEFUL_AND_HELPER"
+}
+`
+
+const testAccNsxtRouteAdvertisementStep1 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_route_advertisement" "adv" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  subnets = ["192.168.1.0/24", "192.168.2.0/24"]
+}
+`
+
+const testAccNsxtRouteAdvertisementStep2 = testAccNsxtEdgeGatewayRoutingDataSource + `
+resource "vcd_nsxt_route_advertisement" "adv" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  subnets = ["192.168.3.0/24"]
+}
+`
@@ -0,0 +1,235 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtStaticRouteNextHopSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"ip_address": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of next hop",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"admin_distance": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Admin distance is used to choose which route to use when there are multiple routes for a specific network. Default '1'",
+			ValidateFunc: validation.IntBetween(1, 255),
+		},
+	},
+}
+
+func resourceVcdNsxtEdgeGatewayStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtEdgeGatewayStaticRouteCreate,
+		Read:   resourceVcdNsxtEdgeGatewayStaticRouteRead,
+		Update: resourceVcdNsxtEdgeGatewayStaticRouteUpdate,
+		Delete: resourceVcdNsxtEdgeGatewayStaticRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtEdgeGatewayStaticRouteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which static route is located",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Static route name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Static route description",
+			},
+			"network_cidr": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Destination network in CIDR format (e.g. 192.168.1.0/24)",
+				ValidateFunc: validation.IsCIDR,
+			},
+			"next_hop": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "A set of next hops to use within the static route",
+				Elem:        nsxtStaticRouteNextHopSchema,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtEdgeGatewayStaticRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T static route creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	staticRoute, err := createNsxtStaticRoute(vcdClient, d.Get("edge_gateway_id").(string), getNsxtStaticRouteType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt static route create] error creating static route '%s': %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(staticRoute.ID)
+
+	return resourceVcdNsxtEdgeGatewayStaticRouteRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayStaticRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T static route update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	staticRoute := getNsxtStaticRouteType(d)
+	staticRoute.ID = d.Id()
+
+	_, err := updateNsxtStaticRoute(vcdClient, d.Get("edge_gateway_id").(string), staticRoute)
+	if err != nil {
+		return fmt.Errorf("[nsxt static route update] error updating static route '%s': %s", staticRoute.Name, err)
+	}
+
+	return resourceVcdNsxtEdgeGatewayStaticRouteRead(d, meta)
+}
+
+func resourceVcdNsxtEdgeGatewayStaticRouteRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T static route read initiated")
+
+	staticRoute, err := getNsxtStaticRouteById(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	// If the static route is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T static route with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt static route read] error retrieving static route: %s", err)
+	}
+
+	_ = d.Set("name", staticRoute.Name)
+	_ = d.Set("description", staticRoute.Description)
+	_ = d.Set("network_cidr", staticRoute.NetworkCidr)
+
+	nextHops := make([]interface{}, len(staticRoute.NextHops))
+	for index, nextHop := range staticRoute.NextHops {
+		nextHops[index] = map[string]interface{}{
+			"ip_address":     nextHop.IpAddress,
+			"admin_distance": nextHop.AdminDistance,
+		}
+	}
+	err = d.Set("next_hop", schema.NewSet(schema.HashResource(nsxtStaticRouteNextHopSchema), nextHops))
+	if err != nil {
+		return fmt.Errorf("[nsxt static route read] error setting 'next_hop': %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtEdgeGatewayStaticRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T static route deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtStaticRoute(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt static route delete] error deleting static route: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtEdgeGatewayStaticRouteImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_edgegateway_static_route.my-route
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.static-route-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtEdgeGatewayStaticRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt static route import] resource name must be specified as org-name.vdc-name.edge-gw-name.static-route-name")
+	}
+	orgName, vdcName, edgeName, staticRouteName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt static route import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt static route import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	staticRoute, err := getNsxtStaticRouteByName(vcdClient, edgeGateway.ID, staticRouteName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt static route import] unable to find static route '%s': %s", staticRouteName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(staticRoute.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtStaticRouteType converts Terraform schema into static route structure
+func getNsxtStaticRouteType(d *schema.ResourceData) *nsxtStaticRoute {
+	staticRoute := &nsxtStaticRoute{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		NetworkCidr: d.Get("network_cidr").(string),
+	}
+
+	nextHopSet := d.Get("next_hop").(*schema.Set)
+	staticRoute.NextHops = make([]nsxtStaticRouteHop, nextHopSet.Len())
+	for index, nextHop := range nextHopSet.List() {
+		nextHopMap := nextHop.(map[string]interface{})
+		staticRoute.NextHops[index] = nsxtStaticRouteHop{
+			IpAddress:     nextHopMap["ip_address"].(string),
+			AdminDistance: nextHopMap["admin_distance"].(int),
+		}
+	}
+
+	return staticRoute
+}
@@ -0,0 +1,152 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtEdgeGateway(t *testing.T) {
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtVdcConfiguration(t)
+	vcdClient := createTemporaryVCDConnection()
+	if vcdClient.Client.APIVCDMaxVersionIs("< 34.0") {
+		t.Skip(t.Name() + " requires at least API v34.0 (vCD 10.1+)")
+	}
+
+	var params = StringMap{
+		"Org":                 testConfig.VCD.Org,
+		"NsxtVdc":             testConfig.Nsxt.Vdc,
+		"NsxtEdgeGatewayName": t.Name(),
+		"ExternalNetwork":     testConfig.Nsxt.ExternalNetwork,
+		"Description":         "Test NSX-T edge gateway",
+		"Tags":                "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtEdgeGateway, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	params["NsxtEdgeGatewayName"] = t.Name() + "-updated"
+	params["Description"] = "Updated NSX-T edge gateway"
+	configText1 := templateFill(testAccNsxtEdgeGatewayStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_edgegateway.nsxt-edge"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtEdgeGatewayDestroy(t.Name() + "-updated"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:gateway:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test NSX-T edge gateway"),
+					resource.TestCheckResourceAttr(resourceName, "dedicate_external_network", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "external_network_id", "data.vcd_external_network_v2.existing-extnet", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "edge_cluster_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:gateway:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated NSX-T edge gateway"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "data.vcd_nsxt_edgegateway.ds", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "data.vcd_nsxt_edgegateway.ds", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "description", "data.vcd_nsxt_edgegateway.ds", "description"),
+					resource.TestCheckResourceAttrPair(resourceName, "external_network_id", "data.vcd_nsxt_edgegateway.ds", "external_network_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_ip", "data.vcd_nsxt_edgegateway.ds", "primary_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "edge_cluster_id", "data.vcd_nsxt_edgegateway.ds", "edge_cluster_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet.#", "data.vcd_nsxt_edgegateway.ds", "subnet.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNsxtEdgeGateway = `
+data "vcd_external_network_v2" "existing-extnet" {
+  name = "{{.ExternalNetwork}}"
+}
+
+resource "vcd_nsxt_edgegateway" "nsxt-edge" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NsxtEdgeGatewayName}}"
+  description = "{{.Description}}"
+
+  external_network_id = data.vcd_external_network_v2.existing-extnet.id
+
+  subnet {
+    gateway       = tolist(data.vcd_external_network_v2.existing-extnet.ip_scope)[0].gateway
+    prefix_length = tolist(data.vcd_external_network_v2.existing-extnet.ip_scope)[0].prefix_length
+    # primary_ip should fall into defined "allocated_ips" range as otherwise
+    # the next apply will report additional range of "allocated_ips" with
+    # the range containing single "primary_ip" and will cause non-empty plan.
+    primary_ip = tolist(tolist(data.vcd_external_network_v2.existing-extnet.ip_scope)[0].static_ip_pool)[0].end_address
+
+    allocated_ips {
+      start_address = tolist(tolist(data.vcd_external_network_v2.existing-extnet.ip_scope)[0].static_ip_pool)[0].end_address
+      end_address   = tolist(tolist(data.vcd_external_network_v2.existing-extnet.ip_scope)[0].static_ip_pool)[0].end_address
+    }
+  }
+}
+`
+
+const testAccNsxtEdgeGatewayStep1 = testAccNsxtEdgeGateway + `
+data "vcd_nsxt_edgegateway" "ds" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = vcd_nsxt_edgegateway.nsxt-edge.name
+}
+`
+
+func testAccCheckNsxtEdgeGatewayDestroy(edgeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "vcd_nsxt_edgegateway" {
+				continue
+			}
+
+			conn := testAccProvider.Meta().(*VCDClient)
+			_, err := getNsxtEdgeGatewayById(conn, rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("NSX-T edge gateway %s still exists", edgeName)
+			}
+			if !govcd.ContainsNotFound(err) {
+				return fmt.Errorf("error checking if NSX-T edge gateway %s was deleted: %s", edgeName, err)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,311 @@
+package vcd
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtFirewallRuleSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Firewall rule ID",
+		},
+		"name": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Firewall rule name",
+		},
+		"action": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Defines if the rule should 'ALLOW', 'DROP' or 'REJECT' matching traffic",
+			ValidateFunc: validation.StringInSlice([]string{"ALLOW", "DROP", "REJECT"}, false),
+		},
+		"enabled": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Defines if the rule is enabled. Default 'true'",
+		},
+		"logging": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Defines if logging for this rule is enabled. Default 'false'",
+		},
+		"direction": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Direction on which Firewall Rule applies (One of 'IN', 'OUT', 'IN_OUT')",
+			ValidateFunc: validation.StringInSlice([]string{"IN", "OUT", "IN_OUT"}, false),
+		},
+		"ip_protocol": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Firewall Rule Protocol (One of 'IPV4', 'IPV6', 'IPV4_IPV6')",
+			ValidateFunc: validation.StringInSlice([]string{"IPV4", "IPV6", "IPV4_IPV6"}, false),
+		},
+		"source_ids": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "A set of Source Firewall Group IDs (IP Sets or Security Groups). Leaving it empty matches 'Any'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"destination_ids": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "A set of Destination Firewall Group IDs (IP Sets or Security Groups). Leaving it empty matches 'Any'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"app_port_profile_ids": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "A set of Application Port Profile IDs. Leaving it empty matches 'Any'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+func resourceVcdNsxtFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtFirewallCreateUpdate,
+		Read:   resourceVcdNsxtFirewallRead,
+		Update: resourceVcdNsxtFirewallCreateUpdate,
+		Delete: resourceVcdNsxtFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtFirewallImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which Firewall Rules are located",
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Ordered list of firewall rules. Rules are processed in the order they are defined",
+				Elem:        nsxtFirewallRuleSchema,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T firewall create/update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+	firewall := getNsxtFirewallType(d)
+
+	_, err := updateNsxtFirewall(vcdClient, edgeGatewayId, firewall)
+	if err != nil {
+		return fmt.Errorf("[nsxt firewall create/update] error setting firewall rules: %s", err)
+	}
+
+	// Firewall rules are a single configuration object of edge gateway therefore edge gateway ID is used as ID
+	d.SetId(edgeGatewayId)
+
+	return resourceVcdNsxtFirewallRead(d, meta)
+}
+
+func resourceVcdNsxtFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T firewall read initiated")
+
+	firewall, err := getNsxtFirewall(vcdClient, d.Get("edge_gateway_id").(string))
+	// If the edge gateway is not found - remove firewall from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T edge gateway with ID %s no longer exists. Removing firewall from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt firewall read] error retrieving firewall rules: %s", err)
+	}
+
+	err = setNsxtFirewallData(firewall.UserDefinedRules, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt firewall read] error storing firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T firewall deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtFirewall(vcdClient, d.Get("edge_gateway_id").(string))
+	if err != nil {
+		return fmt.Errorf("[nsxt firewall delete] error deleting firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtFirewallImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_firewall.my-firewall
+// Example import path (_the_id_string_): org.vdc.edge-gw-name
+// Example listing path (_the_id_string_): list@org.vdc.edge-gw-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtFirewallImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var orgName, vdcName, edgeName string
+	var listRules bool
+
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	helpError := fmt.Errorf(`resource id must be specified in one of these formats:
+'org-name.vdc-name.edge-gw-name' to import all firewall rules of NSX-T edge gateway
+'list@org-name.vdc-name.edge-gw-name' to get a list of rules with their respective order and IDs`)
+
+	log.Printf("[DEBUG] importing vcd_nsxt_firewall resource with provided id %s", d.Id())
+
+	if len(resourceURI) != 3 {
+		return nil, helpError
+	}
+	orgName, vdcName, edgeName = resourceURI[0], resourceURI[1], resourceURI[2]
+
+	if strings.Contains(orgName, "@") {
+		commandOrgNameSplit := strings.Split(orgName, "@")
+		if len(commandOrgNameSplit) != 2 || commandOrgNameSplit[0] != "list" {
+			return nil, helpError
+		}
+		orgName = commandOrgNameSplit[1]
+		listRules = true
+	}
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt firewall import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt firewall import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	// If the user requested to print rules, try to fetch all of them and print in a user friendly
+	// table with their order and real firewall IDs
+	if listRules {
+		stdout := getTerraformStdout() // share the same stdout for multiple print statements
+		_, _ = fmt.Fprintln(stdout, "Retrieving all firewall rules")
+		firewall, err := getNsxtFirewall(vcdClient, edgeGateway.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve all firewall rules: %s", err)
+		}
+
+		tableWriter := new(bytes.Buffer)
+		writer := tabwriter.NewWriter(tableWriter, 0, 8, 1, '\t', tabwriter.AlignRight)
+		fmt.Fprintln(writer, "No\tID\tName\tAction\tDirection\tIP Protocol")
+		fmt.Fprintln(writer, "--\t--\t----\t------\t---------\t-----------")
+		for index, rule := range firewall.UserDefinedRules {
+			fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%s\n", (index + 1), rule.ID, rule.Name, rule.Action,
+				rule.Direction, rule.IpProtocol)
+		}
+		writer.Flush()
+		_, _ = fmt.Fprintln(stdout, tableWriter.String())
+
+		return nil, fmt.Errorf("resource was not imported! %s", helpError.Error())
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(edgeGateway.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setNsxtFirewallData stores user defined NSX-T firewall rules in Terraform schema preserving their order
+func setNsxtFirewallData(rules []*nsxtFirewallRule, d *schema.ResourceData) error {
+	ruleSlice := make([]interface{}, len(rules))
+	for index, rule := range rules {
+		ruleMap := make(map[string]interface{})
+		ruleMap["id"] = rule.ID
+		ruleMap["name"] = rule.Name
+		ruleMap["action"] = rule.Action
+		ruleMap["enabled"] = rule.Enabled
+		ruleMap["logging"] = rule.Logging
+		ruleMap["direction"] = rule.Direction
+		ruleMap["ip_protocol"] = rule.IpProtocol
+		ruleMap["source_ids"] = convertToTypeSet(extractIdsFromOpenApiReferences(rule.SourceFirewallGroups))
+		ruleMap["destination_ids"] = convertToTypeSet(extractIdsFromOpenApiReferences(rule.DestinationFirewallGroups))
+		ruleMap["app_port_profile_ids"] = convertToTypeSet(extractIdsFromOpenApiReferences(rule.ApplicationPortProfiles))
+
+		ruleSlice[index] = ruleMap
+	}
+
+	return d.Set("rule", ruleSlice)
+}
+
+// getNsxtFirewallType converts Terraform schema into NSX-T firewall structure containing user defined rules
+func getNsxtFirewallType(d *schema.ResourceData) *nsxtFirewall {
+	firewall := &nsxtFirewall{}
+
+	rules := d.Get("rule").([]interface{})
+	firewall.UserDefinedRules = make([]*nsxtFirewallRule, len(rules))
+	for index, ruleInterface := range rules {
+		ruleMap := ruleInterface.(map[string]interface{})
+
+		firewall.UserDefinedRules[index] = &nsxtFirewallRule{
+			Name:                      ruleMap["name"].(string),
+			Action:                    ruleMap["action"].(string),
+			Enabled:                   ruleMap["enabled"].(bool),
+			Logging:                   ruleMap["logging"].(bool),
+			Direction:                 ruleMap["direction"].(string),
+			IpProtocol:                ruleMap["ip_protocol"].(string),
+			SourceFirewallGroups:      convertSetToOpenApiReferences(ruleMap["source_ids"].(*schema.Set)),
+			DestinationFirewallGroups: convertSetToOpenApiReferences(ruleMap["destination_ids"].(*schema.Set)),
+			ApplicationPortProfiles:   convertSetToOpenApiReferences(ruleMap["app_port_profile_ids"].(*schema.Set)),
+		}
+	}
+
+	return firewall
+}
@@ -0,0 +1,171 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVcdNsxtFirewall(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtFirewallStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtFirewallStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_firewall.testing"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtFirewallRulesDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:gateway:`)),
+					resource.TestCheckResourceAttrPair(resourceName, "edge_gateway_id", "data.vcd_nsxt_edgegateway.existing", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "rule.0.id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "test_rule"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.direction", "IN"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.ip_protocol", "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.logging", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.source_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination_ids.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					// Rule order must be preserved
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "test_rule-3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.direction", "IN_OUT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.ip_protocol", "IPV4_IPV6"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.logging", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.name", "test_rule"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action", "DROP"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.name", "test_rule-2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.direction", "OUT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.ip_protocol", "IPV6"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.action", "REJECT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, testConfig.Nsxt.EdgeGateway),
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtFirewallRulesDestroy checks that no user defined firewall rules are left on NSX-T edge gateway
+func testAccCheckNsxtFirewallRulesDestroy(vdcName, edgeGatewayName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		firewall, err := getNsxtFirewall(conn, edgeGateway.ID)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve firewall rules: %s", err)
+		}
+
+		if len(firewall.UserDefinedRules) > 0 {
+			return fmt.Errorf("%d user defined firewall rules still exist", len(firewall.UserDefinedRules))
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtFirewallDataSource = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+`
+
+const testAccNsxtFirewallStep1 = testAccNsxtFirewallDataSource + `
+resource "vcd_nsxt_firewall" "testing" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  rule {
+    name        = "test_rule"
+    direction   = "IN"
+    ip_protocol = "IPV4"
+    action      = "DROP"
+  }
+}
+`
+
+const testAccNsxtFirewallStep2 = testAccNsxtFirewallDataSource + `
+resource "vcd_nsxt_firewall" "testing" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  rule {
+    name        = "test_rule-3"
+    direction   = "IN_OUT"
+    ip_protocol = "IPV4_IPV6"
+    action      = "ALLOW"
+    logging     = true
+  }
+
+  rule {
+    name        = "test_rule"
+    direction   = "IN"
+    ip_protocol = "IPV4"
+    action      = "DROP"
+  }
+
+  rule {
+    name        = "test_rule-2"
+    direction   = "OUT"
+    ip_protocol = "IPV6"
+    action      = "REJECT"
+    enabled     = false
+  }
+}
+`
@@ -0,0 +1,496 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtIpSecVpnTunnelSecurityProfileSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"ike_version": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IKE version one of 'IKE_V1', 'IKE_V2', 'IKE_FLEX'",
+			ValidateFunc: validation.StringInSlice([]string{"IKE_V1", "IKE_V2", "IKE_FLEX"}, false),
+		},
+		"ike_encryption_algorithms": &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Encryption algorithms. One of 'AES_128', 'AES_256', 'AES_GCM_128', 'AES_GCM_192', 'AES_GCM_256'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ike_digest_algorithms": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Secure hashing algorithms to use during the IKE negotiation. One of 'SHA1', 'SHA2_256', 'SHA2_384', 'SHA2_512'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ike_dh_groups": &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Diffie-Hellman groups to be used if Perfect Forward Secrecy is enabled. One of 'GROUP2', 'GROUP5', 'GROUP14', 'GROUP15', 'GROUP16', 'GROUP19', 'GROUP20', 'GROUP21'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ike_sa_lifetime": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Security Association life time (in seconds)",
+		},
+		"tunnel_pfs_enabled": &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Perfect Forward Secrecy Enabled or Disabled. Default 'true'",
+		},
+		"tunnel_encryption_algorithms": &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Encryption algorithms to use in IPsec tunnel establishment. One of 'AES_128', 'AES_256', 'AES_GCM_128', 'AES_GCM_192', 'AES_GCM_256', 'NO_ENCRYPTION_AUTH_AES_GMAC_128', 'NO_ENCRYPTION_AUTH_AES_GMAC_192', 'NO_ENCRYPTION_AUTH_AES_GMAC_256', 'NO_ENCRYPTION'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tunnel_digest_algorithms": &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Digest algorithms to be used for message digest. One of 'SHA1', 'SHA2_256', 'SHA2_384', 'SHA2_512'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tunnel_dh_groups": &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Diffie-Hellman groups to be used is PFS is enabled. One of 'GROUP2', 'GROUP5', 'GROUP14', 'GROUP15', 'GROUP16', 'GROUP19', 'GROUP20', 'GROUP21'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tunnel_sa_lifetime": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Security Association life time (in seconds)",
+		},
+		"dpd_probe_internal": &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Value in seconds of dead probe detection interval. Minimum is 3 seconds and the maximum is 60 seconds",
+		},
+	},
+}
+
+func resourceVcdNsxtIpSecVpnTunnel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtIpSecVpnTunnelCreate,
+		Read:   resourceVcdNsxtIpSecVpnTunnelRead,
+		Update: resourceVcdNsxtIpSecVpnTunnelUpdate,
+		Delete: resourceVcdNsxtIpSecVpnTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtIpSecVpnTunnelImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which IPsec VPN tunnel is located",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of IPsec VPN tunnel",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of IPsec VPN tunnel",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables IPsec VPN tunnel. Default 'true'",
+			},
+			"pre_shared_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Pre-Shared Key (PSK)",
+			},
+			"local_ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "IPv4 Address for the endpoint. This has to be a sub-allocated IP on the Edge Gateway",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"local_networks": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Set of local networks in CIDR format. At least one value is required",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"remote_ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Public IPv4 Address of the remote device terminating the VPN connection",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"remote_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Custom remote ID of the peer site. 'remote_ip_address' is used when not specified",
+			},
+			"remote_networks": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of remote networks in CIDR format. Leaving it empty is interpreted as 0.0.0.0/0",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"logging": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Sets whether logging for the tunnel is enabled or not. Default 'false'",
+			},
+			"security_profile_customization": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Security profile customization. Default security profile is used when not specified",
+				Elem:        nsxtIpSecVpnTunnelSecurityProfileSchema,
+			},
+			"security_profile": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Security type which is used for IPsec VPN Tunnel. It will be 'DEFAULT' if nothing is customized and 'CUSTOM' if some changes are applied",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Overall IPsec VPN Tunnel Status",
+			},
+			"ike_service_status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status for the actual IKE Session for the given tunnel",
+			},
+			"ike_fail_reason": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provides more details of failure if the IKE service is not UP",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtIpSecVpnTunnelCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T IPsec VPN tunnel creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+	tunnel := getNsxtIpSecVpnTunnelType(d)
+
+	createdTunnel, err := createNsxtIpSecVpnTunnel(vcdClient, edgeGatewayId, tunnel)
+	if err != nil {
+		return fmt.Errorf("[nsxt ipsec vpn tunnel create] error creating IPsec VPN tunnel: %s", err)
+	}
+
+	d.SetId(createdTunnel.ID)
+
+	// Security profile can only be customized after the tunnel is created
+	if _, isSet := d.GetOk("security_profile_customization"); isSet {
+		_, err = updateNsxtIpSecVpnTunnelSecurityProfile(vcdClient, edgeGatewayId, createdTunnel.ID,
+			getNsxtIpSecVpnTunnelSecurityProfileType(d))
+		if err != nil {
+			return fmt.Errorf("[nsxt ipsec vpn tunnel create] error customizing security profile: %s", err)
+		}
+	}
+
+	return resourceVcdNsxtIpSecVpnTunnelRead(d, meta)
+}
+
+func resourceVcdNsxtIpSecVpnTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T IPsec VPN tunnel update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+
+	if d.HasChanges("name", "description", "enabled", "pre_shared_key", "local_ip_address", "local_networks",
+		"remote_ip_address", "remote_id", "remote_networks", "logging") {
+		tunnel := getNsxtIpSecVpnTunnelType(d)
+		tunnel.ID = d.Id()
+
+		_, err := updateNsxtIpSecVpnTunnel(vcdClient, edgeGatewayId, tunnel)
+		if err != nil {
+			return fmt.Errorf("[nsxt ipsec vpn tunnel update] error updating IPsec VPN tunnel: %s", err)
+		}
+	}
+
+	// Removing customization block resets the security profile to 'DEFAULT'
+	if d.HasChange("security_profile_customization") {
+		_, err := updateNsxtIpSecVpnTunnelSecurityProfile(vcdClient, edgeGatewayId, d.Id(),
+			getNsxtIpSecVpnTunnelSecurityProfileType(d))
+		if err != nil {
+			return fmt.Errorf("[nsxt ipsec vpn tunnel update] error updating security profile: %s", err)
+		}
+	}
+
+	return resourceVcdNsxtIpSecVpnTunnelRead(d, meta)
+}
+
+func resourceVcdNsxtIpSecVpnTunnelRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T IPsec VPN tunnel read initiated")
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+
+	tunnel, err := getNsxtIpSecVpnTunnelById(vcdClient, edgeGatewayId, d.Id())
+	// If the tunnel is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T IPsec VPN tunnel with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt ipsec vpn tunnel read] error retrieving IPsec VPN tunnel: %s", err)
+	}
+
+	setNsxtIpSecVpnTunnelData(tunnel, d)
+
+	profile, err := getNsxtIpSecVpnTunnelSecurityProfile(vcdClient, edgeGatewayId, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt ipsec vpn tunnel read] error retrieving security profile: %s", err)
+	}
+
+	err = setNsxtIpSecVpnTunnelSecurityProfileData(profile, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt ipsec vpn tunnel read] error storing security profile: %s", err)
+	}
+
+	// Status is informational only and should not fail the whole read operation
+	status, err := getNsxtIpSecVpnTunnelStatus(vcdClient, edgeGatewayId, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] unable to retrieve status of IPsec VPN tunnel %s: %s", d.Id(), err)
+		return nil
+	}
+	_ = d.Set("status", status.TunnelStatus.Status)
+	_ = d.Set("ike_service_status", status.IkeStatus.IkeServiceStatus)
+	_ = d.Set("ike_fail_reason", status.IkeStatus.FailReason)
+
+	return nil
+}
+
+func resourceVcdNsxtIpSecVpnTunnelDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T IPsec VPN tunnel deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtIpSecVpnTunnel(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt ipsec vpn tunnel delete] error deleting IPsec VPN tunnel: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtIpSecVpnTunnelImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_ipsec_vpn_tunnel.my-tunnel
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.tunnel-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtIpSecVpnTunnelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt ipsec vpn tunnel import] resource name must be specified as org-name.vdc-name.edge-gw-name.tunnel-name")
+	}
+	orgName, vdcName, edgeName, tunnelName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt ipsec vpn tunnel import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt ipsec vpn tunnel import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	tunnel, err := getNsxtIpSecVpnTunnelByName(vcdClient, edgeGateway.ID, tunnelName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt ipsec vpn tunnel import] unable to find IPsec VPN tunnel '%s': %s", tunnelName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(tunnel.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtIpSecVpnTunnelType converts Terraform schema into NSX-T IPsec VPN tunnel structure
+func getNsxtIpSecVpnTunnelType(d *schema.ResourceData) *nsxtIpSecVpnTunnel {
+	return &nsxtIpSecVpnTunnel{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+		LocalEndpoint: nsxtIpSecVpnTunnelLocalEndpoint{
+			LocalAddress:  d.Get("local_ip_address").(string),
+			LocalNetworks: convertSchemaSetToSliceOfStrings(d.Get("local_networks").(*schema.Set)),
+		},
+		RemoteEndpoint: nsxtIpSecVpnTunnelRemoteEndpoint{
+			RemoteId:       d.Get("remote_id").(string),
+			RemoteAddress:  d.Get("remote_ip_address").(string),
+			RemoteNetworks: convertSchemaSetToSliceOfStrings(d.Get("remote_networks").(*schema.Set)),
+		},
+		PreSharedKey:       d.Get("pre_shared_key").(string),
+		Logging:            d.Get("logging").(bool),
+		AuthenticationMode: "PSK",
+	}
+}
+
+// setNsxtIpSecVpnTunnelData stores NSX-T IPsec VPN tunnel structure in Terraform schema
+func setNsxtIpSecVpnTunnelData(tunnel *nsxtIpSecVpnTunnel, d *schema.ResourceData) {
+	_ = d.Set("name", tunnel.Name)
+	_ = d.Set("description", tunnel.Description)
+	_ = d.Set("enabled", tunnel.Enabled)
+	_ = d.Set("local_ip_address", tunnel.LocalEndpoint.LocalAddress)
+	_ = d.Set("local_networks", convertToTypeSet(tunnel.LocalEndpoint.LocalNetworks))
+	_ = d.Set("remote_ip_address", tunnel.RemoteEndpoint.RemoteAddress)
+	_ = d.Set("remote_id", tunnel.RemoteEndpoint.RemoteId)
+	_ = d.Set("remote_networks", convertToTypeSet(tunnel.RemoteEndpoint.RemoteNetworks))
+	_ = d.Set("logging", tunnel.Logging)
+	_ = d.Set("security_profile", tunnel.SecurityType)
+
+	// Older VCD versions do not return pre-shared key
+	if tunnel.PreSharedKey != "" {
+		_ = d.Set("pre_shared_key", tunnel.PreSharedKey)
+	}
+}
+
+// getNsxtIpSecVpnTunnelSecurityProfileType converts 'security_profile_customization' block into security profile
+// structure. When the block is not set, 'DEFAULT' security type is returned which resets all customizations.
+func getNsxtIpSecVpnTunnelSecurityProfileType(d *schema.ResourceData) *nsxtIpSecVpnTunnelSecurityProfile {
+	customization := d.Get("security_profile_customization").([]interface{})
+	if len(customization) == 0 || customization[0] == nil {
+		return &nsxtIpSecVpnTunnelSecurityProfile{SecurityType: "DEFAULT"}
+	}
+
+	profileMap := customization[0].(map[string]interface{})
+	profile := &nsxtIpSecVpnTunnelSecurityProfile{
+		SecurityType: "CUSTOM",
+		IkeConfiguration: &nsxtIpSecVpnTunnelProfileIkeConfiguration{
+			IkeVersion:           profileMap["ike_version"].(string),
+			DhGroups:             convertSchemaSetToSliceOfStrings(profileMap["ike_dh_groups"].(*schema.Set)),
+			DigestAlgorithms:     convertSchemaSetToSliceOfStrings(profileMap["ike_digest_algorithms"].(*schema.Set)),
+			EncryptionAlgorithms: convertSchemaSetToSliceOfStrings(profileMap["ike_encryption_algorithms"].(*schema.Set)),
+		},
+		TunnelConfiguration: &nsxtIpSecVpnTunnelProfileTunnelConfiguration{
+			PerfectForwardSecrecyEnabled: profileMap["tunnel_pfs_enabled"].(bool),
+			DhGroups:                     convertSchemaSetToSliceOfStrings(profileMap["tunnel_dh_groups"].(*schema.Set)),
+			EncryptionAlgorithms:         convertSchemaSetToSliceOfStrings(profileMap["tunnel_encryption_algorithms"].(*schema.Set)),
+			DigestAlgorithms:             convertSchemaSetToSliceOfStrings(profileMap["tunnel_digest_algorithms"].(*schema.Set)),
+		},
+	}
+
+	if ikeSaLifetime := profileMap["ike_sa_lifetime"].(int); ikeSaLifetime > 0 {
+		profile.IkeConfiguration.SaLifeTime = takeIntPointer(ikeSaLifetime)
+	}
+
+	if tunnelSaLifetime := profileMap["tunnel_sa_lifetime"].(int); tunnelSaLifetime > 0 {
+		profile.TunnelConfiguration.SaLifeTime = takeIntPointer(tunnelSaLifetime)
+	}
+
+	if dpdProbeInterval := profileMap["dpd_probe_internal"].(int); dpdProbeInterval > 0 {
+		profile.DpdConfiguration = &nsxtIpSecVpnTunnelProfileDpdConfiguration{ProbeInterval: dpdProbeInterval}
+	}
+
+	return profile
+}
+
+// setNsxtIpSecVpnTunnelSecurityProfileData stores security profile in Terraform schema. The
+// 'security_profile_customization' block is only populated for 'CUSTOM' security type.
+func setNsxtIpSecVpnTunnelSecurityProfileData(profile *nsxtIpSecVpnTunnelSecurityProfile, d *schema.ResourceData) error {
+	_ = d.Set("security_profile", profile.SecurityType)
+
+	if profile.SecurityType != "CUSTOM" {
+		return d.Set("security_profile_customization", nil)
+	}
+
+	profileMap := make(map[string]interface{})
+	if profile.IkeConfiguration != nil {
+		profileMap["ike_version"] = profile.IkeConfiguration.IkeVersion
+		profileMap["ike_dh_groups"] = convertToTypeSet(profile.IkeConfiguration.DhGroups)
+		profileMap["ike_digest_algorithms"] = convertToTypeSet(profile.IkeConfiguration.DigestAlgorithms)
+		profileMap["ike_encryption_algorithms"] = convertToTypeSet(profile.IkeConfiguration.EncryptionAlgorithms)
+		if profile.IkeConfiguration.SaLifeTime != nil {
+			profileMap["ike_sa_lifetime"] = *profile.IkeConfiguration.SaLifeTime
+		}
+	}
+
+	if profile.TunnelConfiguration != nil {
+		profileMap["tunnel_pfs_enabled"] = profile.TunnelConfiguration.PerfectForwardSecrecyEnabled
+		profileMap["tunnel_dh_groups"] = convertToTypeSet(profile.TunnelConfiguration.DhGroups)
+		profileMap["tunnel_digest_algorithms"] = convertToTypeSet(profile.TunnelConfiguration.DigestAlgorithms)
+		profileMap["tunnel_encryption_algorithms"] = convertToTypeSet(profile.TunnelConfiguration.EncryptionAlgorithms)
+		if profile.TunnelConfiguration.SaLifeTime != nil {
+			profileMap["tunnel_sa_lifetime"] = *profile.TunnelConfiguration.SaLifeTime
+		}
+	}
+
+	if profile.DpdConfiguration != nil {
+		profileMap["dpd_probe_internal"] = profile.DpdConfiguration.ProbeInterval
+	}
+
+	return d.Set("security_profile_customization", []interface{}{profileMap})
+}
@@ -0,0 +1,194 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtIpSecVpnTunnel(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"TunnelName":      t.Name(),
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtIpSecVpnTunnelStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtIpSecVpnTunnelStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_ipsec_vpn_tunnel.tunnel1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtIpSecVpnTunnelDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "test-tunnel-description"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "pre_shared_key", "test-psk"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_ip_address", "data.vcd_nsxt_edgegateway.existing", "primary_ip"),
+					resource.TestCheckResourceAttr(resourceName, "local_networks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "local_networks.*", "10.10.10.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "remote_ip_address", "1.2.3.4"),
+					resource.TestCheckResourceAttr(resourceName, "remote_networks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_networks.*", "192.168.1.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_networks.*", "192.168.10.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "status", regexp.MustCompile(`^\S+`)),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "true"),
+					resource.TestCheckResourceAttr(resourceName, "remote_networks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.0.ike_version", "IKE_V2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "security_profile_customization.0.ike_encryption_algorithms.*", "AES_128"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "security_profile_customization.0.ike_dh_groups.*", "GROUP14"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.0.ike_sa_lifetime", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.0.tunnel_pfs_enabled", "true"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "security_profile_customization.0.tunnel_encryption_algorithms.*", "AES_256"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.0.tunnel_sa_lifetime", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.0.dpd_probe_internal", "30"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+				// Status may change between subsequent reads
+				ImportStateVerifyIgnore: []string{"status", "ike_service_status", "ike_fail_reason"},
+			},
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "security_profile", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_customization.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtIpSecVpnTunnelDestroy checks that no IPsec VPN tunnels with given name are left on NSX-T edge gateway
+func testAccCheckNsxtIpSecVpnTunnelDestroy(vdcName, edgeGatewayName, tunnelName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		_, err = getNsxtIpSecVpnTunnelByName(conn, edgeGateway.ID, tunnelName)
+		if err == nil {
+			return fmt.Errorf("IPsec VPN tunnel '%s' still exists", tunnelName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking IPsec VPN tunnel '%s': %s", tunnelName, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtIpSecVpnTunnelDataSource = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+`
+
+const testAccNsxtIpSecVpnTunnelStep1 = testAccNsxtIpSecVpnTunnelDataSource + `
+resource "vcd_nsxt_ipsec_vpn_tunnel" "tunnel1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name        = "{{.TunnelName}}"
+  description = "test-tunnel-description"
+
+  pre_shared_key = "test-psk"
+
+  local_ip_address = data.vcd_nsxt_edgegateway.existing.primary_ip
+  local_networks   = ["10.10.10.0/24"]
+
+  remote_ip_address = "1.2.3.4"
+  remote_networks   = ["192.168.1.0/24", "192.168.10.0/24"]
+}
+`
+
+const testAccNsxtIpSecVpnTunnelStep2 = testAccNsxtIpSecVpnTunnelDataSource + `
+resource "vcd_nsxt_ipsec_vpn_tunnel" "tunnel1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name    = "{{.TunnelName}}"
+  enabled = false
+  logging = true
+
+  pre_shared_key = "test-psk"
+
+  local_ip_address = data.vcd_nsxt_edgegateway.existing.primary_ip
+  local_networks   = ["10.10.10.0/24"]
+
+  remote_ip_address = "1.2.3.4"
+
+  security_profile_customization {
+    ike_version               = "IKE_V2"
+    ike_encryption_algorithms = ["AES_128"]
+    ike_digest_algorithms     = ["SHA2_256"]
+    ike_dh_groups             = ["GROUP14"]
+    ike_sa_lifetime           = 86400
+
+    tunnel_pfs_enabled           = true
+    tunnel_encryption_algorithms = ["AES_256"]
+    tunnel_digest_algorithms     = ["SHA2_256"]
+    tunnel_dh_groups             = ["GROUP14"]
+    tunnel_sa_lifetime           = 3600
+
+    dpd_probe_internal = 30
+  }
+}
+`
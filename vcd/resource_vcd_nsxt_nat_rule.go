@@ -0,0 +1,324 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtNatRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtNatRuleCreate,
+		Read:   resourceVcdNsxtNatRuleRead,
+		Update: resourceVcdNsxtNatRuleUpdate,
+		Delete: resourceVcdNsxtNatRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtNatRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which NAT Rule is located",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of NAT rule",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of NAT rule",
+			},
+			"rule_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Rule type - one of 'DNAT', 'NO_DNAT', 'SNAT', 'NO_SNAT', 'REFLEXIVE'. " +
+					"'REFLEXIVE' requires VCD 10.3+",
+				ValidateFunc: validation.StringInSlice([]string{"DNAT", "NO_DNAT", "SNAT", "NO_SNAT", "REFLEXIVE"}, false),
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables NAT rule. Default 'true'",
+			},
+			"external_address": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IP address or CIDR of external network",
+			},
+			"internal_address": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IP address or CIDR of the virtual machines for which you are configuring NAT",
+			},
+			"app_port_profile_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application Port Profile ID to which the rule applies",
+			},
+			"internal_port": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "For DNAT only. Port (or range) to which inbound packets are translated",
+			},
+			"dnat_external_port": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "For DNAT only. External port (or range) of inbound packets",
+			},
+			"snat_destination_address": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "For SNAT only. Limits the rule to traffic with specified destination IP address or CIDR",
+			},
+			"logging": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable logging when this rule is applied. Default 'false'",
+			},
+			"firewall_match": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Firewall match option - one of 'MATCH_INTERNAL_ADDRESS', 'MATCH_EXTERNAL_ADDRESS', " +
+					"'BYPASS'. Requires VCD 10.2.2+",
+				ValidateFunc: validation.StringInSlice([]string{"MATCH_INTERNAL_ADDRESS", "MATCH_EXTERNAL_ADDRESS", "BYPASS"}, false),
+			},
+			"priority": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "If an address has multiple NAT rules, the rule with the highest priority (lowest value) " +
+					"is applied. Requires VCD 10.2.2+",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtNatRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T NAT rule creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	natRule, err := getNsxtNatRuleType(d, vcdClient)
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule create] error building NAT rule structure: %s", err)
+	}
+
+	createdNatRule, err := createNsxtNatRule(vcdClient, d.Get("edge_gateway_id").(string), natRule)
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule create] error creating NAT rule: %s", err)
+	}
+
+	d.SetId(createdNatRule.ID)
+
+	return resourceVcdNsxtNatRuleRead(d, meta)
+}
+
+func resourceVcdNsxtNatRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T NAT rule update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	natRule, err := getNsxtNatRuleType(d, vcdClient)
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule update] error building NAT rule structure: %s", err)
+	}
+	natRule.ID = d.Id()
+
+	_, err = updateNsxtNatRule(vcdClient, d.Get("edge_gateway_id").(string), natRule)
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule update] error updating NAT rule: %s", err)
+	}
+
+	return resourceVcdNsxtNatRuleRead(d, meta)
+}
+
+func resourceVcdNsxtNatRuleRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T NAT rule read initiated")
+
+	natRule, err := getNsxtNatRuleById(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	// If the NAT rule is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T NAT rule with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule read] error retrieving NAT rule: %s", err)
+	}
+
+	setNsxtNatRuleData(natRule, d)
+
+	return nil
+}
+
+func resourceVcdNsxtNatRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T NAT rule deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtNatRule(vcdClient, d.Get("edge_gateway_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt nat rule delete] error deleting NAT rule: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtNatRuleImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_nat_rule.my-rule
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.rule-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtNatRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt nat rule import] resource name must be specified as org-name.vdc-name.edge-gw-name.rule-name")
+	}
+	orgName, vdcName, edgeName, ruleName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt nat rule import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt nat rule import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	natRule, err := getNsxtNatRuleByName(vcdClient, edgeGateway.ID, ruleName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt nat rule import] unable to find NAT rule '%s': %s", ruleName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(natRule.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtNatRuleType converts Terraform schema into NSX-T NAT rule structure. Fields which are not supported by VCD
+// version in use are rejected instead of being silently ignored by the API.
+func getNsxtNatRuleType(d *schema.ResourceData, vcdClient *VCDClient) (*nsxtNatRule, error) {
+	natRule := &nsxtNatRule{
+		Name:                     d.Get("name").(string),
+		Description:              d.Get("description").(string),
+		Enabled:                  d.Get("enabled").(bool),
+		ExternalAddresses:        d.Get("external_address").(string),
+		InternalAddresses:        d.Get("internal_address").(string),
+		InternalPort:             d.Get("internal_port").(string),
+		DnatExternalPort:         d.Get("dnat_external_port").(string),
+		SnatDestinationAddresses: d.Get("snat_destination_address").(string),
+		Logging:                  d.Get("logging").(bool),
+	}
+
+	if appPortProfileId := d.Get("app_port_profile_id").(string); appPortProfileId != "" {
+		natRule.ApplicationPortProfile = &openApiReference{ID: appPortProfileId}
+	}
+
+	// API 36.0 replaces 'ruleType' with 'type' field which also supports REFLEXIVE rules
+	ruleType := d.Get("rule_type").(string)
+	if vcdClient.Client.APIVCDMaxVersionIs(">= 36.0") {
+		natRule.Type = ruleType
+	} else {
+		if ruleType == "REFLEXIVE" {
+			return nil, fmt.Errorf("rule_type 'REFLEXIVE' requires VCD 10.3+")
+		}
+		natRule.RuleType = ruleType
+	}
+
+	firewallMatch := d.Get("firewall_match").(string)
+	// 'priority' is Optional+Computed, therefore GetOkExists also returns the value stored in state during update. It
+	// is only considered set by user when it is in configuration of a new rule (GetOkExists does not drop an explicit
+	// 'priority = 0') or when it changes.
+	priority, priorityExists := d.GetOkExists("priority")
+	prioritySet := (d.IsNewResource() && priorityExists) || d.HasChange("priority")
+	if vcdClient.Client.APIVCDMaxVersionIs("< 35.2") {
+		if firewallMatch != "" || prioritySet {
+			return nil, fmt.Errorf("'firewall_match' and 'priority' fields require VCD 10.2.2+")
+		}
+		return natRule, nil
+	}
+
+	natRule.FirewallMatch = firewallMatch
+	if prioritySet {
+		natRule.Priority = takeIntPointer(priority.(int))
+	}
+
+	return natRule, nil
+}
+
+// setNsxtNatRuleData stores NSX-T NAT rule structure in Terraform schema
+func setNsxtNatRuleData(natRule *nsxtNatRule, d *schema.ResourceData) {
+	_ = d.Set("name", natRule.Name)
+	_ = d.Set("description", natRule.Description)
+	_ = d.Set("enabled", natRule.Enabled)
+	_ = d.Set("external_address", natRule.ExternalAddresses)
+	_ = d.Set("internal_address", natRule.InternalAddresses)
+	_ = d.Set("internal_port", natRule.InternalPort)
+	_ = d.Set("dnat_external_port", natRule.DnatExternalPort)
+	_ = d.Set("snat_destination_address", natRule.SnatDestinationAddresses)
+	_ = d.Set("logging", natRule.Logging)
+	_ = d.Set("firewall_match", natRule.FirewallMatch)
+
+	// API 36.0+ returns rule type in 'type' field
+	if natRule.Type != "" {
+		_ = d.Set("rule_type", natRule.Type)
+	} else {
+		_ = d.Set("rule_type", natRule.RuleType)
+	}
+
+	appPortProfileId := ""
+	if natRule.ApplicationPortProfile != nil {
+		appPortProfileId = natRule.ApplicationPortProfile.ID
+	}
+	_ = d.Set("app_port_profile_id", appPortProfileId)
+
+	if natRule.Priority != nil {
+		_ = d.Set("priority", *natRule.Priority)
+	}
+}
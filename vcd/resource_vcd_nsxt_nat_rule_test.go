@@ -0,0 +1,226 @@
+// +build gateway nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtNatRuleDnat(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"RuleName":        t.Name(),
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtNatRuleDnatStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtNatRuleDnatStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_nat_rule.dnat"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtNatRuleDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "DNAT"),
+					resource.TestCheckResourceAttrPair(resourceName, "external_address", "data.vcd_nsxt_edgegateway.existing", "primary_ip"),
+					resource.TestCheckResourceAttr(resourceName, "internal_address", "11.11.11.2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "DNAT"),
+					resource.TestCheckResourceAttr(resourceName, "internal_address", "11.11.11.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "dnat_external_port", "8888"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+			},
+		},
+	})
+}
+
+func TestAccVcdNsxtNatRuleSnat(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"RuleName":        t.Name(),
+		"Tags":            "gateway nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtNatRuleSnat, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_nat_rule.snat"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtNatRuleDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\S+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SNAT"),
+					resource.TestCheckResourceAttr(resourceName, "internal_address", "11.11.11.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "snat_destination_address", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SNAT"),
+					resource.TestCheckResourceAttr("vcd_nsxt_nat_rule.no-snat", "rule_type", "NO_SNAT"),
+					resource.TestCheckResourceAttr("vcd_nsxt_nat_rule.no-snat", "internal_address", "11.11.11.0/24"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtNatRuleDestroy checks that no NAT rules with given name are left on NSX-T edge gateway
+func testAccCheckNsxtNatRuleDestroy(vdcName, edgeGatewayName, ruleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		_, err = getNsxtNatRuleByName(conn, edgeGateway.ID, ruleName)
+		if err == nil {
+			return fmt.Errorf("NAT rule '%s' still exists", ruleName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking NAT rule '%s': %s", ruleName, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtNatRuleDataSource = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+`
+
+const testAccNsxtNatRuleDnatStep1 = testAccNsxtNatRuleDataSource + `
+resource "vcd_nsxt_nat_rule" "dnat" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name        = "{{.RuleName}}"
+  rule_type   = "DNAT"
+  description = "description"
+
+  external_address = data.vcd_nsxt_edgegateway.existing.primary_ip
+  internal_address = "11.11.11.2"
+}
+`
+
+const testAccNsxtNatRuleDnatStep2 = testAccNsxtNatRuleDataSource + `
+resource "vcd_nsxt_nat_rule" "dnat" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name        = "{{.RuleName}}"
+  rule_type   = "DNAT"
+  description = "updated description"
+
+  external_address   = data.vcd_nsxt_edgegateway.existing.primary_ip
+  internal_address   = "11.11.11.0/24"
+  dnat_external_port = "8888"
+  logging            = true
+  enabled            = false
+}
+`
+
+const testAccNsxtNatRuleSnat = testAccNsxtNatRuleDataSource + `
+resource "vcd_nsxt_nat_rule" "snat" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name      = "{{.RuleName}}"
+  rule_type = "SNAT"
+
+  external_address         = data.vcd_nsxt_edgegateway.existing.primary_ip
+  internal_address         = "11.11.11.0/24"
+  snat_destination_address = "8.8.8.8"
+}
+
+resource "vcd_nsxt_nat_rule" "no-snat" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name      = "{{.RuleName}}-no-snat"
+  rule_type = "NO_SNAT"
+
+  internal_address = "11.11.11.0/24"
+}
+`
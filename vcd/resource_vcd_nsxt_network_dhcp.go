@@ -0,0 +1,236 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdOpenApiDhcp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdOpenApiDhcpUpdate,
+		Read:   resourceVcdOpenApiDhcpRead,
+		Update: resourceVcdOpenApiDhcpUpdate,
+		Delete: resourceVcdOpenApiDhcpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdOpenApiDhcpImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"org_network_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Parent Org VDC network ID",
+			},
+			"pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "IP ranges used for DHCP pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+			"lease_time": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Lease time in seconds. Minimum value is 60 seconds",
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+			"dns_servers": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    2,
+				Description: "The DNS server IPs to be assigned by this DHCP service. Maximum two DNS servers are allowed. Requires VCD 10.3.1+",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+	}
+}
+
+// resourceVcdOpenApiDhcpUpdate is used for both - create and update as DHCP configuration always exists for Org VDC
+// network and can only be updated
+func resourceVcdOpenApiDhcpUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T Org VDC network DHCP update initiated")
+
+	orgNetworkId := d.Get("org_network_id").(string)
+
+	dhcp, err := getOpenApiOrgVdcNetworkDhcpType(vcdClient, d)
+	if err != nil {
+		return fmt.Errorf("[nsxt network dhcp update] %s", err)
+	}
+
+	_, err = updateOpenApiOrgVdcNetworkDhcp(vcdClient, orgNetworkId, dhcp)
+	if err != nil {
+		return fmt.Errorf("[nsxt network dhcp update] error updating DHCP configuration for Org VDC network '%s': %s",
+			orgNetworkId, err)
+	}
+
+	d.SetId(orgNetworkId)
+
+	return resourceVcdOpenApiDhcpRead(d, meta)
+}
+
+func resourceVcdOpenApiDhcpRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T Org VDC network DHCP read initiated")
+
+	dhcp, err := getOpenApiOrgVdcNetworkDhcp(vcdClient, d.Id())
+	// If the network is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] Org VDC network with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt network dhcp read] error retrieving DHCP configuration: %s", err)
+	}
+
+	// DHCP configuration without pools means that it was removed
+	if len(dhcp.DhcpPools) == 0 {
+		log.Printf("[DEBUG] DHCP configuration for Org VDC network %s has no pools. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = setOpenApiOrgVdcNetworkDhcpData(d, dhcp)
+	if err != nil {
+		return fmt.Errorf("[nsxt network dhcp read] error storing DHCP configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdOpenApiDhcpDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T Org VDC network DHCP delete initiated")
+
+	err := deleteOpenApiOrgVdcNetworkDhcp(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt network dhcp delete] error removing DHCP configuration: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdOpenApiDhcpImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_network_dhcp.my-dhcp
+// Example import path (_the_id_string_): org.vdc.org-network-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdOpenApiDhcpImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[nsxt network dhcp import] resource name must be specified as org-name.vdc-name.org-network-name")
+	}
+	orgName, vdcName, networkName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt network dhcp import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkByName(vcdClient, vdc, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt network dhcp import] error reading network with name '%s': %s", networkName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("org_network_id", orgNetwork.ID)
+	d.SetId(orgNetwork.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getOpenApiOrgVdcNetworkDhcpType converts Terraform schema into DHCP configuration structure
+func getOpenApiOrgVdcNetworkDhcpType(vcdClient *VCDClient, d *schema.ResourceData) (*openApiOrgVdcNetworkDhcp, error) {
+	dhcp := &openApiOrgVdcNetworkDhcp{
+		Enabled: takeBoolPointer(true),
+	}
+
+	poolSet := d.Get("pool").(*schema.Set)
+	for _, pool := range poolSet.List() {
+		poolMap := pool.(map[string]interface{})
+		dhcp.DhcpPools = append(dhcp.DhcpPools, openApiOrgVdcNetworkDhcpPool{
+			Enabled: takeBoolPointer(true),
+			IPRange: openApiIpRange{
+				StartAddress: poolMap["start_address"].(string),
+				EndAddress:   poolMap["end_address"].(string),
+			},
+		})
+	}
+
+	if leaseTime, isSet := d.GetOk("lease_time"); isSet {
+		dhcp.LeaseTime = takeIntPointer(leaseTime.(int))
+	}
+
+	dnsServers := convertTypeListToSliceOfStrings(d.Get("dns_servers").([]interface{}))
+	if len(dnsServers) > 0 {
+		if vcdClient.Client.APIVCDMaxVersionIs("< 36.1") {
+			return nil, fmt.Errorf("'dns_servers' requires VCD 10.3.1+")
+		}
+		dhcp.DnsServers = dnsServers
+	}
+
+	return dhcp, nil
+}
+
+// setOpenApiOrgVdcNetworkDhcpData stores DHCP configuration structure in Terraform schema
+func setOpenApiOrgVdcNetworkDhcpData(d *schema.ResourceData, dhcp *openApiOrgVdcNetworkDhcp) error {
+	_ = d.Set("org_network_id", d.Id())
+
+	if dhcp.LeaseTime != nil {
+		_ = d.Set("lease_time", *dhcp.LeaseTime)
+	}
+
+	pools := make([]interface{}, len(dhcp.DhcpPools))
+	for index, pool := range dhcp.DhcpPools {
+		pools[index] = map[string]interface{}{
+			"start_address": pool.IPRange.StartAddress,
+			"end_address":   pool.IPRange.EndAddress,
+		}
+	}
+
+	poolSet := schema.NewSet(schema.HashResource(networkV2IpRange), pools)
+	err := d.Set("pool", poolSet)
+	if err != nil {
+		return fmt.Errorf("error setting 'pool': %s", err)
+	}
+
+	err = d.Set("dns_servers", dhcp.DnsServers)
+	if err != nil {
+		return fmt.Errorf("error setting 'dns_servers': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVcdOpenApiDhcpNsxtRouted(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":             testConfig.VCD.Org,
+		"NsxtVdc":         testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway": testConfig.Nsxt.EdgeGateway,
+		"NetworkName":     t.Name(),
+		"Tags":            "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccRoutedNetDhcpStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccRoutedNetDhcpStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_network_dhcp.pools"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOpenApiVcdNetworkDestroy(testConfig.Nsxt.Vdc, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "vcd_network_routed_v2.net1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_network_id", "vcd_network_routed_v2.net1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "pool.*", map[string]string{
+						"start_address": "7.1.1.100",
+						"end_address":   "7.1.1.110",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "lease_time"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "vcd_network_routed_v2.net1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "pool.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "pool.*", map[string]string{
+						"start_address": "7.1.1.100",
+						"end_address":   "7.1.1.110",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "pool.*", map[string]string{
+						"start_address": "7.1.1.111",
+						"end_address":   "7.1.1.112",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "lease_time", "3600"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()),
+			},
+		},
+	})
+}
+
+const testAccRoutedNetDhcpConfig = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+
+resource "vcd_network_routed_v2" "net1" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NetworkName}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  gateway       = "7.1.1.1"
+  prefix_length = 24
+
+  static_ip_pool {
+    start_address = "7.1.1.10"
+    end_address   = "7.1.1.20"
+  }
+}
+`
+
+const testAccRoutedNetDhcpStep1 = testAccRoutedNetDhcpConfig + `
+resource "vcd_nsxt_network_dhcp" "pools" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  org_network_id = vcd_network_routed_v2.net1.id
+
+  pool {
+    start_address = "7.1.1.100"
+    end_address   = "7.1.1.110"
+  }
+}
+`
+
+const testAccRoutedNetDhcpStep2 = testAccRoutedNetDhcpConfig + `
+resource "vcd_nsxt_network_dhcp" "pools" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  org_network_id = vcd_network_routed_v2.net1.id
+  lease_time     = 3600
+
+  pool {
+    start_address = "7.1.1.100"
+    end_address   = "7.1.1.110"
+  }
+
+  pool {
+    start_address = "7.1.1.111"
+    end_address   = "7.1.1.112"
+  }
+}
+`
@@ -0,0 +1,264 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// nsxtImportedSwitchBackingType is the backing type of an Org VDC network which consumes an existing NSX-T logical
+// switch (segment)
+const nsxtImportedSwitchBackingType = "IMPORTED_T_LOGICAL_SWITCH"
+
+func resourceVcdNsxtNetworkImported() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtNetworkImportedCreate,
+		Read:   resourceVcdNsxtNetworkImportedRead,
+		Update: resourceVcdNsxtNetworkImportedUpdate,
+		Delete: resourceVcdNsxtNetworkImportedDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtNetworkImportedImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Network name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Network description",
+			},
+			"nsxt_logical_switch_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of existing NSX-T logical switch (segment) which is not yet consumed by VCD",
+			},
+			"nsxt_logical_switch_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of used NSX-T logical switch (segment)",
+			},
+			"gateway": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Gateway IP address",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"prefix_length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Network prefix length (e.g. 24 for 255.255.255.0)",
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"dns1": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 1",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns2": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "DNS server 2",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"dns_suffix": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS suffix",
+			},
+			"static_ip_pool": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IP ranges used for static pool allocation in the network",
+				Elem:        networkV2IpRange,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtNetworkImportedCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] imported network creation initiated")
+
+	if !vcdClient.Client.IsSysAdmin {
+		return fmt.Errorf("this resource requires System user")
+	}
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	logicalSwitchName := d.Get("nsxt_logical_switch_name").(string)
+	logicalSwitch, err := getNsxtImportableSwitchByName(vcdClient, vdc, logicalSwitchName)
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network create] unable to find NSX-T logical switch '%s': %s", logicalSwitchName, err)
+	}
+
+	networkType := getOpenApiOrgVdcImportedNetworkType(d, vdc)
+	networkType.BackingNetworkId = logicalSwitch.ID
+	networkType.BackingNetworkType = nsxtImportedSwitchBackingType
+
+	orgNetwork, err := createOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network create] error creating Org VDC imported network: %s", err)
+	}
+
+	d.SetId(orgNetwork.ID)
+
+	return resourceVcdNsxtNetworkImportedRead(d, meta)
+}
+
+func resourceVcdNsxtNetworkImportedUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] imported network update initiated")
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	// Backing details cannot be changed, but must be sent as they are in update request
+	orgNetwork, err := getOpenApiOrgVdcNetworkById(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network update] error getting Org VDC network: %s", err)
+	}
+
+	networkType := getOpenApiOrgVdcImportedNetworkType(d, vdc)
+	networkType.ID = d.Id()
+	networkType.BackingNetworkId = orgNetwork.BackingNetworkId
+	networkType.BackingNetworkType = orgNetwork.BackingNetworkType
+
+	_, err = updateOpenApiOrgVdcNetwork(vcdClient, networkType)
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network update] error updating Org VDC network: %s", err)
+	}
+
+	return resourceVcdNsxtNetworkImportedRead(d, meta)
+}
+
+func resourceVcdNsxtNetworkImportedRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] imported network read initiated")
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkById(vcdClient, d.Id())
+	// If the network is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] Org VDC network with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network read] error getting Org VDC network: %s", err)
+	}
+
+	err = setOpenApiOrgVdcImportedNetworkData(d, orgNetwork)
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network read] error setting Org VDC network data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtNetworkImportedDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] imported network deletion initiated")
+
+	err := deleteOpenApiOrgVdcNetwork(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt imported network delete] error deleting Org VDC network: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtNetworkImportedImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_network_imported.my-network
+// Example import path (_the_id_string_): org.vdc.my-network
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+//
+// Note: NSX-T logical switch name is not known to VCD once it is consumed and 'nsxt_logical_switch_name' is not
+// populated after import.
+func resourceVcdNsxtNetworkImportedImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[nsxt imported network import] resource name must be specified as org-name.vdc-name.network-name")
+	}
+	orgName, vdcName, networkName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt imported network import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	orgNetwork, err := getOpenApiOrgVdcNetworkByName(vcdClient, vdc, networkName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt imported network import] error reading network with name '%s': %s", networkName, err)
+	}
+
+	if orgNetwork.NetworkType != openApiOrgVdcNetworkTypeImported {
+		return nil, fmt.Errorf("[nsxt imported network import] Org VDC network with name '%s' found, but is not of type Imported (OPAQUE) (type is '%s')",
+			networkName, orgNetwork.NetworkType)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(orgNetwork.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setOpenApiOrgVdcImportedNetworkData stores imported Org VDC network structure in Terraform schema
+func setOpenApiOrgVdcImportedNetworkData(d *schema.ResourceData, orgVdcNetwork *openApiOrgVdcNetwork) error {
+	_ = d.Set("name", orgVdcNetwork.Name)
+	_ = d.Set("description", orgVdcNetwork.Description)
+	_ = d.Set("nsxt_logical_switch_id", orgVdcNetwork.BackingNetworkId)
+
+	return setOpenApiOrgVdcNetworkSubnetData(d, orgVdcNetwork)
+}
+
+// getOpenApiOrgVdcImportedNetworkType converts Terraform schema into imported Org VDC network structure. Backing
+// network details must be filled in by caller.
+func getOpenApiOrgVdcImportedNetworkType(d *schema.ResourceData, vdc *govcd.Vdc) *openApiOrgVdcNetwork {
+	return &openApiOrgVdcNetwork{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		OrgVdc:      &openApiReference{ID: vdc.Vdc.ID},
+		NetworkType: openApiOrgVdcNetworkTypeImported,
+		Subnets:     getOpenApiOrgVdcNetworkSubnetsType(d),
+	}
+}
@@ -0,0 +1,170 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVcdNsxtNetworkImported(t *testing.T) {
+	if !usingSysAdmin() {
+		t.Skip(t.Name() + " requires system admin privileges")
+		return
+	}
+
+	skipNoNsxtVdcConfiguration(t)
+	if testConfig.Nsxt.NsxtImportSegment == "" {
+		t.Skip("Missing NSX-T config: No NSX-T segment for import specified")
+	}
+
+	var params = StringMap{
+		"Org":               testConfig.VCD.Org,
+		"NsxtVdc":           testConfig.Nsxt.Vdc,
+		"NetworkName":       t.Name(),
+		"NsxtImportSegment": testConfig.Nsxt.NsxtImportSegment,
+		"Tags":              "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxtNetworkImportedStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["NetworkName"] = t.Name() + "-updated"
+	configText2 := templateFill(testAccVcdNsxtNetworkImportedStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcdNsxtNetworkImportedStep3, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_network_imported.net1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOpenApiVcdNetworkDestroy(testConfig.Nsxt.Vdc, t.Name()+"-updated"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "NSX-T imported network test"),
+					resource.TestCheckResourceAttrSet(resourceName, "nsxt_logical_switch_id"),
+					resource.TestCheckResourceAttr(resourceName, "gateway", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "24"),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.10",
+						"end_address":   "1.1.1.20",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:network:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated NSX-T imported network test"),
+					resource.TestCheckResourceAttrSet(resourceName, "nsxt_logical_switch_id"),
+					resource.TestCheckResourceAttr(resourceName, "dns1", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "dns2", "8.8.4.4"),
+					resource.TestCheckResourceAttr(resourceName, "dns_suffix", "example.org"),
+					resource.TestCheckResourceAttr(resourceName, "static_ip_pool.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.10",
+						"end_address":   "1.1.1.30",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "static_ip_pool.*", map[string]string{
+						"start_address": "1.1.1.100",
+						"end_address":   "1.1.1.103",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgNsxtVdcObject(testConfig, t.Name()+"-updated"),
+				// NSX-T logical switch name cannot be read once it is consumed by VCD
+				ImportStateVerifyIgnore: []string{"nsxt_logical_switch_name"},
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// 'nsxt_logical_switch_name' is empty when VCD does not list the consumed logical switch
+					resourceFieldsEqual(resourceName, "data.vcd_nsxt_network_imported.ds", []string{"%", "nsxt_logical_switch_name"}),
+					resourceFieldsEqual(resourceName, "data.vcd_nsxt_network_imported.ds-by-segment", []string{"%"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcdNsxtNetworkImportedStep1 = `
+resource "vcd_nsxt_network_imported" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "NSX-T imported network test"
+
+  nsxt_logical_switch_name = "{{.NsxtImportSegment}}"
+
+  gateway       = "1.1.1.1"
+  prefix_length = 24
+
+  static_ip_pool {
+    start_address = "1.1.1.10"
+    end_address   = "1.1.1.20"
+  }
+}
+`
+
+const testAccVcdNsxtNetworkImportedStep2 = `
+resource "vcd_nsxt_network_imported" "net1" {
+  org         = "{{.Org}}"
+  vdc         = "{{.NsxtVdc}}"
+  name        = "{{.NetworkName}}"
+  description = "updated NSX-T imported network test"
+
+  nsxt_logical_switch_name = "{{.NsxtImportSegment}}"
+
+  gateway       = "1.1.1.1"
+  prefix_length = 24
+  dns1          = "8.8.8.8"
+  dns2          = "8.8.4.4"
+  dns_suffix    = "example.org"
+
+  static_ip_pool {
+    start_address = "1.1.1.10"
+    end_address   = "1.1.1.30"
+  }
+
+  static_ip_pool {
+    start_address = "1.1.1.100"
+    end_address   = "1.1.1.103"
+  }
+}
+`
+
+const testAccVcdNsxtNetworkImportedStep3 = testAccVcdNsxtNetworkImportedStep2 + `
+data "vcd_nsxt_network_imported" "ds" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = vcd_nsxt_network_imported.net1.name
+}
+
+data "vcd_nsxt_network_imported" "ds-by-segment" {
+  org                      = "{{.Org}}"
+  vdc                      = "{{.NsxtVdc}}"
+  nsxt_logical_switch_name = vcd_nsxt_network_imported.net1.nsxt_logical_switch_name
+}
+`
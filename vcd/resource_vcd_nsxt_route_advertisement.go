@@ -0,0 +1,164 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxtRouteAdvertisement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtRouteAdvertisementCreateUpdate,
+		Read:   resourceVcdNsxtRouteAdvertisementRead,
+		Update: resourceVcdNsxtRouteAdvertisementCreateUpdate,
+		Delete: resourceVcdNsxtRouteAdvertisementDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtRouteAdvertisementImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID for route advertisement",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Defines if route advertisement is enabled. Default 'true'",
+			},
+			"subnets": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of subnets in CIDR format which are advertised to the Tier-0 gateway",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtRouteAdvertisementCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T route advertisement create/update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	edgeGatewayId := d.Get("edge_gateway_id").(string)
+	routeAdvertisement := &nsxtRouteAdvertisement{
+		Enable:  d.Get("enabled").(bool),
+		Subnets: convertSchemaSetToSliceOfStrings(d.Get("subnets").(*schema.Set)),
+	}
+
+	_, err := updateNsxtRouteAdvertisement(vcdClient, edgeGatewayId, routeAdvertisement)
+	if err != nil {
+		return fmt.Errorf("[nsxt route advertisement create/update] error updating route advertisement: %s", err)
+	}
+
+	// Route advertisement is a single configuration object of edge gateway therefore edge gateway ID is used as ID
+	d.SetId(edgeGatewayId)
+
+	return resourceVcdNsxtRouteAdvertisementRead(d, meta)
+}
+
+func resourceVcdNsxtRouteAdvertisementRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T route advertisement read initiated")
+
+	routeAdvertisement, err := getNsxtRouteAdvertisement(vcdClient, d.Id())
+	// If the edge gateway is not found - remove route advertisement from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T edge gateway with ID %s no longer exists. Removing route advertisement from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt route advertisement read] error retrieving route advertisement: %s", err)
+	}
+
+	_ = d.Set("edge_gateway_id", d.Id())
+	_ = d.Set("enabled", routeAdvertisement.Enable)
+	err = d.Set("subnets", convertToTypeSet(routeAdvertisement.Subnets))
+	if err != nil {
+		return fmt.Errorf("[nsxt route advertisement read] error setting subnets: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtRouteAdvertisementDelete disables route advertisement and removes all advertised subnets
+func resourceVcdNsxtRouteAdvertisementDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T route advertisement deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	_, err := updateNsxtRouteAdvertisement(vcdClient, d.Id(), &nsxtRouteAdvertisement{Enable: false})
+	if err != nil {
+		return fmt.Errorf("[nsxt route advertisement delete] error removing route advertisement: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtRouteAdvertisementImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_route_advertisement.my-advertisement
+// Example import path (_the_id_string_): org.vdc.edge-gw-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtRouteAdvertisementImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("[nsxt route advertisement import] resource name must be specified as org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt route advertisement import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt route advertisement import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(edgeGateway.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,266 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxtSecurityGroupMemberVmSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"vm_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "VM ID",
+		},
+		"vm_name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "VM Name",
+		},
+		"vapp_id": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "vApp ID",
+		},
+		"vapp_name": &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "vApp Name",
+		},
+	},
+}
+
+func resourceVcdNsxtSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxtSecurityGroupCreate,
+		Read:   resourceVcdNsxtSecurityGroupRead,
+		Update: resourceVcdNsxtSecurityGroupUpdate,
+		Delete: resourceVcdNsxtSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxtSecurityGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway ID in which security group is located",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Security group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Security group description",
+			},
+			"member_org_network_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Org VDC network IDs attached to this security group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"member_vms": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Set of VM references which are members of the security group through attached Org VDC networks",
+				Elem:        nsxtSecurityGroupMemberVmSchema,
+			},
+		},
+	}
+}
+
+func resourceVcdNsxtSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T security group creation initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	securityGroup, err := createNsxtFirewallGroup(vcdClient, getNsxtSecurityGroupType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxt security group create] error creating security group: %s", err)
+	}
+
+	d.SetId(securityGroup.ID)
+
+	return resourceVcdNsxtSecurityGroupRead(d, meta)
+}
+
+func resourceVcdNsxtSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T security group update initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	securityGroup := getNsxtSecurityGroupType(d)
+	securityGroup.ID = d.Id()
+
+	_, err := updateNsxtFirewallGroup(vcdClient, securityGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt security group update] error updating security group: %s", err)
+	}
+
+	return resourceVcdNsxtSecurityGroupRead(d, meta)
+}
+
+func resourceVcdNsxtSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T security group read initiated")
+
+	securityGroup, err := getNsxtFirewallGroupById(vcdClient, d.Id())
+	// If the security group is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] NSX-T security group with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxt security group read] error retrieving security group: %s", err)
+	}
+
+	err = setNsxtSecurityGroupData(vcdClient, d, securityGroup)
+	if err != nil {
+		return fmt.Errorf("[nsxt security group read] error storing security group data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxtSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-T security group deletion initiated")
+
+	vcdClient.lockParentNsxtEdgeGtw(d)
+	defer vcdClient.unLockParentNsxtEdgeGtw(d)
+
+	err := deleteNsxtFirewallGroup(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxt security group delete] error deleting security group: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxtSecurityGroupImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_nsxt_security_group.my-security-group
+// Example import path (_the_id_string_): org.vdc.edge-gw-name.security-group-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdNsxtSecurityGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("[nsxt security group import] resource name must be specified as org-name.vdc-name.edge-gw-name.security-group-name")
+	}
+	orgName, vdcName, edgeName, groupName := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt security group import] unable to find VDC %s: %s ", vdcName, err)
+	}
+
+	edgeGateway, err := getNsxtEdgeGatewayByName(vcdClient, vdc, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt security group import] unable to find NSX-T edge gateway '%s': %s", edgeName, err)
+	}
+
+	securityGroup, err := getNsxtFirewallGroupByName(vcdClient, edgeGateway.ID, groupName, nsxtFirewallGroupTypeSecurityGroup)
+	if err != nil {
+		return nil, fmt.Errorf("[nsxt security group import] unable to find security group '%s': %s", groupName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway_id", edgeGateway.ID)
+	d.SetId(securityGroup.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxtSecurityGroupType converts Terraform schema into security group structure
+func getNsxtSecurityGroupType(d *schema.ResourceData) *nsxtFirewallGroup {
+	return &nsxtFirewallGroup{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		EdgeGatewayRef: &openApiReference{ID: d.Get("edge_gateway_id").(string)},
+		Members:        convertSetToOpenApiReferences(d.Get("member_org_network_ids").(*schema.Set)),
+		Type:           nsxtFirewallGroupTypeSecurityGroup,
+	}
+}
+
+// setNsxtSecurityGroupData stores security group structure in Terraform schema. Member VMs are looked up separately
+// as they are not part of security group structure.
+func setNsxtSecurityGroupData(vcdClient *VCDClient, d *schema.ResourceData, securityGroup *nsxtFirewallGroup) error {
+	_ = d.Set("name", securityGroup.Name)
+	_ = d.Set("description", securityGroup.Description)
+	if securityGroup.EdgeGatewayRef != nil {
+		_ = d.Set("edge_gateway_id", securityGroup.EdgeGatewayRef.ID)
+	}
+
+	err := d.Set("member_org_network_ids", convertToTypeSet(extractIdsFromOpenApiReferences(securityGroup.Members)))
+	if err != nil {
+		return fmt.Errorf("error setting 'member_org_network_ids': %s", err)
+	}
+
+	memberVms, err := getNsxtFirewallGroupAssociatedVms(vcdClient, securityGroup.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving member VMs: %s", err)
+	}
+
+	memberVmSlice := make([]interface{}, 0, len(memberVms))
+	for _, memberVm := range memberVms {
+		if memberVm.VmRef == nil {
+			continue
+		}
+		memberVmMap := map[string]interface{}{
+			"vm_id":   memberVm.VmRef.ID,
+			"vm_name": memberVm.VmRef.Name,
+		}
+		// Standalone VMs do not have vApp reference
+		if memberVm.VappRef != nil {
+			memberVmMap["vapp_id"] = memberVm.VappRef.ID
+			memberVmMap["vapp_name"] = memberVm.VappRef.Name
+		}
+		memberVmSlice = append(memberVmSlice, memberVmMap)
+	}
+
+	memberVmSet := schema.NewSet(schema.HashResource(nsxtSecurityGroupMemberVmSchema), memberVmSlice)
+	err = d.Set("member_vms", memberVmSet)
+	if err != nil {
+		return fmt.Errorf("error setting 'member_vms': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func TestAccVcdNsxtSecurityGroup(t *testing.T) {
+	skipNoNsxtEdgeGatewayConfiguration(t)
+
+	var params = StringMap{
+		"Org":               testConfig.VCD.Org,
+		"NsxtVdc":           testConfig.Nsxt.Vdc,
+		"NsxtEdgeGateway":   testConfig.Nsxt.EdgeGateway,
+		"SecurityGroupName": t.Name(),
+		"NetworkName":       t.Name() + "-net",
+		"VAppName":          t.Name() + "-vapp",
+		"VmName":            t.Name() + "-vm",
+		"Tags":              "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccNsxtSecurityGroupStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccNsxtSecurityGroupStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccNsxtSecurityGroupStep3, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcd_nsxt_security_group.group1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNsxtSecurityGroupDestroy(testConfig.Nsxt.Vdc, testConfig.Nsxt.EdgeGateway, t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:firewallGroup:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", "test security group"),
+					resource.TestCheckResourceAttr(resourceName, "member_org_network_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_org_network_ids.*", "vcd_network_routed_v2.net1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "member_vms.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member_vms.*", map[string]string{
+						"vm_name":   t.Name() + "-vm",
+						"vapp_name": t.Name() + "-vapp",
+					}),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcd_nsxt_security_group.group1", []string{"org", "vdc"}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxtEdgeGatewayObject(testConfig, testConfig.Nsxt.EdgeGateway, t.Name()),
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:firewallGroup:`)),
+					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "member_org_network_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_vms.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNsxtSecurityGroupDestroy checks that no security groups with given name are left on NSX-T edge gateway
+func testAccCheckNsxtSecurityGroupDestroy(vdcName, edgeGatewayName, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, vdcName)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+		}
+
+		edgeGateway, err := getNsxtEdgeGatewayByName(conn, vdc, edgeGatewayName)
+		if err != nil {
+			return fmt.Errorf("unable to find NSX-T edge gateway '%s': %s", edgeGatewayName, err)
+		}
+
+		_, err = getNsxtFirewallGroupByName(conn, edgeGateway.ID, groupName, nsxtFirewallGroupTypeSecurityGroup)
+		if err == nil {
+			return fmt.Errorf("security group '%s' still exists", groupName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking security group '%s': %s", groupName, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccNsxtSecurityGroupPrereqs = `
+data "vcd_nsxt_edgegateway" "existing" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NsxtEdgeGateway}}"
+}
+
+resource "vcd_network_routed_v2" "net1" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.NetworkName}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  gateway       = "110.10.102.1"
+  prefix_length = 26
+
+  static_ip_pool {
+    start_address = "110.10.102.2"
+    end_address   = "110.10.102.20"
+  }
+}
+
+resource "vcd_vapp" "app1" {
+  org  = "{{.Org}}"
+  vdc  = "{{.NsxtVdc}}"
+  name = "{{.VAppName}}"
+}
+
+resource "vcd_vapp_org_network" "app1-net" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  vapp_name        = vcd_vapp.app1.name
+  org_network_name = vcd_network_routed_v2.net1.name
+}
+
+resource "vcd_vapp_vm" "vm1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  vapp_name = vcd_vapp.app1.name
+  name      = "{{.VmName}}"
+  memory    = 512
+  cpus      = 1
+  power_on  = false
+
+  os_type          = "sles11_64Guest"
+  hardware_version = "vmx-13"
+  computer_name    = "sg-member"
+
+  network {
+    type               = "org"
+    name               = vcd_vapp_org_network.app1-net.org_network_name
+    ip_allocation_mode = "POOL"
+  }
+}
+`
+
+const testAccNsxtSecurityGroupStep1 = testAccNsxtSecurityGroupPrereqs + `
+resource "vcd_nsxt_security_group" "group1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name        = "{{.SecurityGroupName}}"
+  description = "test security group"
+
+  member_org_network_ids = [vcd_network_routed_v2.net1.id]
+
+  # Member VMs are only known once the VM is attached to member network
+  depends_on = [vcd_vapp_vm.vm1]
+}
+`
+
+const testAccNsxtSecurityGroupStep2 = testAccNsxtSecurityGroupStep1 + `
+data "vcd_nsxt_security_group" "group1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+  name            = vcd_nsxt_security_group.group1.name
+}
+`
+
+const testAccNsxtSecurityGroupStep3 = testAccNsxtSecurityGroupPrereqs + `
+resource "vcd_nsxt_security_group" "group1" {
+  org = "{{.Org}}"
+  vdc = "{{.NsxtVdc}}"
+
+  edge_gateway_id = data.vcd_nsxt_edgegateway.existing.id
+
+  name = "{{.SecurityGroupName}}"
+}
+`
@@ -0,0 +1,323 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxvBgpNeighborResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"ip_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of BGP neighbor",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"remote_as": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Autonomous system number of BGP neighbor",
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      60,
+			Description:  "Weight of routes learned from the neighbor. Default '60'",
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+		"keep_alive_timer": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      60,
+			Description:  "Interval in seconds between keep alive messages. Default '60'",
+			ValidateFunc: validation.IntBetween(1, 65534),
+		},
+		"hold_down_timer": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      180,
+			Description:  "Interval in seconds after which neighbor is declared down. Default '180'",
+			ValidateFunc: validation.IntBetween(3, 65535),
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password for BGP neighbor authentication",
+		},
+	},
+}
+
+func resourceVcdNsxvBgp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvBgpCreate,
+		Read:   resourceVcdNsxvBgpRead,
+		Update: resourceVcdNsxvBgpUpdate,
+		Delete: resourceVcdNsxvBgpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvBgpImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for BGP configuration",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables BGP. Default 'true'",
+			},
+			"local_as": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local autonomous system number. Both 2 byte and 4 byte numbers are supported",
+			},
+			"graceful_restart": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables graceful restart. Default 'true'",
+			},
+			"default_originate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Advertises edge gateway as default gateway to BGP neighbors. Default 'false'",
+			},
+			"neighbor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "BGP neighbor definition",
+				Elem:        nsxvBgpNeighborResource,
+			},
+			"redistribution_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables route redistribution into BGP. Default 'false'",
+			},
+			"redistribution_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Route redistribution rule. Rules are evaluated in the defined order",
+				Elem:        nsxvRedistributionRuleResource,
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvBgpCreate replaces BGP configuration of edge gateway with the one defined in schema
+func resourceVcdNsxvBgpCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V BGP creation initiated")
+
+	err := resourceVcdNsxvBgpUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv bgp create] %s", err)
+	}
+
+	return resourceVcdNsxvBgpRead(d, meta)
+}
+
+// resourceVcdNsxvBgpUpdate is the same as create because complete configuration is always sent
+func resourceVcdNsxvBgpUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V BGP update initiated")
+
+	err := resourceVcdNsxvBgpUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv bgp update] %s", err)
+	}
+
+	return resourceVcdNsxvBgpRead(d, meta)
+}
+
+func resourceVcdNsxvBgpUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = updateNsxvBgp(vcdClient, edgeGateway, getNsxvBgpType(d))
+	if err != nil {
+		return fmt.Errorf("unable to update BGP configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvBgpId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvBgpRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V BGP read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing BGP from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	bgpConfig, err := getNsxvBgp(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv bgp read] could not read BGP configuration: %s", err)
+	}
+
+	err = setNsxvBgpData(d, bgpConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxv bgp read] %s", err)
+	}
+
+	d.SetId(getNsxvBgpId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvBgpDelete disables BGP and removes its configuration
+func resourceVcdNsxvBgpDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V BGP deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvBgp(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv bgp delete] could not reset BGP configuration: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvBgpImport imports BGP configuration. Because BGP is just a configuration of edge gateway and not a
+// separate object - the ID actually does not represent any object
+func resourceVcdNsxvBgpImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvBgpId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvBgpType converts resource schema to *nsxvBgp
+func getNsxvBgpType(d *schema.ResourceData) *nsxvBgp {
+	bgpConfig := &nsxvBgp{
+		Enabled:          d.Get("enabled").(bool),
+		LocalASNumber:    d.Get("local_as").(string),
+		GracefulRestart:  d.Get("graceful_restart").(bool),
+		DefaultOriginate: d.Get("default_originate").(bool),
+		BgpNeighbours:    &nsxvBgpNeighbours{},
+		Redistribution:   getNsxvRedistributionType(d),
+	}
+
+	for _, neighborItem := range d.Get("neighbor").([]interface{}) {
+		neighbor := neighborItem.(map[string]interface{})
+		bgpConfig.BgpNeighbours.Neighbours = append(bgpConfig.BgpNeighbours.Neighbours, &nsxvBgpNeighbour{
+			IpAddress:      neighbor["ip_address"].(string),
+			RemoteASNumber: neighbor["remote_as"].(string),
+			Weight:         neighbor["weight"].(int),
+			KeepAliveTimer: neighbor["keep_alive_timer"].(int),
+			HoldDownTimer:  neighbor["hold_down_timer"].(int),
+			Password:       neighbor["password"].(string),
+		})
+	}
+
+	return bgpConfig
+}
+
+// setNsxvBgpData sets BGP configuration into statefile. Neighbor passwords are not returned by the API therefore
+// they are retained from the state of the neighbor with the same IP address
+func setNsxvBgpData(d *schema.ResourceData, bgpConfig *nsxvBgp) error {
+	_ = d.Set("enabled", bgpConfig.Enabled)
+	_ = d.Set("graceful_restart", bgpConfig.GracefulRestart)
+	_ = d.Set("default_originate", bgpConfig.DefaultOriginate)
+
+	localAs := bgpConfig.LocalASNumber
+	if localAs == "" {
+		localAs = bgpConfig.LocalAS
+	}
+	_ = d.Set("local_as", localAs)
+
+	passwords := make(map[string]string)
+	for _, neighborItem := range d.Get("neighbor").([]interface{}) {
+		neighbor := neighborItem.(map[string]interface{})
+		passwords[neighbor["ip_address"].(string)] = neighbor["password"].(string)
+	}
+
+	var neighbors []interface{}
+	if bgpConfig.BgpNeighbours != nil {
+		for _, neighbor := range bgpConfig.BgpNeighbours.Neighbours {
+			remoteAs := neighbor.RemoteASNumber
+			if remoteAs == "" {
+				remoteAs = neighbor.RemoteAS
+			}
+
+			neighbors = append(neighbors, map[string]interface{}{
+				"ip_address":       neighbor.IpAddress,
+				"remote_as":        remoteAs,
+				"weight":           neighbor.Weight,
+				"keep_alive_timer": neighbor.KeepAliveTimer,
+				"hold_down_timer":  neighbor.HoldDownTimer,
+				"password":         passwords[neighbor.IpAddress],
+			})
+		}
+	}
+	err := d.Set("neighbor", neighbors)
+	if err != nil {
+		return fmt.Errorf("error setting 'neighbor': %s", err)
+	}
+
+	return setNsxvRedistributionData(d, bgpConfig.Redistribution)
+}
+
+// getNsxvBgpId constructs a fake BGP configuration ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:bgp" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:bgp")
+func getNsxvBgpId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":bgp"
+}
@@ -0,0 +1,247 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvCertificateCreate,
+		Read:   resourceVcdNsxvCertificateRead,
+		Delete: resourceVcdNsxvCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvCertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name to which the certificate is uploaded",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"service_certificate", "ca_certificate", "crl"}, false),
+				Description:  "Type of uploaded object. One of 'service_certificate', 'ca_certificate' or 'crl'",
+			},
+			"pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PEM encoded certificate, certificate chain or CRL",
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key. Required for type 'service_certificate'",
+			},
+			"passphrase": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Passphrase of private key if it is encrypted",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Optional description",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the object as it is reported by truststore",
+			},
+			"subject_cn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Common name of certificate subject. Empty for type 'crl'",
+			},
+			"issuer_cn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Common name of certificate or CRL issuer",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V certificate creation initiated")
+
+	certificateType := d.Get("type").(string)
+	privateKey := d.Get("private_key").(string)
+	if certificateType == "service_certificate" && privateKey == "" {
+		return fmt.Errorf("[nsxv certificate create] 'private_key' is required for type 'service_certificate'")
+	}
+	if certificateType != "service_certificate" && (privateKey != "" || d.Get("passphrase").(string) != "") {
+		return fmt.Errorf("[nsxv certificate create] 'private_key' and 'passphrase' can only be used with type 'service_certificate'")
+	}
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	trustObject := &nsxvTrustObject{
+		Description: d.Get("description").(string),
+		PemEncoding: d.Get("pem").(string),
+		PrivateKey:  privateKey,
+		Passphrase:  d.Get("passphrase").(string),
+	}
+
+	suffix := nsxvServicesCertificate
+	if certificateType == "crl" {
+		suffix = nsxvServicesCrl
+	}
+
+	objectId, err := uploadNsxvTrustObject(vcdClient, edgeGateway, suffix, trustObject)
+	if err != nil {
+		return fmt.Errorf("[nsxv certificate create] unable to upload %s: %s", certificateType, err)
+	}
+
+	d.SetId(objectId)
+
+	return resourceVcdNsxvCertificateRead(d, meta)
+}
+
+func resourceVcdNsxvCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V certificate read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing certificate from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = setNsxvCertificateData(d, vcdClient, edgeGateway)
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] certificate %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv certificate read] unable to read certificate with ID %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxvCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V certificate deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvTrustObject(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv certificate delete] error deleting certificate with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvCertificateImport imports a certificate or CRL by its ID which can be found in edge gateway
+// certificate list in the UI or API (e.g. "certificate-1" or "crl-1"). PEM content and private key are not
+// returned by the API and must be set in configuration after import
+func resourceVcdNsxvCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.certificate-id")
+	}
+	orgName, vdcName, edgeName, certificateId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	certificateType := "crl"
+	if !strings.HasPrefix(certificateId, "crl-") {
+		certificate, err := getNsxvCertificateById(vcdClient, edgeGateway, certificateId)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find certificate with ID %s: %s", certificateId, err)
+		}
+		certificateType = "service_certificate"
+		if strings.HasSuffix(strings.ToLower(certificate.CertificateType), "ca") {
+			certificateType = "ca_certificate"
+		}
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	_ = d.Set("type", certificateType)
+	d.SetId(certificateId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// setNsxvCertificateData reads a certificate or CRL with the ID of resource and sets its read only fields. PEM content
+// is never set because the API returns it reformatted
+func setNsxvCertificateData(d *schema.ResourceData, vcdClient *VCDClient, edge *govcd.EdgeGateway) error {
+	if strings.HasPrefix(d.Id(), "crl-") {
+		crl, err := getNsxvCrlById(vcdClient, edge, d.Id())
+		if err != nil {
+			return err
+		}
+		_ = d.Set("name", crl.Name)
+		_ = d.Set("description", crl.Description)
+		_ = d.Set("subject_cn", "")
+		_ = d.Set("issuer_cn", crl.IssuerCn)
+		return nil
+	}
+
+	certificate, err := getNsxvCertificateById(vcdClient, edge, d.Id())
+	if err != nil {
+		return err
+	}
+	_ = d.Set("name", certificate.Name)
+	_ = d.Set("description", certificate.Description)
+	if certificate.X509Certificate != nil {
+		_ = d.Set("subject_cn", certificate.X509Certificate.SubjectCn)
+		_ = d.Set("issuer_cn", certificate.X509Certificate.IssuerCn)
+	}
+	return nil
+}
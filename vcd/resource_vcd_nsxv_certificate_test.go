@@ -0,0 +1,255 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvCertificate uploads a CA certificate, a service certificate signed by it and a CRL to the truststore
+// and uses them for SSL offload in load balancer application profile
+func TestAccVcdNsxvCertificate(t *testing.T) {
+	caPem, certificatePem, privateKeyPem, crlPem, err := generateTestCertificates(t.Name())
+	if err != nil {
+		t.Fatalf("unable to generate test certificates: %s", err)
+	}
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":           testConfig.VCD.Org,
+		"Vdc":           testConfig.VCD.Vdc,
+		"EdgeGateway":   testConfig.Networking.EdgeGateway,
+		"Name":          t.Name(),
+		"CaPem":         caPem,
+		"CertPem":       certificatePem,
+		"PrivateKeyPem": privateKeyPem,
+		"CrlPem":        crlPem,
+		"Tags":          "nsxv gateway lb",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvCertificate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvCertificateNoSsl, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvCertificateDestroy("vcd_nsxv_certificate.ca"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("vcd_nsxv_certificate.ca", "id", regexp.MustCompile(`^certificate-\d*$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_certificate.ca", "subject_cn", t.Name()+"-ca"),
+					resource.TestMatchResourceAttr("vcd_nsxv_certificate.service", "id", regexp.MustCompile(`^certificate-\d*$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_certificate.service", "subject_cn", t.Name()),
+					resource.TestCheckResourceAttr("vcd_nsxv_certificate.service", "issuer_cn", t.Name()+"-ca"),
+					resource.TestMatchResourceAttr("vcd_nsxv_certificate.crl", "id", regexp.MustCompile(`^crl-\d*$`)),
+
+					resource.TestCheckResourceAttrPair("vcd_lb_app_profile.https", "service_certificate_id",
+						"vcd_nsxv_certificate.service", "id"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "client_auth", "required"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "service_ca_certificate_ids.#", "1"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "service_crl_ids.#", "1"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "enable_pool_side_ssl", "true"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "pool_ca_certificate_ids.#", "1"),
+					resourceFieldsEqual("vcd_lb_app_profile.https", "data.vcd_lb_app_profile.https", []string{}),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "service_certificate_id", ""),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "service_ca_certificate_ids.#", "0"),
+					resource.TestCheckResourceAttr("vcd_lb_app_profile.https", "pool_ca_certificate_ids.#", "0"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "vcd_nsxv_certificate.ca",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_certificate.ca"),
+				ImportStateVerifyIgnore: []string{"pem"},
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvCertificateDestroy checks that the certificate of given resource is removed from truststore
+func testAccCheckVcdNsxvCertificateDestroy(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found resource: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		_, err = getNsxvCertificateById(conn, edgeGateway, rs.Primary.ID)
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("certificate %s was not removed: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// generateTestCertificates generates a self signed CA, a service certificate with private key signed by this CA and
+// an empty CRL issued by the CA. All values are PEM encoded
+func generateTestCertificates(commonName string) (string, string, string, string, error) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	now := time.Now()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName + "-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	caCertificate, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, caCertificate, &key.PublicKey, caKey)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	crlDer, err := caCertificate.CreateCRL(rand.Reader, caKey, nil, now, now.AddDate(1, 0, 0))
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer})
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDer})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	crlPem := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDer})
+
+	return string(caPem), string(certificatePem), string(keyPem), string(crlPem), nil
+}
+
+const testAccVcdNsxvCertificatePrereqs = `
+resource "vcd_nsxv_certificate" "ca" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  type        = "ca_certificate"
+  description = "{{.Name}} CA"
+  pem         = <<EOT
+{{.CaPem}}EOT
+}
+
+resource "vcd_nsxv_certificate" "service" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  type        = "service_certificate"
+  pem         = <<EOT
+{{.CertPem}}EOT
+  private_key = <<EOT
+{{.PrivateKeyPem}}EOT
+}
+
+resource "vcd_nsxv_certificate" "crl" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  type = "crl"
+  pem  = <<EOT
+{{.CrlPem}}EOT
+}
+`
+
+const testAccVcdNsxvCertificate = testAccVcdNsxvCertificatePrereqs + `
+resource "vcd_lb_app_profile" "https" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  name = "{{.Name}}"
+  type = "https"
+
+  service_certificate_id     = vcd_nsxv_certificate.service.id
+  service_ca_certificate_ids = [vcd_nsxv_certificate.ca.id]
+  service_crl_ids            = [vcd_nsxv_certificate.crl.id]
+  client_auth                = "required"
+
+  enable_pool_side_ssl    = true
+  pool_ca_certificate_ids = [vcd_nsxv_certificate.ca.id]
+}
+
+data "vcd_lb_app_profile" "https" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  name         = vcd_lb_app_profile.https.name
+}
+`
+
+const testAccVcdNsxvCertificateNoSsl = testAccVcdNsxvCertificatePrereqs + `
+resource "vcd_lb_app_profile" "https" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  name = "{{.Name}}"
+  type = "https"
+}
+`
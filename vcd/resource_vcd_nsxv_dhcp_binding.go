@@ -0,0 +1,238 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// resourceVcdNsxvDhcpBinding manages a single DHCP static binding. NSX-V API does not support updating static
+// bindings therefore all fields force recreation
+func resourceVcdNsxvDhcpBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvDhcpBindingCreate,
+		Read:   resourceVcdNsxvDhcpBindingRead,
+		Delete: resourceVcdNsxvDhcpBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvDhcpBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which DHCP static binding is located",
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "MAC address of the client (e.g. '00:50:56:01:29:c8')",
+				ValidateFunc: validation.IsMACAddress,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "IP address assigned to the client",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname assigned to the client",
+			},
+			"subnet_mask": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Subnet mask assigned to the client. Taken from the network when not set",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"default_gateway": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Default gateway assigned to the client. Taken from the network when not set",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Domain name assigned to the client",
+			},
+			"auto_configure_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Uses DNS servers of edge gateway DNS forwarder instead of 'primary_name_server' and 'secondary_name_server'. Default 'false'",
+			},
+			"primary_name_server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Primary DNS server assigned to the client",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"secondary_name_server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Secondary DNS server assigned to the client",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"lease_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "86400",
+				ForceNew:    true,
+				Description: "Lease time in seconds or 'infinite'. Default '86400'",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvDhcpBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V DHCP static binding creation initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	binding := &nsxvDhcpStaticBinding{
+		MacAddress:          d.Get("mac_address").(string),
+		IpAddress:           d.Get("ip_address").(string),
+		Hostname:            d.Get("hostname").(string),
+		SubnetMask:          d.Get("subnet_mask").(string),
+		DefaultGateway:      d.Get("default_gateway").(string),
+		DomainName:          d.Get("domain_name").(string),
+		AutoConfigureDNS:    d.Get("auto_configure_dns").(bool),
+		PrimaryNameServer:   d.Get("primary_name_server").(string),
+		SecondaryNameServer: d.Get("secondary_name_server").(string),
+		LeaseTime:           d.Get("lease_time").(string),
+	}
+
+	bindingId, err := createNsxvDhcpStaticBinding(vcdClient, edgeGateway, binding)
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp binding create] unable to create DHCP static binding for MAC %s: %s",
+			binding.MacAddress, err)
+	}
+
+	d.SetId(bindingId)
+
+	return resourceVcdNsxvDhcpBindingRead(d, meta)
+}
+
+func resourceVcdNsxvDhcpBindingRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V DHCP static binding read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing DHCP static binding from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	binding, err := getNsxvDhcpStaticBindingById(vcdClient, edgeGateway, d.Id())
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] DHCP static binding %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp binding read] unable to read DHCP static binding with ID %s: %s", d.Id(), err)
+	}
+
+	_ = d.Set("mac_address", binding.MacAddress)
+	_ = d.Set("ip_address", binding.IpAddress)
+	_ = d.Set("hostname", binding.Hostname)
+	_ = d.Set("subnet_mask", binding.SubnetMask)
+	_ = d.Set("default_gateway", binding.DefaultGateway)
+	_ = d.Set("domain_name", binding.DomainName)
+	_ = d.Set("auto_configure_dns", binding.AutoConfigureDNS)
+	_ = d.Set("primary_name_server", binding.PrimaryNameServer)
+	_ = d.Set("secondary_name_server", binding.SecondaryNameServer)
+	_ = d.Set("lease_time", binding.LeaseTime)
+
+	return nil
+}
+
+func resourceVcdNsxvDhcpBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V DHCP static binding deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvDhcpStaticBinding(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp binding delete] error deleting DHCP static binding with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvDhcpBindingImport imports DHCP static binding by its ID which can be found in edge gateway DHCP
+// configuration in the UI or API (e.g. "binding-1")
+func resourceVcdNsxvDhcpBindingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.binding-id")
+	}
+	orgName, vdcName, edgeName, bindingId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	binding, err := getNsxvDhcpStaticBindingById(vcdClient, edgeGateway, bindingId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find DHCP static binding with ID %s: %s", bindingId, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(binding.BindingId)
+	return []*schema.ResourceData{d}, nil
+}
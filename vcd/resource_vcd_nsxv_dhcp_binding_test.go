@@ -0,0 +1,203 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvDhcpBinding tests DHCP static bindings, DHCP service settings and DHCP leases data source
+func TestAccVcdNsxvDhcpBinding(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"Tags":        "gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvDhcpBinding, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvDhcpBindingUpdate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvDhcpBindingDestroy("00:50:56:29:01:01"),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("vcd_nsxv_dhcp_binding.binding", "id", regexp.MustCompile(`^binding-\d+$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "mac_address", "00:50:56:29:01:01"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "ip_address", "10.203.0.50"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "hostname", "binding-host"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "default_gateway", "10.203.0.1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "primary_name_server", "8.8.8.8"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "lease_time", "86400"),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_dhcp_settings.settings", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:dhcpSettings$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_settings.settings", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_settings.settings", "logging_enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_settings.settings", "log_level", "debug"),
+
+					resource.TestMatchResourceAttr("data.vcd_nsxv_dhcp_leases.leases", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:dhcpLeases$`)),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "ip_address", "10.203.0.51"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "lease_time", "infinite"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_binding.binding", "auto_configure_dns", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_dhcp_settings.settings", "logging_enabled", "false"),
+					// Settings update must not remove static bindings
+					testAccCheckVcdNsxvDhcpBindingExists("vcd_nsxv_dhcp_binding.binding"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_dhcp_binding.binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_dhcp_binding.binding"),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_dhcp_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.VCD.Org + ImportSeparator + testConfig.VCD.Vdc + ImportSeparator + testConfig.Networking.EdgeGateway,
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvDhcpBindingExists checks that DHCP static binding of given resource exists in edge gateway
+func testAccCheckVcdNsxvDhcpBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		_, err = getNsxvDhcpStaticBindingById(conn, edgeGateway, rs.Primary.ID)
+		return err
+	}
+}
+
+// testAccCheckVcdNsxvDhcpBindingDestroy ensures that no DHCP static binding for given MAC address is left
+func testAccCheckVcdNsxvDhcpBindingDestroy(macAddress string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		bindings, err := getAllNsxvDhcpStaticBindings(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read DHCP static bindings: %s", err)
+		}
+
+		for _, binding := range bindings {
+			if binding.MacAddress == macAddress {
+				return fmt.Errorf("DHCP static binding %s for MAC %s still exists", binding.BindingId, macAddress)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccVcdNsxvDhcpBindingNetwork = `
+resource "vcd_network_routed" "dhcp" {
+  name         = "dhcp-binding-test"
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  gateway      = "10.203.0.1"
+  netmask      = "255.255.255.0"
+}
+
+data "vcd_nsxv_dhcp_leases" "leases" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+}
+`
+
+const testAccVcdNsxvDhcpBinding = testAccVcdNsxvDhcpBindingNetwork + `
+resource "vcd_nsxv_dhcp_settings" "settings" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  logging_enabled = true
+  log_level       = "debug"
+}
+
+resource "vcd_nsxv_dhcp_binding" "binding" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  mac_address         = "00:50:56:29:01:01"
+  ip_address          = "10.203.0.50"
+  hostname            = "binding-host"
+  subnet_mask         = "255.255.255.0"
+  default_gateway     = "10.203.0.1"
+  primary_name_server = "8.8.8.8"
+
+  depends_on = [vcd_network_routed.dhcp]
+}
+`
+
+const testAccVcdNsxvDhcpBindingUpdate = testAccVcdNsxvDhcpBindingNetwork + `
+resource "vcd_nsxv_dhcp_settings" "settings" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  logging_enabled = false
+}
+
+resource "vcd_nsxv_dhcp_binding" "binding" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  mac_address        = "00:50:56:29:01:01"
+  ip_address         = "10.203.0.51"
+  hostname           = "binding-host"
+  subnet_mask        = "255.255.255.0"
+  default_gateway    = "10.203.0.1"
+  auto_configure_dns = true
+  lease_time         = "infinite"
+
+  depends_on = [vcd_network_routed.dhcp]
+}
+`
@@ -0,0 +1,195 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvDhcpSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvDhcpSettingsCreate,
+		Read:   resourceVcdNsxvDhcpSettingsRead,
+		Update: resourceVcdNsxvDhcpSettingsUpdate,
+		Delete: resourceVcdNsxvDhcpSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvDhcpSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for DHCP service settings",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables DHCP service. Default 'true'",
+			},
+			"logging_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables DHCP service logging. Default 'false'",
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "info",
+				Description:  "Log level. One of 'emergency', 'alert', 'critical', 'error', 'warning', 'notice', 'info', 'debug'. Default 'info'",
+				ValidateFunc: validation.StringInSlice([]string{"emergency", "alert", "critical", "error", "warning", "notice", "info", "debug"}, false),
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvDhcpSettingsCreate sets DHCP service settings of edge gateway
+func resourceVcdNsxvDhcpSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V DHCP settings creation initiated")
+
+	err := resourceVcdNsxvDhcpSettingsUpdateConfig(d, meta, d.Get("enabled").(bool),
+		d.Get("logging_enabled").(bool), d.Get("log_level").(string))
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp settings create] %s", err)
+	}
+
+	return resourceVcdNsxvDhcpSettingsRead(d, meta)
+}
+
+// resourceVcdNsxvDhcpSettingsUpdate is the same as create because DHCP service settings always exist
+func resourceVcdNsxvDhcpSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V DHCP settings update initiated")
+
+	err := resourceVcdNsxvDhcpSettingsUpdateConfig(d, meta, d.Get("enabled").(bool),
+		d.Get("logging_enabled").(bool), d.Get("log_level").(string))
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp settings update] %s", err)
+	}
+
+	return resourceVcdNsxvDhcpSettingsRead(d, meta)
+}
+
+// resourceVcdNsxvDhcpSettingsUpdateConfig retrieves current DHCP service configuration and changes only service state
+// and logging so that static bindings and IP pools are retained
+func resourceVcdNsxvDhcpSettingsUpdateConfig(d *schema.ResourceData, meta interface{}, enabled, loggingEnabled bool, logLevel string) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	dhcpSettings, err := getNsxvDhcpSettings(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve DHCP service configuration: %s", err)
+	}
+
+	dhcpSettings.Enabled = enabled
+	dhcpSettings.Logging = &nsxvServiceLogging{
+		Enable:   loggingEnabled,
+		LogLevel: logLevel,
+	}
+
+	err = updateNsxvDhcpSettings(vcdClient, edgeGateway, dhcpSettings)
+	if err != nil {
+		return fmt.Errorf("unable to update DHCP service configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvDhcpSettingsId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvDhcpSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V DHCP settings read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing DHCP settings from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	dhcpSettings, err := getNsxvDhcpSettings(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp settings read] could not read DHCP service configuration: %s", err)
+	}
+
+	_ = d.Set("enabled", dhcpSettings.Enabled)
+	if dhcpSettings.Logging != nil {
+		_ = d.Set("logging_enabled", dhcpSettings.Logging.Enable)
+		_ = d.Set("log_level", dhcpSettings.Logging.LogLevel)
+	}
+
+	d.SetId(getNsxvDhcpSettingsId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvDhcpSettingsDelete restores default DHCP service settings (service enabled, logging disabled). The
+// service is not disabled because DHCP pools of routed networks depend on it
+func resourceVcdNsxvDhcpSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V DHCP settings deletion initiated")
+
+	err := resourceVcdNsxvDhcpSettingsUpdateConfig(d, meta, true, false, "info")
+	if err != nil {
+		return fmt.Errorf("[nsxv dhcp settings delete] could not reset DHCP service settings: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvDhcpSettingsImport imports DHCP service settings. Because DHCP service settings are just a
+// configuration of edge gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvDhcpSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvDhcpSettingsId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvDhcpSettingsId constructs a fake DHCP settings ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:dhcpSettings" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:dhcpSettings")
+func getNsxvDhcpSettingsId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":dhcpSettings"
+}
@@ -142,6 +142,14 @@ func resourceVcdNsxvFirewallRule() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"mac_set_ids": {
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Description: "Set of MAC set IDs",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						// TODO - uncomment once security groups are supported
 						// "security_groups": {
 						// 	Optional:    true,
@@ -208,6 +216,14 @@ func resourceVcdNsxvFirewallRule() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"mac_set_ids": {
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Description: "Set of MAC set IDs",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						// TODO - uncomment once security groups are supported
 						// "security_groups": {
 						// 	Optional:    true,
@@ -228,10 +244,11 @@ func resourceVcdNsxvFirewallRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"protocol": {
-							Required:         true,
+							Optional:         true,
 							Type:             schema.TypeString,
 							ValidateFunc:     validation.StringInSlice([]string{"any", "icmp", "tcp", "udp"}, true),
 							DiffSuppressFunc: suppressCase,
+							Description:      "Protocol - one of 'any', 'icmp', 'tcp', 'udp'. Conflicts with 'service_id'",
 						},
 						"port": {
 							Optional:     true,
@@ -245,6 +262,11 @@ func resourceVcdNsxvFirewallRule() *schema.Resource {
 							Type:         schema.TypeString,
 							ValidateFunc: validateCase("lower"),
 						},
+						"service_id": {
+							Optional:    true,
+							Type:        schema.TypeString,
+							Description: "ID of service or service group. Conflicts with 'protocol'",
+						},
 					},
 				},
 			},
@@ -558,7 +580,7 @@ func getFirewallRule(d *schema.ResourceData, edge *govcd.EdgeGateway, vdc *govcd
 		return nil, fmt.Errorf("could not convert 'destination' block to API request: %s", err)
 	}
 
-	services, err := getFirewallServices(d.Get("service").(*schema.Set))
+	services, serviceId, err := getFirewallServices(d.Get("service").(*schema.Set))
 	if err != nil {
 		return nil, fmt.Errorf("could not convert services blocks for API request: %s ", err)
 	}
@@ -569,6 +591,7 @@ func getFirewallRule(d *schema.ResourceData, edge *govcd.EdgeGateway, vdc *govcd
 		LoggingEnabled: d.Get("logging_enabled").(bool),
 		Action:         d.Get("action").(string),
 		Application: types.EdgeFirewallApplication{
+			ID:       serviceId,
 			Services: services,
 		},
 		Source:      *sourceEndpoint,
@@ -591,6 +614,7 @@ func getEndpointData(endpoint types.EdgeFirewallEndpoint, edge *govcd.EdgeGatewa
 		endpointNetworks []string
 		endpointVMs      []string
 		endpointIpSets   []string
+		endpointMacSets  []string
 		// TODO uncomment when Security groups are supported
 		// endpointSecurityGroups []string
 	)
@@ -621,6 +645,11 @@ func getEndpointData(endpoint types.EdgeFirewallEndpoint, edge *govcd.EdgeGatewa
 		case idLen == 2 && subIdSplit == "ipset":
 			endpointIpSets = append(endpointIpSets, groupingObject)
 
+		// Handle MAC sets
+		// Sample ID: f9daf2da-b4f9-4921-a2f4-d77a943a381c:macset-2
+		case idLen == 2 && subIdSplit == "macset":
+			endpointMacSets = append(endpointMacSets, groupingObject)
+
 		// TODO uncomment when Security groups are supported
 		// Handle security groups
 		// Sample ID: f9daf2da-b4f9-4921-a2f4-d77a943a381c:securitygroup-11
@@ -665,6 +694,10 @@ func getEndpointData(endpoint types.EdgeFirewallEndpoint, edge *govcd.EdgeGatewa
 	endpointIpSetSlice := convertToTypeSet(endpointIpSetNames)
 	endpointIpSetSet := schema.NewSet(schema.HashSchema(&schema.Schema{Type: schema.TypeString}), endpointIpSetSlice)
 
+	// Convert MAC set IDs to set
+	endpointMacSetSlice := convertToTypeSet(endpointMacSets)
+	endpointMacSetSet := schema.NewSet(schema.HashSchema(&schema.Schema{Type: schema.TypeString}), endpointMacSetSlice)
+
 	// TODO uncomment when Security groups are supported
 	// Convert security group IDs to set
 	// endpointSecurityGroupSlice := convertToTypeSet(endpointSecurityGroups)
@@ -679,6 +712,7 @@ func getEndpointData(endpoint types.EdgeFirewallEndpoint, edge *govcd.EdgeGatewa
 	endpointMap["org_networks"] = endpointNetworksSet
 	endpointMap["vm_ids"] = endpointVmSet
 	endpointMap["ip_sets"] = endpointIpSetSet
+	endpointMap["mac_set_ids"] = endpointMacSetSet
 	// TODO - uncomment when security groups are supported
 	// endpointMap["security_groups"] = endpointSecurityGroupSet
 
@@ -696,10 +730,22 @@ func getServiceData(firewallApplication types.EdgeFirewallApplication, edge *gov
 		serviceMap["protocol"] = service.Protocol
 		serviceMap["port"] = service.Port
 		serviceMap["source_port"] = service.SourcePort
+		serviceMap["service_id"] = ""
 
 		serviceSlice[index] = serviceMap
 	}
 
+	// A service or service group reference is stored as a separate 'service' block
+	if firewallApplication.ID != "" {
+		serviceMap := make(map[string]interface{})
+		serviceMap["protocol"] = ""
+		serviceMap["port"] = ""
+		serviceMap["source_port"] = ""
+		serviceMap["service_id"] = firewallApplication.ID
+
+		serviceSlice = append(serviceSlice, serviceMap)
+	}
+
 	serviceSet := schema.NewSet(resourceVcdNsxvFirewallRuleServiceHash, serviceSlice)
 
 	return serviceSet, nil
@@ -753,6 +799,10 @@ func getFirewallRuleEndpoint(endpoint []interface{}, edge *govcd.EdgeGateway, vd
 	}
 	result.GroupingObjectIds = append(result.GroupingObjectIds, endpointIpSetIdStrings...)
 
+	// Extract MAC set IDs from set and add them to endpoint structure
+	endpointMacSetIdStrings := convertSchemaSetToSliceOfStrings(endpointMap["mac_set_ids"].(*schema.Set))
+	result.GroupingObjectIds = append(result.GroupingObjectIds, endpointMacSetIdStrings...)
+
 	// TODO - uncomment once security groups are supported
 	// Extract security group IDs from set and add them to endpoint structure
 	// endpointSecurityGroupStrings := convertSchemaSetToSliceOfStrings(endpointMap["security_groups"].(*schema.Set))
@@ -761,22 +811,42 @@ func getFirewallRuleEndpoint(endpoint []interface{}, edge *govcd.EdgeGateway, vd
 	return result, nil
 }
 
-// getFirewallServices extracts service definition from terraform schema and returns it
-func getFirewallServices(serviceSet *schema.Set) ([]types.EdgeFirewallApplicationService, error) {
-	serviceSlice := serviceSet.List()
-	services := make([]types.EdgeFirewallApplicationService, len(serviceSlice))
-	if len(services) > 0 {
-		for index, service := range serviceSlice {
-			serviceMap := convertToStringMap(service.(map[string]interface{}))
-			oneService := types.EdgeFirewallApplicationService{
-				Protocol:   serviceMap["protocol"],
-				Port:       serviceMap["port"],
-				SourcePort: serviceMap["source_port"],
+// getFirewallServices extracts service definition from terraform schema and returns it together with
+// service or service group ID if one is referenced. Each 'service' block must have either 'protocol' or
+// 'service_id' and only one 'service_id' is allowed per rule
+func getFirewallServices(serviceSet *schema.Set) ([]types.EdgeFirewallApplicationService, string, error) {
+	var (
+		services  []types.EdgeFirewallApplicationService
+		serviceId string
+	)
+	for _, service := range serviceSet.List() {
+		serviceMap := convertToStringMap(service.(map[string]interface{}))
+
+		if serviceMap["service_id"] != "" {
+			if serviceMap["protocol"] != "" || serviceMap["port"] != "" || serviceMap["source_port"] != "" {
+				return nil, "", fmt.Errorf("'service_id' cannot be combined with 'protocol', 'port' or " +
+					"'source_port' in the same 'service' block")
 			}
-			services[index] = oneService
+			if serviceId != "" {
+				return nil, "", fmt.Errorf("only one 'service_id' can be used in a firewall rule. " +
+					"Use service group to combine multiple services")
+			}
+			serviceId = serviceMap["service_id"]
+			continue
+		}
+
+		if serviceMap["protocol"] == "" {
+			return nil, "", fmt.Errorf("each 'service' block must have either 'protocol' or 'service_id'")
 		}
+
+		oneService := types.EdgeFirewallApplicationService{
+			Protocol:   serviceMap["protocol"],
+			Port:       serviceMap["port"],
+			SourcePort: serviceMap["source_port"],
+		}
+		services = append(services, oneService)
 	}
-	return services, nil
+	return services, serviceId, nil
 }
 
 // edgeVnicIdStringsToNetworkNames iterates over vnic IDs in format `vnic-10`, `vnic-x` and converts
@@ -879,10 +949,16 @@ func stringInSlice(str string, list []string) bool {
 // avoid hash changes when port or source_port ar left empty or set as 'any'. Having empty port and
 // source_port is the same as having "any".
 // protocol, port, source_port
+// A block referring a service or service group is hashed by 'service_id' only.
 func resourceVcdNsxvFirewallRuleServiceHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
 
+	if serviceId, ok := m["service_id"].(string); ok && serviceId != "" {
+		buf.WriteString(fmt.Sprintf("%s-", serviceId))
+		return hashcodeString(buf.String())
+	}
+
 	protocol := strings.ToLower(m["protocol"].(string))
 	port := strings.ToLower(m["port"].(string))
 	sourcePort := strings.ToLower(m["source_port"].(string))
@@ -0,0 +1,310 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+func resourceVcdNsxvFirewallRules() *schema.Resource {
+	// Rule fields are shared with vcd_nsxv_firewall_rule so that both resources accept the same definition
+	firewallRuleSchema := resourceVcdNsxvFirewallRule().Schema
+
+	return &schema.Resource{
+		Create: resourceVcdNsxvFirewallRulesCreate,
+		Read:   resourceVcdNsxvFirewallRulesRead,
+		Update: resourceVcdNsxvFirewallRulesUpdate,
+		Delete: resourceVcdNsxvFirewallRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvFirewallRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which firewall rules are located",
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Ordered list of all user defined firewall rules. The first rule has the highest " +
+					"priority",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":            firewallRuleSchema["name"],
+						"action":          firewallRuleSchema["action"],
+						"enabled":         firewallRuleSchema["enabled"],
+						"logging_enabled": firewallRuleSchema["logging_enabled"],
+						"rule_tag": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(65537, 131072),
+							Description:  "Optional. Allows to set custom rule tag",
+						},
+						"source":      firewallRuleSchema["source"],
+						"destination": firewallRuleSchema["destination"],
+						"service":     firewallRuleSchema["service"],
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvFirewallRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V firewall rules creation initiated")
+
+	err := resourceVcdNsxvFirewallRulesUpdateConfig(d, meta, d.Get("rule").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("[nsxv firewall rules create] %s", err)
+	}
+
+	return resourceVcdNsxvFirewallRulesRead(d, meta)
+}
+
+func resourceVcdNsxvFirewallRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V firewall rules update initiated")
+
+	err := resourceVcdNsxvFirewallRulesUpdateConfig(d, meta, d.Get("rule").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("[nsxv firewall rules update] %s", err)
+	}
+
+	return resourceVcdNsxvFirewallRulesRead(d, meta)
+}
+
+// resourceVcdNsxvFirewallRulesUpdateConfig replaces all user defined firewall rules with the given ones in a single
+// request. Firewall state, default policy and global configuration are retained
+func resourceVcdNsxvFirewallRulesUpdateConfig(d *schema.ResourceData, meta interface{}, rules []interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	firewallRules := make([]*types.EdgeFirewallRule, len(rules))
+	for index, rule := range rules {
+		firewallRules[index], err = getNsxvFirewallRulesRule(rule.(map[string]interface{}), edgeGateway, vdc)
+		if err != nil {
+			return fmt.Errorf("unable to process firewall rule %d: %s", index+1, err)
+		}
+	}
+
+	firewallConfig, err := getNsxvFirewallConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve firewall configuration: %s", err)
+	}
+
+	firewallConfig.FirewallRules.Rules = firewallRules
+	err = updateNsxvFirewallConfig(vcdClient, edgeGateway, firewallConfig)
+	if err != nil {
+		return fmt.Errorf("unable to update firewall rules for edge gateway %s: %s", edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvFirewallRulesId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvFirewallRulesRead reads all user defined rules in their order so that reordered rules and rules
+// added outside of Terraform are reported as a difference
+func resourceVcdNsxvFirewallRulesRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V firewall rules read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing firewall rules from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	firewallConfig, err := getNsxvFirewallConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv firewall rules read] could not read firewall configuration: %s", err)
+	}
+
+	// Rule tags in configuration are needed to tell a custom rule tag from the one that NSX-V reports by default
+	configuredRules := d.Get("rule").([]interface{})
+	userRules := getNsxvUserFirewallRules(firewallConfig)
+	rules := make([]interface{}, len(userRules))
+	for index, rule := range userRules {
+		configuredRuleTag := 0
+		if index < len(configuredRules) && configuredRules[index] != nil {
+			configuredRuleTag = configuredRules[index].(map[string]interface{})["rule_tag"].(int)
+		}
+
+		rules[index], err = getNsxvFirewallRulesRuleData(rule, configuredRuleTag, edgeGateway, vdc)
+		if err != nil {
+			return fmt.Errorf("[nsxv firewall rules read] could not process firewall rule %s: %s", rule.ID, err)
+		}
+	}
+
+	err = d.Set("rule", rules)
+	if err != nil {
+		return fmt.Errorf("[nsxv firewall rules read] could not set 'rule' blocks: %s", err)
+	}
+
+	d.SetId(getNsxvFirewallRulesId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvFirewallRulesDelete removes all user defined firewall rules. Default policy is left as it is
+func resourceVcdNsxvFirewallRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V firewall rules deletion initiated")
+
+	err := resourceVcdNsxvFirewallRulesUpdateConfig(d, meta, nil)
+	if err != nil {
+		return fmt.Errorf("[nsxv firewall rules delete] could not remove firewall rules: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvFirewallRulesImport imports all user defined firewall rules of edge gateway. Because the rule list
+// is a configuration of edge gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvFirewallRulesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvFirewallRulesId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvFirewallRulesRule converts a single 'rule' block into *types.EdgeFirewallRule. Rule ID is not set because
+// the whole list is replaced and NSX-V assigns new IDs
+func getNsxvFirewallRulesRule(ruleMap map[string]interface{}, edge *govcd.EdgeGateway, vdc *govcd.Vdc) (*types.EdgeFirewallRule, error) {
+	sourceEndpoint, err := getFirewallRuleEndpoint(ruleMap["source"].([]interface{}), edge, vdc, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert 'source' block to API request: %s", err)
+	}
+
+	destinationEndpoint, err := getFirewallRuleEndpoint(ruleMap["destination"].([]interface{}), edge, vdc, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert 'destination' block to API request: %s", err)
+	}
+
+	services, serviceId, err := getFirewallServices(ruleMap["service"].(*schema.Set))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert services blocks for API request: %s ", err)
+	}
+
+	firewallRule := &types.EdgeFirewallRule{
+		Name:           ruleMap["name"].(string),
+		Enabled:        ruleMap["enabled"].(bool),
+		LoggingEnabled: ruleMap["logging_enabled"].(bool),
+		Action:         ruleMap["action"].(string),
+		Application: types.EdgeFirewallApplication{
+			ID:       serviceId,
+			Services: services,
+		},
+		Source:      *sourceEndpoint,
+		Destination: *destinationEndpoint,
+	}
+
+	if ruleTag := ruleMap["rule_tag"].(int); ruleTag != 0 {
+		firewallRule.RuleTag = strconv.Itoa(ruleTag)
+	}
+
+	return firewallRule, nil
+}
+
+// getNsxvFirewallRulesRuleData converts *types.EdgeFirewallRule into a map suitable for a 'rule' block.
+// 'configuredRuleTag' is the rule tag which is set for this rule in configuration (0 when it is not set)
+func getNsxvFirewallRulesRuleData(rule *types.EdgeFirewallRule, configuredRuleTag int, edge *govcd.EdgeGateway, vdc *govcd.Vdc) (map[string]interface{}, error) {
+	ruleMap := make(map[string]interface{})
+	ruleMap["name"] = rule.Name
+	ruleMap["action"] = rule.Action
+	ruleMap["enabled"] = rule.Enabled
+	ruleMap["logging_enabled"] = rule.LoggingEnabled
+
+	// NSX-V reports rule ID as rule tag when a custom one is not set. A custom rule tag which is equal to rule ID is
+	// only kept when it is set in configuration
+	ruleMap["rule_tag"] = 0
+	if rule.RuleTag != "" {
+		ruleTag, err := strconv.Atoi(rule.RuleTag)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert ruletag (%s) from string to int: %s", rule.RuleTag, err)
+		}
+		if rule.RuleTag != rule.ID || ruleTag == configuredRuleTag {
+			ruleMap["rule_tag"] = ruleTag
+		}
+	}
+
+	source, err := getEndpointData(rule.Source, edge, vdc)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare data for setting 'source' block: %s", err)
+	}
+	ruleMap["source"] = source
+
+	destination, err := getEndpointData(rule.Destination, edge, vdc)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare data for setting 'destination' block: %s", err)
+	}
+	ruleMap["destination"] = destination
+
+	serviceSet, err := getServiceData(rule.Application, edge, vdc)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare data for setting 'service' blocks: %s", err)
+	}
+	ruleMap["service"] = serviceSet
+
+	return ruleMap, nil
+}
+
+// getNsxvFirewallRulesId constructs a fake firewall rules ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:firewallRules" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:firewallRules")
+func getNsxvFirewallRulesId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":firewallRules"
+}
@@ -0,0 +1,255 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// TestAccVcdNsxvFirewallRules tests ordered firewall rule list. Because the resource owns all user defined rules of
+// edge gateway, this test must not run in parallel with other firewall rule tests
+func TestAccVcdNsxvFirewallRules(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"Tags":        "gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvFirewallRules, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvFirewallRulesReordered, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvFirewallRulesEmpty,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("vcd_nsxv_firewall_rules.rules", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:firewallRules$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.#", "3"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.0.name", "allow-ssh"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.1.name", "allow-web"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.1.rule_tag", "70000"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.2.name", "deny-ping"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.2.action", "deny"),
+					testAccCheckVcdNsxvFirewallRulesOrder([]string{"allow-ssh", "allow-web", "deny-ping"}),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.#", "3"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.0.name", "deny-ping"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.1.name", "allow-web"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.2.name", "allow-ssh"),
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.2.enabled", "false"),
+					testAccCheckVcdNsxvFirewallRulesOrder([]string{"deny-ping", "allow-web", "allow-ssh"}),
+				),
+			},
+			// A rule added outside of Terraform must be reported as a difference
+			resource.TestStep{
+				PreConfig:          testAccAddNsxvFirewallRuleOutOfBand(t),
+				Config:             configText1,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Apply removes the rule which was added outside of Terraform
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_firewall_rules.rules", "rule.#", "3"),
+					testAccCheckVcdNsxvFirewallRulesOrder([]string{"deny-ping", "allow-web", "allow-ssh"}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_firewall_rules.rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.VCD.Org + ImportSeparator + testConfig.VCD.Vdc + ImportSeparator + testConfig.Networking.EdgeGateway,
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvFirewallRulesOrder checks that user defined firewall rules in edge gateway have exactly the
+// given names in the given order
+func testAccCheckVcdNsxvFirewallRulesOrder(names []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		firewallConfig, err := getNsxvFirewallConfig(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read firewall configuration: %s", err)
+		}
+
+		userRules := getNsxvUserFirewallRules(firewallConfig)
+		if len(userRules) != len(names) {
+			return fmt.Errorf("expected %d user firewall rules, got %d", len(names), len(userRules))
+		}
+
+		for index, rule := range userRules {
+			if rule.Name != names[index] {
+				return fmt.Errorf("expected rule %d to be %s, got %s", index+1, names[index], rule.Name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccAddNsxvFirewallRuleOutOfBand creates a firewall rule directly in edge gateway
+func testAccAddNsxvFirewallRuleOutOfBand(t *testing.T) func() {
+	return func() {
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			t.Fatalf(errorUnableToFindEdgeGateway, err)
+		}
+
+		_, err = edgeGateway.CreateNsxvFirewallRule(&types.EdgeFirewallRule{
+			Name:        "out-of-band",
+			Action:      "accept",
+			Enabled:     true,
+			Source:      types.EdgeFirewallEndpoint{IpAddresses: []string{"any"}},
+			Destination: types.EdgeFirewallEndpoint{IpAddresses: []string{"any"}},
+			Application: types.EdgeFirewallApplication{
+				Services: []types.EdgeFirewallApplicationService{{Protocol: "any"}},
+			},
+		}, "")
+		if err != nil {
+			t.Fatalf("unable to create firewall rule: %s", err)
+		}
+	}
+}
+
+// testAccCheckVcdNsxvFirewallRulesEmpty ensures that no user defined firewall rules are left
+func testAccCheckVcdNsxvFirewallRulesEmpty(s *terraform.State) error {
+	return testAccCheckVcdNsxvFirewallRulesOrder([]string{})(s)
+}
+
+const testAccVcdNsxvFirewallRulesSsh = `
+  rule {
+    name = "allow-ssh"
+
+    source {
+      ip_addresses = ["10.10.10.0/24"]
+    }
+
+    destination {
+      gateway_interfaces = ["internal"]
+    }
+
+    service {
+      protocol = "tcp"
+      port     = "22"
+    }
+  }
+`
+
+const testAccVcdNsxvFirewallRulesWeb = `
+  rule {
+    name     = "allow-web"
+    rule_tag = 70000
+
+    source {
+      ip_addresses = ["any"]
+    }
+
+    destination {
+      ip_addresses = ["10.10.20.10"]
+    }
+
+    service {
+      protocol = "tcp"
+      port     = "443"
+    }
+
+    service {
+      protocol = "tcp"
+      port     = "80"
+    }
+  }
+`
+
+const testAccVcdNsxvFirewallRulesPing = `
+  rule {
+    name   = "deny-ping"
+    action = "deny"
+
+    source {
+      exclude      = true
+      ip_addresses = ["10.10.10.0/24"]
+    }
+
+    destination {
+      ip_addresses = ["any"]
+    }
+
+    service {
+      protocol = "icmp"
+    }
+  }
+`
+
+const testAccVcdNsxvFirewallRules = `
+resource "vcd_nsxv_firewall_rules" "rules" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+` + testAccVcdNsxvFirewallRulesSsh + testAccVcdNsxvFirewallRulesWeb + testAccVcdNsxvFirewallRulesPing + `
+}
+`
+
+const testAccVcdNsxvFirewallRulesReordered = `
+resource "vcd_nsxv_firewall_rules" "rules" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+` + testAccVcdNsxvFirewallRulesPing + testAccVcdNsxvFirewallRulesWeb + `
+  rule {
+    name    = "allow-ssh"
+    enabled = false
+
+    source {
+      ip_addresses = ["10.10.10.0/24"]
+    }
+
+    destination {
+      gateway_interfaces = ["internal"]
+    }
+
+    service {
+      protocol = "tcp"
+      port     = "22"
+    }
+  }
+}
+`
@@ -0,0 +1,262 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvGroupingObjects tests MAC sets, services and service groups together with their data sources and
+// a firewall rule referring them
+func TestAccVcdNsxvGroupingObjects(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"Name":        t.Name(),
+		"Tags":        "nsxv gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvGroupingObjects, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvGroupingObjectsUpdate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvGroupingObjectsDestroy(t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("vcd_nsxv_mac_set.set", "id", regexp.MustCompile(`.*macset-\d*$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_mac_set.set", "name", t.Name()+"-macset"),
+					resource.TestCheckResourceAttr("vcd_nsxv_mac_set.set", "mac_addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vcd_nsxv_mac_set.set", "mac_addresses.*", "00:50:56:29:02:01"),
+					resource.TestCheckTypeSetElemAttr("vcd_nsxv_mac_set.set", "mac_addresses.*", "00:50:56:29:02:02"),
+					resourceFieldsEqual("vcd_nsxv_mac_set.set", "data.vcd_nsxv_mac_set.set", []string{}),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_service.http", "id", regexp.MustCompile(`.*application-\d*$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_service.http", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("vcd_nsxv_service.http", "port", "8080"),
+					resourceFieldsEqual("vcd_nsxv_service.http", "data.vcd_nsxv_service.http", []string{}),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_service_group.group", "id", regexp.MustCompile(`.*applicationgroup-\d*$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_service_group.group", "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("vcd_nsxv_service_group.group", "member_ids.*", "vcd_nsxv_service.http", "id"),
+					resourceFieldsEqual("vcd_nsxv_service_group.group", "data.vcd_nsxv_service_group.group", []string{}),
+
+					resource.TestCheckTypeSetElemAttrPair("vcd_nsxv_firewall_rule.rule", "source.0.mac_set_ids.*", "vcd_nsxv_mac_set.set", "id"),
+					resource.TestCheckTypeSetElemAttrPair("vcd_nsxv_firewall_rule.rule", "service.*.service_id", "vcd_nsxv_service_group.group", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_mac_set.set", "description", "updated"),
+					resource.TestCheckResourceAttr("vcd_nsxv_mac_set.set", "mac_addresses.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_service.http", "port", "8080-8081"),
+					resource.TestCheckResourceAttr("vcd_nsxv_service.http", "source_port", "1024-65535"),
+					resource.TestCheckResourceAttr("vcd_nsxv_service_group.group", "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("vcd_nsxv_service_group.group", "member_ids.*", "vcd_nsxv_service.ping", "id"),
+					resource.TestCheckTypeSetElemAttrPair("vcd_nsxv_firewall_rule.rule", "service.*.service_id", "vcd_nsxv_service.ping", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcd_nsxv_firewall_rule.rule", "service.*", map[string]string{
+						"protocol": "udp",
+						"port":     "53",
+					}),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_mac_set.set",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgVdcObject(testConfig, t.Name()+"-macset"),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_service.http",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgVdcObject(testConfig, t.Name()+"-http"),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_service_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgVdcObject(testConfig, t.Name()+"-group"),
+			},
+		},
+	})
+}
+
+func testAccCheckVcdNsxvGroupingObjectsDestroy(namePrefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		_, vdc, err := conn.GetOrgAndVdc(testConfig.VCD.Org, testConfig.VCD.Vdc)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingVdcFromOrg, testConfig.VCD.Vdc, testConfig.VCD.Org, err)
+		}
+
+		_, err = getNsxvMacSetByName(conn, vdc, namePrefix+"-macset")
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("MAC set %s was not deleted: %s", namePrefix+"-macset", err)
+		}
+
+		for _, serviceName := range []string{namePrefix + "-http", namePrefix + "-ping"} {
+			_, err = getNsxvApplicationByName(conn, vdc, serviceName)
+			if !govcd.ContainsNotFound(err) {
+				return fmt.Errorf("service %s was not deleted: %s", serviceName, err)
+			}
+		}
+
+		_, err = getNsxvApplicationGroupByName(conn, vdc, namePrefix+"-group")
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("service group %s was not deleted: %s", namePrefix+"-group", err)
+		}
+
+		return nil
+	}
+}
+
+const testAccVcdNsxvGroupingObjectsData = `
+data "vcd_nsxv_mac_set" "set" {
+  org  = "{{.Org}}"
+  vdc  = "{{.Vdc}}"
+  name = vcd_nsxv_mac_set.set.name
+}
+
+data "vcd_nsxv_service" "http" {
+  org  = "{{.Org}}"
+  vdc  = "{{.Vdc}}"
+  name = vcd_nsxv_service.http.name
+}
+
+data "vcd_nsxv_service_group" "group" {
+  org  = "{{.Org}}"
+  vdc  = "{{.Vdc}}"
+  name = vcd_nsxv_service_group.group.name
+}
+`
+
+const testAccVcdNsxvGroupingObjects = `
+resource "vcd_nsxv_mac_set" "set" {
+  org           = "{{.Org}}"
+  vdc           = "{{.Vdc}}"
+  name          = "{{.Name}}-macset"
+  mac_addresses = ["00:50:56:29:02:01", "00:50:56:29:02:02"]
+}
+
+resource "vcd_nsxv_service" "http" {
+  org      = "{{.Org}}"
+  vdc      = "{{.Vdc}}"
+  name     = "{{.Name}}-http"
+  protocol = "tcp"
+  port     = "8080"
+}
+
+resource "vcd_nsxv_service_group" "group" {
+  org        = "{{.Org}}"
+  vdc        = "{{.Vdc}}"
+  name       = "{{.Name}}-group"
+  member_ids = [vcd_nsxv_service.http.id]
+}
+
+resource "vcd_nsxv_firewall_rule" "rule" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  name         = "{{.Name}}"
+
+  source {
+    mac_set_ids = [vcd_nsxv_mac_set.set.id]
+  }
+
+  destination {
+    ip_addresses = ["any"]
+  }
+
+  service {
+    service_id = vcd_nsxv_service_group.group.id
+  }
+}
+` + testAccVcdNsxvGroupingObjectsData
+
+const testAccVcdNsxvGroupingObjectsUpdate = `
+resource "vcd_nsxv_mac_set" "set" {
+  org           = "{{.Org}}"
+  vdc           = "{{.Vdc}}"
+  name          = "{{.Name}}-macset"
+  description   = "updated"
+  mac_addresses = ["00:50:56:29:02:01"]
+}
+
+resource "vcd_nsxv_service" "http" {
+  org         = "{{.Org}}"
+  vdc         = "{{.Vdc}}"
+  name        = "{{.Name}}-http"
+  protocol    = "tcp"
+  port        = "8080-8081"
+  source_port = "1024-65535"
+}
+
+resource "vcd_nsxv_service" "ping" {
+  org      = "{{.Org}}"
+  vdc      = "{{.Vdc}}"
+  name     = "{{.Name}}-ping"
+  protocol = "icmp"
+  port     = "echo-request"
+}
+
+resource "vcd_nsxv_service_group" "group" {
+  org        = "{{.Org}}"
+  vdc        = "{{.Vdc}}"
+  name       = "{{.Name}}-group"
+  member_ids = [vcd_nsxv_service.http.id, vcd_nsxv_service.ping.id]
+}
+
+resource "vcd_nsxv_firewall_rule" "rule" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  name         = "{{.Name}}"
+
+  source {
+    mac_set_ids = [vcd_nsxv_mac_set.set.id]
+  }
+
+  destination {
+    ip_addresses = ["any"]
+  }
+
+  service {
+    service_id = vcd_nsxv_service.ping.id
+  }
+
+  service {
+    protocol = "udp"
+    port     = "53"
+  }
+}
+` + testAccVcdNsxvGroupingObjectsData
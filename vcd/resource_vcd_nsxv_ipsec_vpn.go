@@ -0,0 +1,415 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxvIpsecVpnSiteResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of IPsec VPN site",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of IPsec VPN site",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Enables or disables IPsec VPN site. Default 'true'",
+		},
+		"local_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Local identifier. Usually the IP address of local endpoint",
+		},
+		"local_ip": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of local endpoint. It must be an IP address of edge gateway uplink",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"local_subnets": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Set of local subnets in CIDR format",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+		"peer_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Peer identifier. Usually the IP address of peer endpoint",
+		},
+		"peer_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "IP address of peer endpoint or 'any'",
+		},
+		"peer_subnets": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Set of peer subnets in CIDR format",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+		"encryption_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "aes256",
+			Description:  "Encryption algorithm. One of 'aes', 'aes256', 'aes-gcm', 'triple_des'. Default 'aes256'",
+			ValidateFunc: validation.StringInSlice([]string{"aes", "aes256", "aes-gcm", "triple_des"}, false),
+		},
+		"authentication_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "psk",
+			Description:  "Authentication mode. One of 'psk', 'x.509'. Default 'psk'",
+			ValidateFunc: validation.StringInSlice([]string{"psk", "x.509"}, false),
+		},
+		"psk": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Pre-shared key. Required when 'authentication_mode' is 'psk'",
+		},
+		"pfs_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Enables Perfect Forward Secrecy. Default 'true'",
+		},
+		"dh_group": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "dh14",
+			Description:  "Diffie-Hellman group. One of 'dh2', 'dh5', 'dh14', 'dh15', 'dh16'. Default 'dh14'",
+			ValidateFunc: validation.StringInSlice([]string{"dh2", "dh5", "dh14", "dh15", "dh16"}, false),
+		},
+	},
+}
+
+func resourceVcdNsxvIpsecVpn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvIpsecVpnCreate,
+		Read:   resourceVcdNsxvIpsecVpnRead,
+		Update: resourceVcdNsxvIpsecVpnUpdate,
+		Delete: resourceVcdNsxvIpsecVpnDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvIpsecVpnImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for IPsec VPN configuration",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables IPsec VPN service. Default 'true'",
+			},
+			"logging_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables IPsec VPN logging. Default 'false'",
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "info",
+				Description:  "Log level. One of 'emergency', 'alert', 'critical', 'error', 'warning', 'notice', 'info', 'debug'. Default 'info'",
+				ValidateFunc: validation.StringInSlice([]string{"emergency", "alert", "critical", "error", "warning", "notice", "info", "debug"}, false),
+			},
+			"global_psk": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Global pre-shared key which is used for sites with 'any' as peer IP",
+			},
+			"service_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of service certificate which is used for sites with 'x.509' authentication mode",
+			},
+			"ca_certificates": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of CA certificate IDs used to validate peer certificates",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"site": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IPsec VPN site definition. Sites are kept in the defined order",
+				Elem:        nsxvIpsecVpnSiteResource,
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvIpsecVpnCreate replaces IPsec VPN configuration of edge gateway with the one defined in schema
+func resourceVcdNsxvIpsecVpnCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V IPsec VPN creation initiated")
+
+	err := resourceVcdNsxvIpsecVpnUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ipsec vpn create] %s", err)
+	}
+
+	return resourceVcdNsxvIpsecVpnRead(d, meta)
+}
+
+// resourceVcdNsxvIpsecVpnUpdate is the same as create because complete configuration is always sent
+func resourceVcdNsxvIpsecVpnUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V IPsec VPN update initiated")
+
+	err := resourceVcdNsxvIpsecVpnUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ipsec vpn update] %s", err)
+	}
+
+	return resourceVcdNsxvIpsecVpnRead(d, meta)
+}
+
+func resourceVcdNsxvIpsecVpnUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipsecConfig, err := getNsxvIpsecVpnType(d)
+	if err != nil {
+		return err
+	}
+
+	err = updateNsxvIpsecVpnConfig(vcdClient, edgeGateway, ipsecConfig)
+	if err != nil {
+		return fmt.Errorf("unable to update IPsec VPN configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvIpsecVpnId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvIpsecVpnRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V IPsec VPN read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing IPsec VPN from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipsecConfig, err := getNsxvIpsecVpnConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ipsec vpn read] could not read IPsec VPN configuration: %s", err)
+	}
+
+	err = setNsxvIpsecVpnData(d, ipsecConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxv ipsec vpn read] %s", err)
+	}
+
+	d.SetId(getNsxvIpsecVpnId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvIpsecVpnDelete removes all IPsec VPN sites and resets IPsec VPN configuration
+func resourceVcdNsxvIpsecVpnDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V IPsec VPN deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvIpsecVpnConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ipsec vpn delete] could not reset IPsec VPN configuration: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvIpsecVpnImport imports IPsec VPN configuration. Because IPsec VPN is just a configuration of edge
+// gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvIpsecVpnImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvIpsecVpnId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvIpsecVpnType converts resource schema to *nsxvIpsecVpnConfig
+func getNsxvIpsecVpnType(d *schema.ResourceData) (*nsxvIpsecVpnConfig, error) {
+	ipsecConfig := &nsxvIpsecVpnConfig{
+		Enabled: d.Get("enabled").(bool),
+		Logging: &nsxvServiceLogging{
+			Enable:   d.Get("logging_enabled").(bool),
+			LogLevel: d.Get("log_level").(string),
+		},
+		Global: &nsxvIpsecVpnGlobal{
+			Psk:                d.Get("global_psk").(string),
+			ServiceCertificate: d.Get("service_certificate").(string),
+		},
+		Sites: &nsxvIpsecVpnSites{},
+	}
+
+	caCertificates := convertSchemaSetToSliceOfStrings(d.Get("ca_certificates").(*schema.Set))
+	if len(caCertificates) > 0 {
+		ipsecConfig.Global.CaCertificates = &nsxvIpsecCaCertificates{CaCertificate: caCertificates}
+	}
+
+	for _, siteItem := range d.Get("site").([]interface{}) {
+		site := siteItem.(map[string]interface{})
+		siteName := site["name"].(string)
+
+		authenticationMode := site["authentication_mode"].(string)
+		psk := site["psk"].(string)
+		if authenticationMode == "psk" && psk == "" {
+			return nil, fmt.Errorf("site '%s' must have 'psk' set when 'authentication_mode' is 'psk'", siteName)
+		}
+		if authenticationMode == "x.509" && ipsecConfig.Global.ServiceCertificate == "" {
+			return nil, fmt.Errorf("'service_certificate' must be set when site '%s' uses 'x.509' authentication mode", siteName)
+		}
+
+		ipsecConfig.Sites.Sites = append(ipsecConfig.Sites.Sites, &nsxvIpsecVpnSite{
+			Enabled:             site["enabled"].(bool),
+			Name:                siteName,
+			Description:         site["description"].(string),
+			LocalID:             site["local_id"].(string),
+			LocalIP:             site["local_ip"].(string),
+			PeerID:              site["peer_id"].(string),
+			PeerIP:              site["peer_ip"].(string),
+			EncryptionAlgorithm: site["encryption_algorithm"].(string),
+			EnablePfs:           site["pfs_enabled"].(bool),
+			DhGroup:             site["dh_group"].(string),
+			LocalSubnets:        nsxvSubnets{Subnets: convertSchemaSetToSliceOfStrings(site["local_subnets"].(*schema.Set))},
+			PeerSubnets:         nsxvSubnets{Subnets: convertSchemaSetToSliceOfStrings(site["peer_subnets"].(*schema.Set))},
+			Psk:                 psk,
+			AuthenticationMode:  authenticationMode,
+		})
+	}
+
+	return ipsecConfig, nil
+}
+
+// setNsxvIpsecVpnData sets IPsec VPN configuration into statefile
+func setNsxvIpsecVpnData(d *schema.ResourceData, ipsecConfig *nsxvIpsecVpnConfig) error {
+	_ = d.Set("enabled", ipsecConfig.Enabled)
+	if ipsecConfig.Logging != nil {
+		_ = d.Set("logging_enabled", ipsecConfig.Logging.Enable)
+		_ = d.Set("log_level", ipsecConfig.Logging.LogLevel)
+	}
+
+	var caCertificates []string
+	if ipsecConfig.Global != nil {
+		_ = d.Set("global_psk", ipsecConfig.Global.Psk)
+		_ = d.Set("service_certificate", ipsecConfig.Global.ServiceCertificate)
+		if ipsecConfig.Global.CaCertificates != nil {
+			caCertificates = ipsecConfig.Global.CaCertificates.CaCertificate
+		}
+	}
+	err := d.Set("ca_certificates", convertToTypeSet(caCertificates))
+	if err != nil {
+		return fmt.Errorf("error setting 'ca_certificates': %s", err)
+	}
+
+	var sites []interface{}
+	if ipsecConfig.Sites != nil {
+		for _, site := range ipsecConfig.Sites.Sites {
+			sites = append(sites, map[string]interface{}{
+				"name":                 site.Name,
+				"description":          site.Description,
+				"enabled":              site.Enabled,
+				"local_id":             site.LocalID,
+				"local_ip":             site.LocalIP,
+				"local_subnets":        convertToTypeSet(site.LocalSubnets.Subnets),
+				"peer_id":              site.PeerID,
+				"peer_ip":              site.PeerIP,
+				"peer_subnets":         convertToTypeSet(site.PeerSubnets.Subnets),
+				"encryption_algorithm": site.EncryptionAlgorithm,
+				"authentication_mode":  site.AuthenticationMode,
+				"psk":                  site.Psk,
+				"pfs_enabled":          site.EnablePfs,
+				"dh_group":             site.DhGroup,
+			})
+		}
+	}
+	err = d.Set("site", sites)
+	if err != nil {
+		return fmt.Errorf("error setting 'site': %s", err)
+	}
+
+	return nil
+}
+
+// getNsxvIpsecVpnId constructs a fake IPsec VPN configuration ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:ipsecVpn" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:ipsecVpn")
+func getNsxvIpsecVpnId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":ipsecVpn"
+}
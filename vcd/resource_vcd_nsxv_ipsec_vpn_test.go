@@ -0,0 +1,180 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVcdNsxvIpsecVpn(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":          testConfig.VCD.Org,
+		"Vdc":          testConfig.VCD.Vdc,
+		"EdgeGateway":  testConfig.Networking.EdgeGateway,
+		"LocalIp":      testConfig.Networking.Local.LocalIp,
+		"PeerIp":       testConfig.Networking.Peer.PeerIp,
+		"SharedSecret": testConfig.Networking.SharedSecret,
+		"Tags":         "gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvIpsecVpn, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvIpsecVpnUpdate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resourceName := "vcd_nsxv_ipsec_vpn.vpn"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvIpsecVpnEmpty(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:ipsecVpn$`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "site.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.name", "site-one"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.local_ip", params["LocalIp"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "site.0.peer_ip", params["PeerIp"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "site.0.encryption_algorithm", "aes256"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.dh_group", "dh14"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.authentication_mode", "psk"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.local_subnets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "site.0.local_subnets.*", "10.150.192.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.peer_subnets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "site.0.peer_subnets.*", "192.168.5.0/24"),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logging_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "debug"),
+					resource.TestCheckResourceAttr(resourceName, "site.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.name", "site-one"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.encryption_algorithm", "aes-gcm"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.dh_group", "dh5"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.pfs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "site.0.local_subnets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "site.1.name", "site-two"),
+					resource.TestCheckResourceAttr(resourceName, "site.1.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "site.1.peer_ip", "any"),
+				),
+			},
+			// Pre-shared keys are read back in plain text, therefore the plan must be empty after apply
+			resource.TestStep{
+				Config:             configText1,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.VCD.Org + ImportSeparator + testConfig.VCD.Vdc + ImportSeparator + testConfig.Networking.EdgeGateway,
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvIpsecVpnEmpty reads IPsec VPN configuration and ensures it has no sites left
+func testAccCheckVcdNsxvIpsecVpnEmpty() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		ipsecConfig, err := getNsxvIpsecVpnConfig(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read IPsec VPN configuration: %s", err)
+		}
+
+		if ipsecConfig.Sites != nil && len(ipsecConfig.Sites.Sites) > 0 {
+			return fmt.Errorf("IPsec VPN sites were not cleaned up")
+		}
+
+		return nil
+	}
+}
+
+const testAccVcdNsxvIpsecVpn = `
+resource "vcd_nsxv_ipsec_vpn" "vpn" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  site {
+    name          = "site-one"
+    description   = "first site"
+    local_id      = "{{.LocalIp}}"
+    local_ip      = "{{.LocalIp}}"
+    local_subnets = ["10.150.192.0/24"]
+    peer_id       = "{{.PeerIp}}"
+    peer_ip       = "{{.PeerIp}}"
+    peer_subnets  = ["192.168.5.0/24"]
+    psk           = "{{.SharedSecret}}"
+  }
+}
+`
+
+const testAccVcdNsxvIpsecVpnUpdate = `
+resource "vcd_nsxv_ipsec_vpn" "vpn" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  logging_enabled = true
+  log_level       = "debug"
+  global_psk      = "{{.SharedSecret}}"
+
+  site {
+    name                 = "site-one"
+    description          = "first site"
+    local_id             = "{{.LocalIp}}"
+    local_ip             = "{{.LocalIp}}"
+    local_subnets        = ["10.150.192.0/24", "10.150.193.0/24"]
+    peer_id              = "{{.PeerIp}}"
+    peer_ip              = "{{.PeerIp}}"
+    peer_subnets         = ["192.168.5.0/24"]
+    psk                  = "{{.SharedSecret}}"
+    encryption_algorithm = "aes-gcm"
+    dh_group             = "dh5"
+    pfs_enabled          = false
+  }
+
+  site {
+    name          = "site-two"
+    enabled       = false
+    local_id      = "{{.LocalIp}}"
+    local_ip      = "{{.LocalIp}}"
+    local_subnets = ["10.150.194.0/24"]
+    peer_id       = "remote-two"
+    peer_ip       = "any"
+    peer_subnets  = ["192.168.6.0/24"]
+    psk           = "{{.SharedSecret}}"
+  }
+}
+`
@@ -0,0 +1,556 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var l2VpnEncryptionAlgorithms = []string{"AES128-GCM-SHA256", "ECDHE-RSA-AES128-GCM-SHA256",
+	"ECDHE-RSA-AES256-GCM-SHA384", "AES256-SHA", "AES128-SHA", "DES-CBC3-SHA", "NULL-MD5"}
+
+func resourceVcdNsxvL2Vpn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvL2VpnCreate,
+		Read:   resourceVcdNsxvL2VpnRead,
+		Update: resourceVcdNsxvL2VpnUpdate,
+		Delete: resourceVcdNsxvL2VpnDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvL2VpnImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for which L2VPN is configured",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable L2VPN service. Default 'true'",
+			},
+			"logging_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable L2VPN logging. Default 'false'",
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "info",
+				ValidateFunc: validation.StringInSlice([]string{"emergency", "alert", "critical", "error", "warning", "notice", "info", "debug"}, false),
+				Description:  "Log level. One of 'emergency', 'alert', 'critical', 'error', 'warning', 'notice', 'info', 'debug'. Default 'info'",
+			},
+			"server": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"server", "client"},
+				Description:  "L2VPN server configuration. Edge gateway accepts sessions from peer sites",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"listener_ip": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+							Description:  "Edge gateway uplink IP address on which L2VPN server listens",
+						},
+						"listener_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      443,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port on which L2VPN server listens. Default '443'",
+						},
+						"encryption_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AES128-GCM-SHA256",
+							ValidateFunc: validation.StringInSlice(l2VpnEncryptionAlgorithms, false),
+							Description:  "Encryption algorithm. Default 'AES128-GCM-SHA256'",
+						},
+						"server_certificate_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of service certificate (vcd_nsxv_certificate) presented to peer sites. Self-signed certificate is used when not set",
+						},
+						"peer_site": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Peer sites which are allowed to connect",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the peer site",
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional description",
+									},
+									"user_id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "User ID which the peer site uses to authenticate",
+									},
+									"password": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Sensitive:   true,
+										Description: "Password which the peer site uses to authenticate",
+									},
+									"stretched_networks": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										MinItems:    1,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Names of org networks attached to edge gateway as sub-interfaces which are stretched to the peer site",
+									},
+									"egress_gateway_ips": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+										Description: "Local gateway IP addresses for egress optimization",
+									},
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Enable the peer site. Default 'true'",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"client": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"server", "client"},
+				Description:  "L2VPN client configuration. Edge gateway connects to a remote L2VPN server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address of L2VPN server",
+						},
+						"server_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      443,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port of L2VPN server. Default '443'",
+						},
+						"encryption_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AES128-GCM-SHA256",
+							ValidateFunc: validation.StringInSlice(l2VpnEncryptionAlgorithms, false),
+							Description:  "Encryption algorithm. Default 'AES128-GCM-SHA256'",
+						},
+						"ca_certificate_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of CA certificate (vcd_nsxv_certificate) used to verify server certificate",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User ID used to authenticate to the server",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password used to authenticate to the server",
+						},
+						"stretched_networks": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of org networks attached to edge gateway as sub-interfaces which are stretched to the server",
+						},
+						"egress_gateway_ips": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+							Description: "Local gateway IP addresses for egress optimization",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvL2VpnCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V L2VPN creation initiated")
+
+	err := resourceVcdNsxvL2VpnUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv l2vpn create] %s", err)
+	}
+
+	return resourceVcdNsxvL2VpnRead(d, meta)
+}
+
+func resourceVcdNsxvL2VpnUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V L2VPN update initiated")
+
+	err := resourceVcdNsxvL2VpnUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv l2vpn update] %s", err)
+	}
+
+	return resourceVcdNsxvL2VpnRead(d, meta)
+}
+
+// resourceVcdNsxvL2VpnUpdateConfig replaces the whole L2VPN configuration of edge gateway with the one defined in
+// schema. Passwords are always sent because the API does not return them
+func resourceVcdNsxvL2VpnUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	site, err := getNsxvL2VpnSiteType(d, edgeGateway)
+	if err != nil {
+		return err
+	}
+
+	l2Vpn := &nsxvL2Vpn{
+		Enabled: d.Get("enabled").(bool),
+		Logging: &nsxvServiceLogging{
+			Enable:   d.Get("logging_enabled").(bool),
+			LogLevel: d.Get("log_level").(string),
+		},
+		L2VpnSites: nsxvL2VpnSites{Sites: []*nsxvL2VpnSite{site}},
+	}
+
+	err = updateNsxvL2Vpn(vcdClient, edgeGateway, l2Vpn)
+	if err != nil {
+		return fmt.Errorf("unable to update L2VPN configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvL2VpnId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvL2VpnRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V L2VPN read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing L2VPN from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	l2Vpn, err := getNsxvL2Vpn(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv l2vpn read] could not read L2VPN configuration: %s", err)
+	}
+
+	_ = d.Set("enabled", l2Vpn.Enabled)
+	if l2Vpn.Logging != nil {
+		_ = d.Set("logging_enabled", l2Vpn.Logging.Enable)
+		_ = d.Set("log_level", l2Vpn.Logging.LogLevel)
+	}
+
+	err = setNsxvL2VpnSiteData(d, edgeGateway, l2Vpn.L2VpnSites.Sites)
+	if err != nil {
+		return fmt.Errorf("[nsxv l2vpn read] %s", err)
+	}
+
+	d.SetId(getNsxvL2VpnId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvL2VpnDelete removes L2VPN configuration which also disables the service
+func resourceVcdNsxvL2VpnDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V L2VPN deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvL2Vpn(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv l2vpn delete] could not remove L2VPN configuration: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvL2VpnImport imports L2VPN configuration. Because L2VPN configuration is just a part of edge gateway
+// and not a separate object - the ID actually does not represent any object. Passwords are not returned by the API
+// and must be set in configuration after import
+func resourceVcdNsxvL2VpnImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvL2VpnId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvL2VpnSiteType converts either 'server' or 'client' block to L2VPN site
+func getNsxvL2VpnSiteType(d *schema.ResourceData, edge *govcd.EdgeGateway) (*nsxvL2VpnSite, error) {
+	if serverList := d.Get("server").([]interface{}); len(serverList) > 0 {
+		serverMap := serverList[0].(map[string]interface{})
+		configuration := &nsxvL2VpnServerConfiguration{
+			ListenerIp:          serverMap["listener_ip"].(string),
+			ListenerPort:        serverMap["listener_port"].(int),
+			EncryptionAlgorithm: serverMap["encryption_algorithm"].(string),
+			ServerCertificate:   serverMap["server_certificate_id"].(string),
+			PeerSites:           &nsxvL2VpnPeerSites{},
+		}
+
+		for _, peerSite := range serverMap["peer_site"].([]interface{}) {
+			peerSiteMap := peerSite.(map[string]interface{})
+			vnicIndexes, err := getNsxvL2VpnVnicIndexes(edge, peerSiteMap["stretched_networks"].(*schema.Set))
+			if err != nil {
+				return nil, err
+			}
+
+			configuration.PeerSites.PeerSites = append(configuration.PeerSites.PeerSites, &nsxvL2VpnPeerSite{
+				Name:        peerSiteMap["name"].(string),
+				Description: peerSiteMap["description"].(string),
+				L2VpnUser: &nsxvL2VpnUser{
+					UserId:   peerSiteMap["user_id"].(string),
+					Password: peerSiteMap["password"].(string),
+				},
+				Vnics:              &nsxvL2VpnVnics{Indexes: vnicIndexes},
+				EgressOptimization: getNsxvL2VpnEgressGateways(peerSiteMap["egress_gateway_ips"].(*schema.Set)),
+				Enabled:            peerSiteMap["enabled"].(bool),
+			})
+		}
+
+		return &nsxvL2VpnSite{Server: &nsxvL2VpnServer{Configuration: configuration}}, nil
+	}
+
+	clientMap := d.Get("client").([]interface{})[0].(map[string]interface{})
+	vnicIndexes, err := getNsxvL2VpnVnicIndexes(edge, clientMap["stretched_networks"].(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	return &nsxvL2VpnSite{
+		Client: &nsxvL2VpnClient{
+			Configuration: &nsxvL2VpnClientConfiguration{
+				ServerAddress:       clientMap["server_address"].(string),
+				ServerPort:          clientMap["server_port"].(int),
+				Vnics:               vnicIndexes,
+				EncryptionAlgorithm: clientMap["encryption_algorithm"].(string),
+				CaCertificate:       clientMap["ca_certificate_id"].(string),
+				EgressOptimization:  getNsxvL2VpnEgressGateways(clientMap["egress_gateway_ips"].(*schema.Set)),
+			},
+			L2VpnUser: &nsxvL2VpnUser{
+				UserId:   clientMap["user_id"].(string),
+				Password: clientMap["password"].(string),
+			},
+		},
+	}, nil
+}
+
+// setNsxvL2VpnSiteData sets 'server' or 'client' block from L2VPN site. Passwords are taken from the current state
+// because the API does not return them
+func setNsxvL2VpnSiteData(d *schema.ResourceData, edge *govcd.EdgeGateway, sites []*nsxvL2VpnSite) error {
+	var serverList, clientList []interface{}
+	if len(sites) > 0 && sites[0].Server != nil && sites[0].Server.Configuration != nil {
+		configuration := sites[0].Server.Configuration
+
+		passwords := make(map[string]string)
+		if oldServerList := d.Get("server").([]interface{}); len(oldServerList) > 0 {
+			for _, peerSite := range oldServerList[0].(map[string]interface{})["peer_site"].([]interface{}) {
+				peerSiteMap := peerSite.(map[string]interface{})
+				passwords[peerSiteMap["user_id"].(string)] = peerSiteMap["password"].(string)
+			}
+		}
+
+		var peerSites []interface{}
+		if configuration.PeerSites != nil {
+			for _, peerSite := range configuration.PeerSites.PeerSites {
+				var userId string
+				if peerSite.L2VpnUser != nil {
+					userId = peerSite.L2VpnUser.UserId
+				}
+				var vnicIndexes []int
+				if peerSite.Vnics != nil {
+					vnicIndexes = peerSite.Vnics.Indexes
+				}
+				networks, err := getNsxvL2VpnStretchedNetworks(edge, vnicIndexes)
+				if err != nil {
+					return err
+				}
+
+				peerSites = append(peerSites, map[string]interface{}{
+					"name":               peerSite.Name,
+					"description":        peerSite.Description,
+					"user_id":            userId,
+					"password":           passwords[userId],
+					"stretched_networks": networks,
+					"egress_gateway_ips": getNsxvL2VpnEgressGatewayIps(peerSite.EgressOptimization),
+					"enabled":            peerSite.Enabled,
+				})
+			}
+		}
+
+		serverList = []interface{}{map[string]interface{}{
+			"listener_ip":           configuration.ListenerIp,
+			"listener_port":         configuration.ListenerPort,
+			"encryption_algorithm":  configuration.EncryptionAlgorithm,
+			"server_certificate_id": configuration.ServerCertificate,
+			"peer_site":             peerSites,
+		}}
+	}
+
+	if len(sites) > 0 && sites[0].Client != nil && sites[0].Client.Configuration != nil {
+		configuration := sites[0].Client.Configuration
+
+		var password string
+		if oldClientList := d.Get("client").([]interface{}); len(oldClientList) > 0 {
+			password = oldClientList[0].(map[string]interface{})["password"].(string)
+		}
+		var userId string
+		if sites[0].Client.L2VpnUser != nil {
+			userId = sites[0].Client.L2VpnUser.UserId
+		}
+		networks, err := getNsxvL2VpnStretchedNetworks(edge, configuration.Vnics)
+		if err != nil {
+			return err
+		}
+
+		clientList = []interface{}{map[string]interface{}{
+			"server_address":       configuration.ServerAddress,
+			"server_port":          configuration.ServerPort,
+			"encryption_algorithm": configuration.EncryptionAlgorithm,
+			"ca_certificate_id":    configuration.CaCertificate,
+			"user_id":              userId,
+			"password":             password,
+			"stretched_networks":   networks,
+			"egress_gateway_ips":   getNsxvL2VpnEgressGatewayIps(configuration.EgressOptimization),
+		}}
+	}
+
+	err := d.Set("server", serverList)
+	if err != nil {
+		return fmt.Errorf("could not set server: %s", err)
+	}
+	err = d.Set("client", clientList)
+	if err != nil {
+		return fmt.Errorf("could not set client: %s", err)
+	}
+
+	return nil
+}
+
+// getNsxvL2VpnVnicIndexes converts a set of stretched network names to edge gateway vNic indexes
+func getNsxvL2VpnVnicIndexes(edge *govcd.EdgeGateway, networks *schema.Set) ([]int, error) {
+	var vnicIndexes []int
+	for _, networkName := range convertSchemaSetToSliceOfStrings(networks) {
+		vnicIndex, err := getNsxvVnicIndexByNetworkName(edge, networkName)
+		if err != nil {
+			return nil, err
+		}
+		vnicIndexes = append(vnicIndexes, *vnicIndex)
+	}
+
+	return vnicIndexes, nil
+}
+
+// getNsxvL2VpnStretchedNetworks converts edge gateway vNic indexes to a set of network names
+func getNsxvL2VpnStretchedNetworks(edge *govcd.EdgeGateway, vnicIndexes []int) (*schema.Set, error) {
+	var networks []string
+	for index := range vnicIndexes {
+		networkName, err := getNsxvNetworkNameByVnicIndex(edge, &vnicIndexes[index])
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, networkName)
+	}
+
+	return schema.NewSet(schema.HashString, convertToTypeSet(networks)), nil
+}
+
+// getNsxvL2VpnEgressGateways converts a set of IP addresses to egress optimization. Nil is returned for an empty set
+func getNsxvL2VpnEgressGateways(ips *schema.Set) *nsxvL2VpnEgressGateways {
+	gatewayIps := convertSchemaSetToSliceOfStrings(ips)
+	if len(gatewayIps) == 0 {
+		return nil
+	}
+
+	return &nsxvL2VpnEgressGateways{GatewayIpAddresses: gatewayIps}
+}
+
+// getNsxvL2VpnEgressGatewayIps converts egress optimization to a set of IP addresses
+func getNsxvL2VpnEgressGatewayIps(egressGateways *nsxvL2VpnEgressGateways) *schema.Set {
+	var gatewayIps []string
+	if egressGateways != nil {
+		gatewayIps = egressGateways.GatewayIpAddresses
+	}
+
+	return schema.NewSet(schema.HashString, convertToTypeSet(gatewayIps))
+}
+
+// getNsxvL2VpnId constructs a fake L2VPN ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:l2Vpn" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:l2Vpn")
+func getNsxvL2VpnId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":l2Vpn"
+}
@@ -0,0 +1,150 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvL2Vpn configures edge gateway as L2VPN server stretching a sub-interface network and then switches it
+// to client mode
+func TestAccVcdNsxvL2Vpn(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"ExternalIp":  testConfig.Networking.ExternalIp,
+		"Name":        t.Name(),
+		"Tags":        "nsxv gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvL2VpnServer, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvL2VpnClient, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvL2VpnDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "client.#", "0"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "server.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "server.0.listener_ip", testConfig.Networking.ExternalIp),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "server.0.peer_site.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "server.0.peer_site.0.user_id", "l2vpn-user"),
+					resource.TestCheckTypeSetElemAttr("vcd_nsxv_l2vpn.vpn", "server.0.peer_site.0.stretched_networks.*", t.Name()),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "server.#", "0"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "client.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "client.0.server_address", "192.168.200.1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_l2vpn.vpn", "client.0.egress_gateway_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vcd_nsxv_l2vpn.vpn", "client.0.stretched_networks.*", t.Name()),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "vcd_nsxv_l2vpn.vpn",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdOrgVdcObject(testConfig, testConfig.Networking.EdgeGateway),
+				ImportStateVerifyIgnore: []string{"client.0.password"},
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvL2VpnDestroy checks that L2VPN configuration was removed from edge gateway
+func testAccCheckVcdNsxvL2VpnDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*VCDClient)
+	edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	l2Vpn, err := getNsxvL2Vpn(conn, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("could not read L2VPN configuration: %s", err)
+	}
+
+	if l2Vpn.Enabled || len(l2Vpn.L2VpnSites.Sites) > 0 {
+		return fmt.Errorf("L2VPN configuration was not removed")
+	}
+
+	return nil
+}
+
+const testAccVcdNsxvL2VpnNetwork = `
+resource "vcd_network_routed" "stretched" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  name           = "{{.Name}}"
+  interface_type = "subinterface"
+  gateway        = "10.10.60.1"
+  netmask        = "255.255.255.0"
+}
+`
+
+const testAccVcdNsxvL2VpnServer = testAccVcdNsxvL2VpnNetwork + `
+resource "vcd_nsxv_l2vpn" "vpn" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  server {
+    listener_ip = "{{.ExternalIp}}"
+
+    peer_site {
+      name               = "{{.Name}}"
+      user_id            = "l2vpn-user"
+      password           = "Secret-Passw0rd"
+      stretched_networks = [vcd_network_routed.stretched.name]
+    }
+  }
+}
+`
+
+const testAccVcdNsxvL2VpnClient = testAccVcdNsxvL2VpnNetwork + `
+resource "vcd_nsxv_l2vpn" "vpn" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  logging_enabled = true
+
+  client {
+    server_address     = "192.168.200.1"
+    user_id            = "l2vpn-user"
+    password           = "Secret-Passw0rd"
+    stretched_networks = [vcd_network_routed.stretched.name]
+    egress_gateway_ips = ["10.10.60.1"]
+  }
+}
+`
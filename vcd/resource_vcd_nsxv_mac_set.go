@@ -0,0 +1,226 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvMacSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvMacSetCreate,
+		Read:   resourceVcdNsxvMacSetRead,
+		Update: resourceVcdNsxvMacSetUpdate,
+		Delete: resourceVcdNsxvMacSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvMacSetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "MAC set name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MAC set description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allows visibility in underlying scopes (Default is true)",
+			},
+			"mac_addresses": {
+				Required:    true,
+				Type:        schema.TypeSet,
+				Description: "A set of MAC addresses in lower case (e.g. 00:50:56:01:02:03)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.All(validation.IsMACAddress, validateCase("lower")),
+				},
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvMacSetCreate creates a MAC set based on schema data
+func resourceVcdNsxvMacSetCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating MAC set with name %s", d.Get("name"))
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	createdMacSet, err := createNsxvMacSet(vcdClient, vdc, getNsxvMacSet(d))
+	if err != nil {
+		return fmt.Errorf("error creating new MAC set: %s", err)
+	}
+
+	log.Printf("[DEBUG] MAC set with name %s created. Id: %s", createdMacSet.Name, createdMacSet.ObjectId)
+	d.SetId(createdMacSet.ObjectId)
+	return resourceVcdNsxvMacSetRead(d, meta)
+}
+
+// resourceVcdNsxvMacSetUpdate updates a MAC set based on schema data
+func resourceVcdNsxvMacSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Updating MAC set with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	macSet := getNsxvMacSet(d)
+	macSet.ObjectId = d.Id()
+
+	err = updateNsxvMacSet(vcdClient, vdc, macSet)
+	if err != nil {
+		return fmt.Errorf("error updating MAC set with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updated MAC set with ID %s", d.Id())
+	return resourceVcdNsxvMacSetRead(d, meta)
+}
+
+func datasourceVcdNsxvMacSetRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvMacSetRead(d, meta, "datasource")
+}
+
+func resourceVcdNsxvMacSetRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvMacSetRead(d, meta, "resource")
+}
+
+// genericVcdNsxvMacSetRead reads all data and persists it on statefile.
+// When "origin" == "datasource" it will search for MAC set by name and use d.SetId
+// When "origin" != "datasource" it will search for MAC set by ID and do not perform d.SetId
+func genericVcdNsxvMacSetRead(d *schema.ResourceData, meta interface{}, origin string) error {
+	log.Printf("[DEBUG] Reading MAC set with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	var macSet *nsxvMacSet
+	if origin == "datasource" {
+		macSet, err = getNsxvMacSetByName(vcdClient, vdc, d.Get("name").(string))
+	} else {
+		macSet, err = getNsxvMacSetById(vcdClient, vdc, d.Id())
+	}
+
+	if govcd.ContainsNotFound(err) && origin == "resource" {
+		log.Printf("[INFO] unable to find MAC set with ID %s: %s. Removing from state", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to find MAC set: %s", err)
+	}
+
+	if origin == "resource" {
+		_ = d.Set("name", macSet.Name)
+	}
+	_ = d.Set("description", macSet.Description)
+	_ = d.Set("is_inheritance_allowed", macSet.InheritanceAllowed)
+
+	var macAddresses []string
+	if macSet.Value != "" {
+		macAddresses = strings.Split(macSet.Value, ",")
+	}
+	macAddressSet := schema.NewSet(schema.HashSchema(&schema.Schema{Type: schema.TypeString}), convertToTypeSet(macAddresses))
+	err = d.Set("mac_addresses", macAddressSet)
+	if err != nil {
+		return fmt.Errorf("could not set mac_addresses: %s", err)
+	}
+
+	if origin == "datasource" {
+		d.SetId(macSet.ObjectId)
+	}
+
+	log.Printf("[DEBUG] Read MAC set with ID %s", d.Id())
+	return nil
+}
+
+// resourceVcdNsxvMacSetDelete deletes MAC set based on its ID
+func resourceVcdNsxvMacSetDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Deleting MAC set with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	err = deleteNsxvMacSet(vcdClient, vdc, d.Id())
+	if err != nil {
+		return fmt.Errorf("error deleting MAC set with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleted MAC set with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvMacSetImport imports MAC set by name
+// Example import path (_the_id_string_): org.vdc.macset-name
+func resourceVcdNsxvMacSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.macset-name")
+	}
+	orgName, vdcName, macSetName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find org %s and VDC %s: %s", orgName, vdcName, err)
+	}
+
+	macSet, err := getNsxvMacSetByName(vcdClient, vdc, macSetName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find MAC set with name %s: %s", macSetName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(macSet.ObjectId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvMacSet converts terraform schema definition into *nsxvMacSet
+func getNsxvMacSet(d *schema.ResourceData) *nsxvMacSet {
+	macAddresses := convertSchemaSetToSliceOfStrings(d.Get("mac_addresses").(*schema.Set))
+
+	return &nsxvMacSet{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		InheritanceAllowed: takeBoolPointer(d.Get("is_inheritance_allowed").(bool)),
+		Value:              strings.Join(macAddresses, ","),
+	}
+}
@@ -0,0 +1,412 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxvOspfAreaResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"area_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "OSPF area ID in decimal format (e.g. '0' for backbone area)",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "normal",
+			Description:  "Area type. One of 'normal', 'nssa'. Default 'normal'",
+			ValidateFunc: validation.StringInSlice([]string{"normal", "nssa"}, false),
+		},
+		"authentication_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "none",
+			Description:  "Authentication type. One of 'none', 'password', 'md5'. Default 'none'",
+			ValidateFunc: validation.StringInSlice([]string{"none", "password", "md5"}, false),
+		},
+		"authentication_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password or MD5 key. Required when 'authentication_type' is not 'none'",
+		},
+	},
+}
+
+var nsxvOspfInterfaceResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"network_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of network attached to edge gateway interface which participates in OSPF",
+		},
+		"area_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "OSPF area ID which the interface belongs to",
+		},
+		"hello_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			Description:  "Interval between hello packets in seconds. Default '10'",
+			ValidateFunc: validation.IntBetween(1, 255),
+		},
+		"dead_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      40,
+			Description:  "Interval in seconds after which neighbour is declared down. Default '40'",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      128,
+			Description:  "Priority of the interface used in designated router election. Default '128'",
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"cost": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Cost of sending packets over the interface. Default '1'",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"mtu_ignore": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Ignores MTU mismatch between neighbours. Default 'false'",
+		},
+	},
+}
+
+func resourceVcdNsxvOspf() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvOspfCreate,
+		Read:   resourceVcdNsxvOspfRead,
+		Update: resourceVcdNsxvOspfUpdate,
+		Delete: resourceVcdNsxvOspfDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvOspfImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for OSPF configuration",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables OSPF. Default 'true'",
+			},
+			"graceful_restart": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables graceful restart. Default 'true'",
+			},
+			"default_originate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Advertises edge gateway as default gateway to OSPF peers. Default 'false'",
+			},
+			"area": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "OSPF area definition",
+				Elem:        nsxvOspfAreaResource,
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Mapping of edge gateway interface to OSPF area",
+				Elem:        nsxvOspfInterfaceResource,
+			},
+			"redistribution_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables route redistribution into OSPF. Default 'false'",
+			},
+			"redistribution_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Route redistribution rule. Rules are evaluated in the defined order",
+				Elem:        nsxvRedistributionRuleResource,
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvOspfCreate replaces OSPF configuration of edge gateway with the one defined in schema
+func resourceVcdNsxvOspfCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V OSPF creation initiated")
+
+	err := resourceVcdNsxvOspfUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ospf create] %s", err)
+	}
+
+	return resourceVcdNsxvOspfRead(d, meta)
+}
+
+// resourceVcdNsxvOspfUpdate is the same as create because complete configuration is always sent
+func resourceVcdNsxvOspfUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V OSPF update initiated")
+
+	err := resourceVcdNsxvOspfUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ospf update] %s", err)
+	}
+
+	return resourceVcdNsxvOspfRead(d, meta)
+}
+
+func resourceVcdNsxvOspfUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ospfConfig, err := getNsxvOspfType(d, edgeGateway)
+	if err != nil {
+		return err
+	}
+
+	err = updateNsxvOspf(vcdClient, edgeGateway, ospfConfig)
+	if err != nil {
+		return fmt.Errorf("unable to update OSPF configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvOspfId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvOspfRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V OSPF read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing OSPF from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ospfConfig, err := getNsxvOspf(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ospf read] could not read OSPF configuration: %s", err)
+	}
+
+	err = setNsxvOspfData(d, edgeGateway, ospfConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxv ospf read] %s", err)
+	}
+
+	d.SetId(getNsxvOspfId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvOspfDelete disables OSPF and removes its configuration
+func resourceVcdNsxvOspfDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V OSPF deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvOspf(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ospf delete] could not reset OSPF configuration: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvOspfImport imports OSPF configuration. Because OSPF is just a configuration of edge gateway and not a
+// separate object - the ID actually does not represent any object
+func resourceVcdNsxvOspfImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvOspfId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvOspfType converts resource schema to *nsxvOspf
+func getNsxvOspfType(d *schema.ResourceData, edge *govcd.EdgeGateway) (*nsxvOspf, error) {
+	ospfConfig := &nsxvOspf{
+		Enabled:          d.Get("enabled").(bool),
+		GracefulRestart:  d.Get("graceful_restart").(bool),
+		DefaultOriginate: d.Get("default_originate").(bool),
+		OspfAreas:        &nsxvOspfAreas{},
+		OspfInterfaces:   &nsxvOspfInterfaces{},
+		Redistribution:   getNsxvRedistributionType(d),
+	}
+
+	for _, areaItem := range d.Get("area").([]interface{}) {
+		area := areaItem.(map[string]interface{})
+		areaId := area["area_id"].(string)
+
+		authenticationType := area["authentication_type"].(string)
+		authenticationKey := area["authentication_key"].(string)
+		if authenticationType != "none" && authenticationKey == "" {
+			return nil, fmt.Errorf("area '%s' must have 'authentication_key' set when 'authentication_type' is '%s'",
+				areaId, authenticationType)
+		}
+
+		ospfConfig.OspfAreas.Areas = append(ospfConfig.OspfAreas.Areas, &nsxvOspfArea{
+			AreaId: areaId,
+			Type:   area["type"].(string),
+			Authentication: &nsxvOspfAuthentication{
+				Type:  authenticationType,
+				Value: authenticationKey,
+			},
+		})
+	}
+
+	for _, interfaceItem := range d.Get("interface").([]interface{}) {
+		ospfInterface := interfaceItem.(map[string]interface{})
+
+		vNicIndex, err := getNsxvVnicIndexByNetworkName(edge, ospfInterface["network_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		ospfConfig.OspfInterfaces.Interfaces = append(ospfConfig.OspfInterfaces.Interfaces, &nsxvOspfInterface{
+			Vnic:          *vNicIndex,
+			AreaId:        ospfInterface["area_id"].(string),
+			HelloInterval: ospfInterface["hello_interval"].(int),
+			DeadInterval:  ospfInterface["dead_interval"].(int),
+			Priority:      ospfInterface["priority"].(int),
+			Cost:          ospfInterface["cost"].(int),
+			MtuIgnore:     ospfInterface["mtu_ignore"].(bool),
+		})
+	}
+
+	return ospfConfig, nil
+}
+
+// setNsxvOspfData sets OSPF configuration into statefile. Authentication keys are not returned by the API therefore
+// they are retained from the state of the area with the same ID
+func setNsxvOspfData(d *schema.ResourceData, edge *govcd.EdgeGateway, ospfConfig *nsxvOspf) error {
+	_ = d.Set("enabled", ospfConfig.Enabled)
+	_ = d.Set("graceful_restart", ospfConfig.GracefulRestart)
+	_ = d.Set("default_originate", ospfConfig.DefaultOriginate)
+
+	authenticationKeys := make(map[string]string)
+	for _, areaItem := range d.Get("area").([]interface{}) {
+		area := areaItem.(map[string]interface{})
+		authenticationKeys[area["area_id"].(string)] = area["authentication_key"].(string)
+	}
+
+	var areas []interface{}
+	if ospfConfig.OspfAreas != nil {
+		for _, area := range ospfConfig.OspfAreas.Areas {
+			authenticationType := "none"
+			authenticationKey := ""
+			if area.Authentication != nil && area.Authentication.Type != "" {
+				authenticationType = area.Authentication.Type
+				authenticationKey = authenticationKeys[area.AreaId]
+			}
+
+			areas = append(areas, map[string]interface{}{
+				"area_id":             area.AreaId,
+				"type":                area.Type,
+				"authentication_type": authenticationType,
+				"authentication_key":  authenticationKey,
+			})
+		}
+	}
+	err := d.Set("area", areas)
+	if err != nil {
+		return fmt.Errorf("error setting 'area': %s", err)
+	}
+
+	var interfaces []interface{}
+	if ospfConfig.OspfInterfaces != nil {
+		for _, ospfInterface := range ospfConfig.OspfInterfaces.Interfaces {
+			networkName, err := getNsxvNetworkNameByVnicIndex(edge, &ospfInterface.Vnic)
+			if err != nil {
+				return err
+			}
+
+			interfaces = append(interfaces, map[string]interface{}{
+				"network_name":   networkName,
+				"area_id":        ospfInterface.AreaId,
+				"hello_interval": ospfInterface.HelloInterval,
+				"dead_interval":  ospfInterface.DeadInterval,
+				"priority":       ospfInterface.Priority,
+				"cost":           ospfInterface.Cost,
+				"mtu_ignore":     ospfInterface.MtuIgnore,
+			})
+		}
+	}
+	err = d.Set("interface", interfaces)
+	if err != nil {
+		return fmt.Errorf("error setting 'interface': %s", err)
+	}
+
+	return setNsxvRedistributionData(d, ospfConfig.Redistribution)
+}
+
+// getNsxvOspfId constructs a fake OSPF configuration ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:ospf" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:ospf")
+func getNsxvOspfId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":ospf"
+}
@@ -0,0 +1,219 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvRoutingGlobal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvRoutingGlobalCreate,
+		Read:   resourceVcdNsxvRoutingGlobalRead,
+		Update: resourceVcdNsxvRoutingGlobalUpdate,
+		Delete: resourceVcdNsxvRoutingGlobalDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvRoutingGlobalImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for global routing configuration",
+			},
+			"router_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Router ID in IPv4 address format. Required for OSPF and BGP",
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"ecmp_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables Equal Cost Multi-Path routing. Default 'false'",
+			},
+			"logging_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables dynamic routing logging. Default 'false'",
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "info",
+				Description:  "Log level. One of 'emergency', 'alert', 'critical', 'error', 'warning', 'notice', 'info', 'debug'. Default 'info'",
+				ValidateFunc: validation.StringInSlice([]string{"emergency", "alert", "critical", "error", "warning", "notice", "info", "debug"}, false),
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvRoutingGlobalCreate sets global routing configuration of edge gateway
+func resourceVcdNsxvRoutingGlobalCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V global routing configuration creation initiated")
+
+	err := resourceVcdNsxvRoutingGlobalUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv routing global create] %s", err)
+	}
+
+	return resourceVcdNsxvRoutingGlobalRead(d, meta)
+}
+
+// resourceVcdNsxvRoutingGlobalUpdate is the same as create because the configuration always exists
+func resourceVcdNsxvRoutingGlobalUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V global routing configuration update initiated")
+
+	err := resourceVcdNsxvRoutingGlobalUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv routing global update] %s", err)
+	}
+
+	return resourceVcdNsxvRoutingGlobalRead(d, meta)
+}
+
+// resourceVcdNsxvRoutingGlobalUpdateConfig retrieves current global routing configuration and changes only the
+// fields managed by this resource so that IP prefixes are retained
+func resourceVcdNsxvRoutingGlobalUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	globalConfig, err := getNsxvRoutingGlobalConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve global routing configuration: %s", err)
+	}
+
+	globalConfig.RouterId = d.Get("router_id").(string)
+	globalConfig.Ecmp = d.Get("ecmp_enabled").(bool)
+	globalConfig.Logging = &nsxvServiceLogging{
+		Enable:   d.Get("logging_enabled").(bool),
+		LogLevel: d.Get("log_level").(string),
+	}
+
+	err = updateNsxvRoutingGlobalConfig(vcdClient, edgeGateway, globalConfig)
+	if err != nil {
+		return fmt.Errorf("unable to update global routing configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvRoutingGlobalId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvRoutingGlobalRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V global routing configuration read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing global routing configuration from tfstate",
+			d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	globalConfig, err := getNsxvRoutingGlobalConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv routing global read] could not read global routing configuration: %s", err)
+	}
+
+	_ = d.Set("router_id", globalConfig.RouterId)
+	_ = d.Set("ecmp_enabled", globalConfig.Ecmp)
+	if globalConfig.Logging != nil {
+		_ = d.Set("logging_enabled", globalConfig.Logging.Enable)
+		_ = d.Set("log_level", globalConfig.Logging.LogLevel)
+	}
+
+	d.SetId(getNsxvRoutingGlobalId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvRoutingGlobalDelete disables ECMP and logging. Router ID is left intact because NSX-V does not allow
+// to remove it and OSPF or BGP might still be configured outside of Terraform
+func resourceVcdNsxvRoutingGlobalDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V global routing configuration deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	globalConfig, err := getNsxvRoutingGlobalConfig(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv routing global delete] could not read global routing configuration: %s", err)
+	}
+
+	globalConfig.Ecmp = false
+	globalConfig.Logging = &nsxvServiceLogging{Enable: false, LogLevel: "info"}
+
+	err = updateNsxvRoutingGlobalConfig(vcdClient, edgeGateway, globalConfig)
+	if err != nil {
+		return fmt.Errorf("[nsxv routing global delete] could not reset global routing configuration: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvRoutingGlobalImport imports global routing configuration. Because it is just a configuration of
+// edge gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvRoutingGlobalImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvRoutingGlobalId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvRoutingGlobalId constructs a fake global routing configuration ID which is needed for Terraform. The ID is
+// in format "edgeGateway.ID:routingGlobal" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:routingGlobal")
+func getNsxvRoutingGlobalId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":routingGlobal"
+}
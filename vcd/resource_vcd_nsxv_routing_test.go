@@ -0,0 +1,334 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvRouting tests global routing configuration, static routing, OSPF and BGP of NSX-V edge gateway
+func TestAccVcdNsxvRouting(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"Tags":        "gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvRouting, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvRoutingUpdate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	importStateId := testConfig.VCD.Org + ImportSeparator + testConfig.VCD.Vdc + ImportSeparator + testConfig.Networking.EdgeGateway
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvRoutingEmpty(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("vcd_nsxv_routing_global.global", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:routingGlobal$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_routing_global.global", "router_id", "10.202.0.1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_routing_global.global", "ecmp_enabled", "false"),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_static_routing.static", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:staticRouting$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.0.network", "192.168.100.0/24"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.0.next_hop", "10.202.0.10"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.0.network_name", "routing-test"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.0.admin_distance", "1"),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_ospf.ospf", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:ospf$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "area.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "area.0.area_id", "10"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "area.0.type", "normal"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "interface.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "interface.0.network_name", "routing-test"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "interface.0.hello_interval", "10"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "redistribution_enabled", "false"),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_bgp.bgp", "id", regexp.MustCompile(`^urn:vcloud:gateway:.*:bgp$`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "local_as", "65000"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.0.ip_address", "10.202.0.20"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.0.remote_as", "65001"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.0.weight", "60"),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_routing_global.global", "ecmp_enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_routing_global.global", "logging_enabled", "true"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.#", "2"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.1.network", "192.168.101.0/24"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.1.admin_distance", "5"),
+					resource.TestCheckResourceAttr("vcd_nsxv_static_routing.static", "static_route.1.description", "second route"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "area.0.type", "nssa"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "area.0.authentication_type", "md5"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "interface.0.cost", "10"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "redistribution_enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "redistribution_rule.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "redistribution_rule.0.action", "permit"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ospf.ospf", "redistribution_rule.0.from_static", "true"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.#", "2"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.1.ip_address", "10.202.0.30"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "neighbor.1.keep_alive_timer", "30"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "redistribution_rule.#", "2"),
+					resource.TestCheckResourceAttr("vcd_nsxv_bgp.bgp", "redistribution_rule.1.action", "deny"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_routing_global.global",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     importStateId,
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_static_routing.static",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     importStateId,
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_ospf.ospf",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     importStateId,
+				// Authentication keys are not returned by the API
+				ImportStateVerifyIgnore: []string{"area.0.authentication_key"},
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_bgp.bgp",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     importStateId,
+				// Neighbor passwords are not returned by the API
+				ImportStateVerifyIgnore: []string{"neighbor.0.password", "neighbor.1.password"},
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvRoutingEmpty ensures that static routes are removed and dynamic routing is disabled
+func testAccCheckVcdNsxvRoutingEmpty() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+		edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+		if err != nil {
+			return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+		}
+
+		staticRouting, err := getNsxvStaticRouting(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read static routing configuration: %s", err)
+		}
+		if staticRouting.StaticRoutes != nil && len(staticRouting.StaticRoutes.Routes) > 0 {
+			return fmt.Errorf("static routes were not cleaned up")
+		}
+
+		ospfConfig, err := getNsxvOspf(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read OSPF configuration: %s", err)
+		}
+		if ospfConfig.Enabled {
+			return fmt.Errorf("OSPF is still enabled")
+		}
+
+		bgpConfig, err := getNsxvBgp(conn, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("could not read BGP configuration: %s", err)
+		}
+		if bgpConfig.Enabled {
+			return fmt.Errorf("BGP is still enabled")
+		}
+
+		return nil
+	}
+}
+
+const testAccVcdNsxvRoutingNetwork = `
+resource "vcd_network_routed" "routing" {
+  name         = "routing-test"
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+  gateway      = "10.202.0.1"
+  netmask      = "255.255.255.0"
+}
+`
+
+const testAccVcdNsxvRouting = testAccVcdNsxvRoutingNetwork + `
+resource "vcd_nsxv_routing_global" "global" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  router_id = "10.202.0.1"
+}
+
+resource "vcd_nsxv_static_routing" "static" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  static_route {
+    network      = "192.168.100.0/24"
+    next_hop     = "10.202.0.10"
+    network_name = vcd_network_routed.routing.name
+  }
+}
+
+resource "vcd_nsxv_ospf" "ospf" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  area {
+    area_id = "10"
+  }
+
+  interface {
+    network_name = vcd_network_routed.routing.name
+    area_id      = "10"
+  }
+
+  depends_on = [vcd_nsxv_routing_global.global]
+}
+
+resource "vcd_nsxv_bgp" "bgp" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  local_as = "65000"
+
+  neighbor {
+    ip_address = "10.202.0.20"
+    remote_as  = "65001"
+  }
+
+  depends_on = [vcd_nsxv_routing_global.global]
+}
+`
+
+const testAccVcdNsxvRoutingUpdate = testAccVcdNsxvRoutingNetwork + `
+resource "vcd_nsxv_routing_global" "global" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  router_id       = "10.202.0.1"
+  ecmp_enabled    = true
+  logging_enabled = true
+}
+
+resource "vcd_nsxv_static_routing" "static" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  static_route {
+    network      = "192.168.100.0/24"
+    next_hop     = "10.202.0.10"
+    network_name = vcd_network_routed.routing.name
+  }
+
+  static_route {
+    network        = "192.168.101.0/24"
+    next_hop       = "10.202.0.11"
+    network_name   = vcd_network_routed.routing.name
+    admin_distance = 5
+    description    = "second route"
+  }
+}
+
+resource "vcd_nsxv_ospf" "ospf" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  area {
+    area_id             = "10"
+    type                = "nssa"
+    authentication_type = "md5"
+    authentication_key  = "secret"
+  }
+
+  interface {
+    network_name = vcd_network_routed.routing.name
+    area_id      = "10"
+    cost         = 10
+  }
+
+  redistribution_enabled = true
+  redistribution_rule {
+    action         = "permit"
+    from_static    = true
+    from_connected = true
+  }
+
+  depends_on = [vcd_nsxv_routing_global.global]
+}
+
+resource "vcd_nsxv_bgp" "bgp" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  local_as = "65000"
+
+  neighbor {
+    ip_address = "10.202.0.20"
+    remote_as  = "65001"
+    password   = "secret"
+  }
+
+  neighbor {
+    ip_address       = "10.202.0.30"
+    remote_as        = "65002"
+    keep_alive_timer = 30
+    hold_down_timer  = 90
+  }
+
+  redistribution_enabled = true
+  redistribution_rule {
+    action      = "permit"
+    from_ospf   = true
+    from_static = true
+  }
+
+  redistribution_rule {
+    action         = "deny"
+    from_connected = true
+  }
+
+  depends_on = [vcd_nsxv_routing_global.global]
+}
+`
@@ -0,0 +1,233 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvServiceCreate,
+		Read:   resourceVcdNsxvServiceRead,
+		Update: resourceVcdNsxvServiceUpdate,
+		Delete: resourceVcdNsxvServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allows visibility in underlying scopes (Default is true)",
+			},
+			"protocol": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"tcp", "udp", "icmp"}, true),
+				DiffSuppressFunc: suppressCase,
+				Description:      "Protocol - one of 'tcp', 'udp', 'icmp'",
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Destination port, port range or comma separated list of them for 'tcp' and 'udp' " +
+					"(e.g. '80,443,8000-8080'). ICMP type (e.g. 'echo-request') for 'icmp'",
+			},
+			"source_port": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Source port, port range or comma separated list of them for 'tcp' and 'udp'",
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvServiceCreate creates a custom service based on schema data
+func resourceVcdNsxvServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating service with name %s", d.Get("name"))
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	createdService, err := createNsxvApplication(vcdClient, vdc, getNsxvApplication(d))
+	if err != nil {
+		return fmt.Errorf("error creating new service: %s", err)
+	}
+
+	log.Printf("[DEBUG] Service with name %s created. Id: %s", createdService.Name, createdService.ObjectId)
+	d.SetId(createdService.ObjectId)
+	return resourceVcdNsxvServiceRead(d, meta)
+}
+
+// resourceVcdNsxvServiceUpdate updates a custom service based on schema data
+func resourceVcdNsxvServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Updating service with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	service := getNsxvApplication(d)
+	service.ObjectId = d.Id()
+
+	err = updateNsxvApplication(vcdClient, vdc, service)
+	if err != nil {
+		return fmt.Errorf("error updating service with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updated service with ID %s", d.Id())
+	return resourceVcdNsxvServiceRead(d, meta)
+}
+
+func datasourceVcdNsxvServiceRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvServiceRead(d, meta, "datasource")
+}
+
+func resourceVcdNsxvServiceRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvServiceRead(d, meta, "resource")
+}
+
+// genericVcdNsxvServiceRead reads all data and persists it on statefile.
+// When "origin" == "datasource" it will search for service by name and use d.SetId
+// When "origin" != "datasource" it will search for service by ID and do not perform d.SetId
+func genericVcdNsxvServiceRead(d *schema.ResourceData, meta interface{}, origin string) error {
+	log.Printf("[DEBUG] Reading service with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	var service *nsxvApplication
+	if origin == "datasource" {
+		service, err = getNsxvApplicationByName(vcdClient, vdc, d.Get("name").(string))
+	} else {
+		service, err = getNsxvApplicationById(vcdClient, vdc, d.Id())
+	}
+
+	if govcd.ContainsNotFound(err) && origin == "resource" {
+		log.Printf("[INFO] unable to find service with ID %s: %s. Removing from state", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to find service: %s", err)
+	}
+
+	if origin == "resource" {
+		_ = d.Set("name", service.Name)
+	}
+	_ = d.Set("description", service.Description)
+	_ = d.Set("is_inheritance_allowed", service.InheritanceAllowed)
+
+	if service.Element != nil {
+		_ = d.Set("protocol", strings.ToLower(service.Element.ApplicationProtocol))
+		_ = d.Set("port", service.Element.Value)
+		_ = d.Set("source_port", service.Element.SourcePort)
+	}
+
+	if origin == "datasource" {
+		d.SetId(service.ObjectId)
+	}
+
+	log.Printf("[DEBUG] Read service with ID %s", d.Id())
+	return nil
+}
+
+// resourceVcdNsxvServiceDelete deletes custom service based on its ID
+func resourceVcdNsxvServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Deleting service with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	err = deleteNsxvApplication(vcdClient, vdc, d.Id())
+	if err != nil {
+		return fmt.Errorf("error deleting service with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleted service with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvServiceImport imports custom service by name
+// Example import path (_the_id_string_): org.vdc.service-name
+func resourceVcdNsxvServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.service-name")
+	}
+	orgName, vdcName, serviceName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find org %s and VDC %s: %s", orgName, vdcName, err)
+	}
+
+	service, err := getNsxvApplicationByName(vcdClient, vdc, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find service with name %s: %s", serviceName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(service.ObjectId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvApplication converts terraform schema definition into *nsxvApplication
+func getNsxvApplication(d *schema.ResourceData) *nsxvApplication {
+	return &nsxvApplication{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		InheritanceAllowed: takeBoolPointer(d.Get("is_inheritance_allowed").(bool)),
+		Element: &nsxvApplicationElement{
+			ApplicationProtocol: strings.ToUpper(d.Get("protocol").(string)),
+			Value:               d.Get("port").(string),
+			SourcePort:          d.Get("source_port").(string),
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvServiceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvServiceGroupCreate,
+		Read:   resourceVcdNsxvServiceGroupRead,
+		Update: resourceVcdNsxvServiceGroupUpdate,
+		Delete: resourceVcdNsxvServiceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvServiceGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service group description",
+			},
+			"is_inheritance_allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allows visibility in underlying scopes (Default is true)",
+			},
+			"member_ids": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "A set of service and service group IDs which are members of this group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvServiceGroupCreate creates a service group based on schema data
+func resourceVcdNsxvServiceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating service group with name %s", d.Get("name"))
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	createdServiceGroup, err := createNsxvApplicationGroup(vcdClient, vdc, getNsxvApplicationGroup(d))
+	// The group itself may be created even if adding members failed. Its ID must be stored to avoid orphan objects
+	if createdServiceGroup != nil {
+		d.SetId(createdServiceGroup.ObjectId)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating new service group: %s", err)
+	}
+
+	log.Printf("[DEBUG] Service group with name %s created. Id: %s", createdServiceGroup.Name, createdServiceGroup.ObjectId)
+	return resourceVcdNsxvServiceGroupRead(d, meta)
+}
+
+// resourceVcdNsxvServiceGroupUpdate updates a service group and its members based on schema data
+func resourceVcdNsxvServiceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Updating service group with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	serviceGroup := getNsxvApplicationGroup(d)
+	serviceGroup.ObjectId = d.Id()
+
+	err = updateNsxvApplicationGroup(vcdClient, vdc, serviceGroup)
+	if err != nil {
+		return fmt.Errorf("error updating service group with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updated service group with ID %s", d.Id())
+	return resourceVcdNsxvServiceGroupRead(d, meta)
+}
+
+func datasourceVcdNsxvServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvServiceGroupRead(d, meta, "datasource")
+}
+
+func resourceVcdNsxvServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return genericVcdNsxvServiceGroupRead(d, meta, "resource")
+}
+
+// genericVcdNsxvServiceGroupRead reads all data and persists it on statefile.
+// When "origin" == "datasource" it will search for service group by name and use d.SetId
+// When "origin" != "datasource" it will search for service group by ID and do not perform d.SetId
+func genericVcdNsxvServiceGroupRead(d *schema.ResourceData, meta interface{}, origin string) error {
+	log.Printf("[DEBUG] Reading service group with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	var serviceGroup *nsxvApplicationGroup
+	if origin == "datasource" {
+		serviceGroup, err = getNsxvApplicationGroupByName(vcdClient, vdc, d.Get("name").(string))
+	} else {
+		serviceGroup, err = getNsxvApplicationGroupById(vcdClient, vdc, d.Id())
+	}
+
+	if govcd.ContainsNotFound(err) && origin == "resource" {
+		log.Printf("[INFO] unable to find service group with ID %s: %s. Removing from state", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to find service group: %s", err)
+	}
+
+	if origin == "resource" {
+		_ = d.Set("name", serviceGroup.Name)
+	}
+	_ = d.Set("description", serviceGroup.Description)
+	_ = d.Set("is_inheritance_allowed", serviceGroup.InheritanceAllowed)
+
+	memberIds := make([]string, len(serviceGroup.Members))
+	for index, member := range serviceGroup.Members {
+		memberIds[index] = member.ObjectId
+	}
+	memberIdSet := schema.NewSet(schema.HashSchema(&schema.Schema{Type: schema.TypeString}), convertToTypeSet(memberIds))
+	err = d.Set("member_ids", memberIdSet)
+	if err != nil {
+		return fmt.Errorf("could not set member_ids: %s", err)
+	}
+
+	if origin == "datasource" {
+		d.SetId(serviceGroup.ObjectId)
+	}
+
+	log.Printf("[DEBUG] Read service group with ID %s", d.Id())
+	return nil
+}
+
+// resourceVcdNsxvServiceGroupDelete deletes service group based on its ID. Member services are not removed
+func resourceVcdNsxvServiceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Deleting service group with ID %s", d.Id())
+	vcdClient := meta.(*VCDClient)
+
+	_, vdc, err := vcdClient.GetOrgAndVdcFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrgAndVdc, err)
+	}
+
+	err = deleteNsxvApplicationGroup(vcdClient, vdc, d.Id())
+	if err != nil {
+		return fmt.Errorf("error deleting service group with ID %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleted service group with ID %s", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvServiceGroupImport imports service group by name
+// Example import path (_the_id_string_): org.vdc.service-group-name
+func resourceVcdNsxvServiceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.service-group-name")
+	}
+	orgName, vdcName, serviceGroupName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	_, vdc, err := vcdClient.GetOrgAndVdc(orgName, vdcName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find org %s and VDC %s: %s", orgName, vdcName, err)
+	}
+
+	serviceGroup, err := getNsxvApplicationGroupByName(vcdClient, vdc, serviceGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find service group with name %s: %s", serviceGroupName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	d.SetId(serviceGroup.ObjectId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvApplicationGroup converts terraform schema definition into *nsxvApplicationGroup
+func getNsxvApplicationGroup(d *schema.ResourceData) *nsxvApplicationGroup {
+	serviceGroup := &nsxvApplicationGroup{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		InheritanceAllowed: takeBoolPointer(d.Get("is_inheritance_allowed").(bool)),
+	}
+
+	for _, memberId := range convertSchemaSetToSliceOfStrings(d.Get("member_ids").(*schema.Set)) {
+		serviceGroup.Members = append(serviceGroup.Members, &nsxvGroupingMember{ObjectId: memberId})
+	}
+
+	return serviceGroup
+}
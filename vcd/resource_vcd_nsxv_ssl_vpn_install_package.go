@@ -0,0 +1,319 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvSslVpnInstallPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvSslVpnInstallPackageCreate,
+		Read:   resourceVcdNsxvSslVpnInstallPackageRead,
+		Update: resourceVcdNsxvSslVpnInstallPackageUpdate,
+		Delete: resourceVcdNsxvSslVpnInstallPackageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvSslVpnInstallPackageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which the SSL VPN-Plus install package is located",
+			},
+			"profile_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the installation package",
+			},
+			"gateway": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "SSL VPN-Plus gateways to which the client connects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host name or IP address of the gateway",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      443,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port of the gateway. Default '443'",
+						},
+					},
+				},
+			},
+			"start_client_on_logon": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Start client on logon. Default 'false'",
+			},
+			"hide_systray_icon": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide SSL client system tray icon. Default 'false'",
+			},
+			"remember_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow client to remember password. Default 'false'",
+			},
+			"silent_mode_operation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Run client in silent mode. Default 'false'",
+			},
+			"silent_mode_installation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Install client in silent mode. Default 'false'",
+			},
+			"hide_network_adaptor": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide SSL client network adapter. Default 'false'",
+			},
+			"create_desktop_icon": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create client desktop icon. Default 'false'",
+			},
+			"enforce_server_certificate_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enforce server security certificate validation. Default 'false'",
+			},
+			"create_linux_client": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create Linux client installer. Default 'false'",
+			},
+			"create_mac_client": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create Mac client installer. Default 'false'",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional description",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the installation package. Default 'true'",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvSslVpnInstallPackageCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus install package creation initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	installPackageId, err := createNsxvSslVpnInstallPackage(vcdClient, edgeGateway, getNsxvSslVpnInstallPackageType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn install package create] unable to create SSL VPN-Plus install package: %s", err)
+	}
+
+	d.SetId(installPackageId)
+
+	return resourceVcdNsxvSslVpnInstallPackageRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnInstallPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus install package update initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	installPackage := getNsxvSslVpnInstallPackageType(d)
+	installPackage.ObjectId = d.Id()
+	err = updateNsxvSslVpnInstallPackage(vcdClient, edgeGateway, d.Id(), installPackage)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn install package update] unable to update SSL VPN-Plus install package with ID %s: %s", d.Id(), err)
+	}
+
+	return resourceVcdNsxvSslVpnInstallPackageRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnInstallPackageRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus install package read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing SSL VPN-Plus install package from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	installPackage, err := getNsxvSslVpnInstallPackageById(vcdClient, edgeGateway, d.Id())
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] SSL VPN-Plus install package %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn install package read] unable to read SSL VPN-Plus install package with ID %s: %s", d.Id(), err)
+	}
+
+	_ = d.Set("profile_name", installPackage.ProfileName)
+	_ = d.Set("start_client_on_logon", installPackage.StartClientOnLogon)
+	_ = d.Set("hide_systray_icon", installPackage.HideSystrayIcon)
+	_ = d.Set("remember_password", installPackage.RememberPassword)
+	_ = d.Set("silent_mode_operation", installPackage.SilentModeOperation)
+	_ = d.Set("silent_mode_installation", installPackage.SilentModeInstallation)
+	_ = d.Set("hide_network_adaptor", installPackage.HideNetworkAdaptor)
+	_ = d.Set("create_desktop_icon", installPackage.CreateDesktopIcon)
+	_ = d.Set("enforce_server_certificate_validation", installPackage.EnforceServerSecurityCertValidation)
+	_ = d.Set("create_linux_client", installPackage.CreateLinuxClient)
+	_ = d.Set("create_mac_client", installPackage.CreateMacClient)
+	_ = d.Set("description", installPackage.Description)
+	_ = d.Set("enabled", installPackage.Enabled)
+
+	var gateways []interface{}
+	if installPackage.GatewayList != nil {
+		for _, gateway := range installPackage.GatewayList.Gateways {
+			gateways = append(gateways, map[string]interface{}{
+				"hostname": gateway.Hostname,
+				"port":     gateway.Port,
+			})
+		}
+	}
+	err = d.Set("gateway", gateways)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn install package read] could not set gateway: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxvSslVpnInstallPackageDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus install package deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvSslVpnInstallPackage(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn install package delete] error deleting SSL VPN-Plus install package with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvSslVpnInstallPackageImport imports SSL VPN-Plus install package by its ID which can be found in edge
+// gateway SSL VPN-Plus configuration in the NSX-V API (e.g. "clientinstallpackage-1")
+func resourceVcdNsxvSslVpnInstallPackageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.install-package-id")
+	}
+	orgName, vdcName, edgeName, installPackageId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	installPackage, err := getNsxvSslVpnInstallPackageById(vcdClient, edgeGateway, installPackageId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find SSL VPN-Plus install package with ID %s: %s", installPackageId, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(installPackage.ObjectId)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getNsxvSslVpnInstallPackageType(d *schema.ResourceData) *nsxvSslVpnInstallPackage {
+	installPackage := &nsxvSslVpnInstallPackage{
+		ProfileName:                         d.Get("profile_name").(string),
+		GatewayList:                         &nsxvSslVpnGatewayList{},
+		StartClientOnLogon:                  d.Get("start_client_on_logon").(bool),
+		HideSystrayIcon:                     d.Get("hide_systray_icon").(bool),
+		RememberPassword:                    d.Get("remember_password").(bool),
+		SilentModeOperation:                 d.Get("silent_mode_operation").(bool),
+		SilentModeInstallation:              d.Get("silent_mode_installation").(bool),
+		HideNetworkAdaptor:                  d.Get("hide_network_adaptor").(bool),
+		CreateDesktopIcon:                   d.Get("create_desktop_icon").(bool),
+		EnforceServerSecurityCertValidation: d.Get("enforce_server_certificate_validation").(bool),
+		CreateLinuxClient:                   d.Get("create_linux_client").(bool),
+		CreateMacClient:                     d.Get("create_mac_client").(bool),
+		Description:                         d.Get("description").(string),
+		Enabled:                             d.Get("enabled").(bool),
+	}
+
+	for _, gateway := range d.Get("gateway").([]interface{}) {
+		gatewayMap := gateway.(map[string]interface{})
+		installPackage.GatewayList.Gateways = append(installPackage.GatewayList.Gateways, &nsxvSslVpnGateway{
+			Hostname: gatewayMap["hostname"].(string),
+			Port:     gatewayMap["port"].(int),
+		})
+	}
+
+	return installPackage
+}
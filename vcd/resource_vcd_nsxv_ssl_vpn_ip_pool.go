@@ -0,0 +1,239 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvSslVpnIpPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvSslVpnIpPoolCreate,
+		Read:   resourceVcdNsxvSslVpnIpPoolRead,
+		Update: resourceVcdNsxvSslVpnIpPoolUpdate,
+		Delete: resourceVcdNsxvSslVpnIpPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvSslVpnIpPoolImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which the SSL VPN-Plus IP pool is located",
+			},
+			"ip_range": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IP range assigned to clients (e.g. '10.10.50.10-10.10.50.100')",
+			},
+			"netmask": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Subnet mask of the IP range",
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Gateway IP address which is added to clients as their virtual adapter address",
+			},
+			"primary_dns": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Primary DNS server for clients",
+			},
+			"secondary_dns": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Secondary DNS server for clients",
+			},
+			"dns_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS suffix for clients",
+			},
+			"wins_server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "WINS server for clients",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional description",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the IP pool. Default 'true'",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvSslVpnIpPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus IP pool creation initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipPoolId, err := createNsxvSslVpnIpPool(vcdClient, edgeGateway, getNsxvSslVpnIpPoolType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn ip pool create] unable to create SSL VPN-Plus IP pool: %s", err)
+	}
+
+	d.SetId(ipPoolId)
+
+	return resourceVcdNsxvSslVpnIpPoolRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnIpPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus IP pool update initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipPool := getNsxvSslVpnIpPoolType(d)
+	ipPool.ObjectId = d.Id()
+	err = updateNsxvSslVpnIpPool(vcdClient, edgeGateway, d.Id(), ipPool)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn ip pool update] unable to update SSL VPN-Plus IP pool with ID %s: %s", d.Id(), err)
+	}
+
+	return resourceVcdNsxvSslVpnIpPoolRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnIpPoolRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus IP pool read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing SSL VPN-Plus IP pool from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipPool, err := getNsxvSslVpnIpPoolById(vcdClient, edgeGateway, d.Id())
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] SSL VPN-Plus IP pool %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn ip pool read] unable to read SSL VPN-Plus IP pool with ID %s: %s", d.Id(), err)
+	}
+
+	_ = d.Set("ip_range", ipPool.IpRange)
+	_ = d.Set("netmask", ipPool.Netmask)
+	_ = d.Set("gateway", ipPool.Gateway)
+	_ = d.Set("primary_dns", ipPool.PrimaryDns)
+	_ = d.Set("secondary_dns", ipPool.SecondaryDns)
+	_ = d.Set("dns_suffix", ipPool.DnsSuffix)
+	_ = d.Set("wins_server", ipPool.WinsServer)
+	_ = d.Set("description", ipPool.Description)
+	_ = d.Set("enabled", ipPool.Enabled)
+
+	return nil
+}
+
+func resourceVcdNsxvSslVpnIpPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus IP pool deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvSslVpnIpPool(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn ip pool delete] error deleting SSL VPN-Plus IP pool with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvSslVpnIpPoolImport imports SSL VPN-Plus IP pool by its ID which can be found in edge gateway SSL
+// VPN-Plus configuration in the NSX-V API (e.g. "ippool-1")
+func resourceVcdNsxvSslVpnIpPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.ip-pool-id")
+	}
+	orgName, vdcName, edgeName, ipPoolId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	ipPool, err := getNsxvSslVpnIpPoolById(vcdClient, edgeGateway, ipPoolId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find SSL VPN-Plus IP pool with ID %s: %s", ipPoolId, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(ipPool.ObjectId)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getNsxvSslVpnIpPoolType(d *schema.ResourceData) *nsxvSslVpnIpPool {
+	return &nsxvSslVpnIpPool{
+		IpRange:      d.Get("ip_range").(string),
+		Netmask:      d.Get("netmask").(string),
+		Gateway:      d.Get("gateway").(string),
+		PrimaryDns:   d.Get("primary_dns").(string),
+		SecondaryDns: d.Get("secondary_dns").(string),
+		DnsSuffix:    d.Get("dns_suffix").(string),
+		WinsServer:   d.Get("wins_server").(string),
+		Description:  d.Get("description").(string),
+		Enabled:      d.Get("enabled").(bool),
+	}
+}
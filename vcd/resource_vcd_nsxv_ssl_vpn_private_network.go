@@ -0,0 +1,226 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvSslVpnPrivateNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvSslVpnPrivateNetworkCreate,
+		Read:   resourceVcdNsxvSslVpnPrivateNetworkRead,
+		Update: resourceVcdNsxvSslVpnPrivateNetworkUpdate,
+		Delete: resourceVcdNsxvSslVpnPrivateNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvSslVpnPrivateNetworkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which the SSL VPN-Plus private network is located",
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Network in CIDR format which clients can access (e.g. '192.168.1.0/24')",
+			},
+			"send_over_tunnel": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Send traffic over SSL VPN-Plus tunnel. Otherwise traffic bypasses the edge gateway. Default 'true'",
+			},
+			"ports": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Ports or port ranges for which traffic is sent over the tunnel (e.g. '20-40,443'). All ports when not set",
+			},
+			"enable_tcp_optimization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Optimize TCP traffic sent over the tunnel. Default 'true'",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional description",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the private network. Default 'true'",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvSslVpnPrivateNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus private network creation initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	privateNetworkId, err := createNsxvSslVpnPrivateNetwork(vcdClient, edgeGateway, getNsxvSslVpnPrivateNetworkType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn private network create] unable to create SSL VPN-Plus private network: %s", err)
+	}
+
+	d.SetId(privateNetworkId)
+
+	return resourceVcdNsxvSslVpnPrivateNetworkRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnPrivateNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus private network update initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	privateNetwork := getNsxvSslVpnPrivateNetworkType(d)
+	privateNetwork.ObjectId = d.Id()
+	err = updateNsxvSslVpnPrivateNetwork(vcdClient, edgeGateway, d.Id(), privateNetwork)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn private network update] unable to update SSL VPN-Plus private network with ID %s: %s", d.Id(), err)
+	}
+
+	return resourceVcdNsxvSslVpnPrivateNetworkRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnPrivateNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus private network read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing SSL VPN-Plus private network from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	privateNetwork, err := getNsxvSslVpnPrivateNetworkById(vcdClient, edgeGateway, d.Id())
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] SSL VPN-Plus private network %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn private network read] unable to read SSL VPN-Plus private network with ID %s: %s", d.Id(), err)
+	}
+
+	_ = d.Set("network", privateNetwork.Network)
+	_ = d.Set("send_over_tunnel", privateNetwork.SendOverTunnel != nil)
+	if privateNetwork.SendOverTunnel != nil {
+		_ = d.Set("ports", privateNetwork.SendOverTunnel.Ports)
+		_ = d.Set("enable_tcp_optimization", privateNetwork.SendOverTunnel.Optimize)
+	}
+	_ = d.Set("description", privateNetwork.Description)
+	_ = d.Set("enabled", privateNetwork.Enabled)
+
+	return nil
+}
+
+func resourceVcdNsxvSslVpnPrivateNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus private network deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvSslVpnPrivateNetwork(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn private network delete] error deleting SSL VPN-Plus private network with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvSslVpnPrivateNetworkImport imports SSL VPN-Plus private network by its ID which can be found in edge
+// gateway SSL VPN-Plus configuration in the NSX-V API (e.g. "privatenetwork-1")
+func resourceVcdNsxvSslVpnPrivateNetworkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.private-network-id")
+	}
+	orgName, vdcName, edgeName, privateNetworkId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	privateNetwork, err := getNsxvSslVpnPrivateNetworkById(vcdClient, edgeGateway, privateNetworkId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find SSL VPN-Plus private network with ID %s: %s", privateNetworkId, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(privateNetwork.ObjectId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvSslVpnPrivateNetworkType converts schema to private network. Ports and TCP optimization are only sent when
+// traffic goes over the tunnel
+func getNsxvSslVpnPrivateNetworkType(d *schema.ResourceData) *nsxvSslVpnPrivateNetwork {
+	privateNetwork := &nsxvSslVpnPrivateNetwork{
+		Network:     d.Get("network").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+
+	if d.Get("send_over_tunnel").(bool) {
+		privateNetwork.SendOverTunnel = &nsxvSslVpnSendOverTunnel{
+			Ports:    d.Get("ports").(string),
+			Optimize: d.Get("enable_tcp_optimization").(bool),
+		}
+	}
+
+	return privateNetwork
+}
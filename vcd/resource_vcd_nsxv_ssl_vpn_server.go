@@ -0,0 +1,245 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvSslVpnServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvSslVpnServerCreate,
+		Read:   resourceVcdNsxvSslVpnServerRead,
+		Update: resourceVcdNsxvSslVpnServerUpdate,
+		Delete: resourceVcdNsxvSslVpnServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvSslVpnServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for which SSL VPN-Plus server is configured",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable SSL VPN-Plus service. Default 'true'",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+				Description: "Edge gateway IP addresses on which SSL VPN-Plus server listens",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      443,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port on which SSL VPN-Plus server listens. Default '443'",
+			},
+			"server_certificate_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of service certificate (vcd_nsxv_certificate) presented to clients. Self-signed certificate is used when not set",
+			},
+			"ciphers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Encryption ciphers allowed by SSL VPN-Plus server (e.g. 'AES128-SHA', 'AES256-SHA')",
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvSslVpnServerCreate configures SSL VPN-Plus server of edge gateway
+func resourceVcdNsxvSslVpnServerCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus server creation initiated")
+
+	err := resourceVcdNsxvSslVpnServerUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server create] %s", err)
+	}
+
+	return resourceVcdNsxvSslVpnServerRead(d, meta)
+}
+
+// resourceVcdNsxvSslVpnServerUpdate is the same as create because SSL VPN-Plus server settings always exist
+func resourceVcdNsxvSslVpnServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus server update initiated")
+
+	err := resourceVcdNsxvSslVpnServerUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server update] %s", err)
+	}
+
+	return resourceVcdNsxvSslVpnServerRead(d, meta)
+}
+
+// resourceVcdNsxvSslVpnServerUpdateConfig sends server settings and then toggles SSL VPN-Plus service state
+func resourceVcdNsxvSslVpnServerUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	serverSettings := &nsxvSslVpnServerSettings{
+		ServerAddresses: &nsxvIpAddresses{
+			IpAddresses: convertSchemaSetToSliceOfStrings(d.Get("ip_addresses").(*schema.Set)),
+		},
+		Port:              d.Get("port").(int),
+		ServerCertificate: d.Get("server_certificate_id").(string),
+	}
+
+	ciphers := convertSchemaSetToSliceOfStrings(d.Get("ciphers").(*schema.Set))
+	if len(ciphers) > 0 {
+		serverSettings.CipherList = &nsxvSslVpnCipherList{Ciphers: ciphers}
+	}
+
+	err = updateNsxvSslVpnServerSettings(vcdClient, edgeGateway, serverSettings)
+	if err != nil {
+		return fmt.Errorf("unable to update SSL VPN-Plus server settings for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	err = enableNsxvSslVpn(vcdClient, edgeGateway, d.Get("enabled").(bool))
+	if err != nil {
+		return fmt.Errorf("unable to change SSL VPN-Plus service state for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvSslVpnServerId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvSslVpnServerRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus server read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing SSL VPN-Plus server from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	enabled, err := getNsxvSslVpnEnabled(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server read] could not read SSL VPN-Plus service state: %s", err)
+	}
+
+	serverSettings, err := getNsxvSslVpnServerSettings(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server read] could not read SSL VPN-Plus server settings: %s", err)
+	}
+
+	_ = d.Set("enabled", enabled)
+	_ = d.Set("port", serverSettings.Port)
+	_ = d.Set("server_certificate_id", serverSettings.ServerCertificate)
+
+	var ipAddresses []string
+	if serverSettings.ServerAddresses != nil {
+		ipAddresses = serverSettings.ServerAddresses.IpAddresses
+	}
+	err = d.Set("ip_addresses", convertToTypeSet(ipAddresses))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server read] could not set ip_addresses: %s", err)
+	}
+
+	var ciphers []string
+	if serverSettings.CipherList != nil {
+		ciphers = serverSettings.CipherList.Ciphers
+	}
+	err = d.Set("ciphers", convertToTypeSet(ciphers))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server read] could not set ciphers: %s", err)
+	}
+
+	d.SetId(getNsxvSslVpnServerId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvSslVpnServerDelete disables SSL VPN-Plus service. Server settings cannot be removed and are left
+// as they are
+func resourceVcdNsxvSslVpnServerDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus server deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = enableNsxvSslVpn(vcdClient, edgeGateway, false)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn server delete] could not disable SSL VPN-Plus service: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvSslVpnServerImport imports SSL VPN-Plus server settings. Because server settings are just a
+// configuration of edge gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvSslVpnServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvSslVpnServerId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvSslVpnServerId constructs a fake SSL VPN-Plus server ID which is needed for Terraform. The ID is in format
+// "edgeGateway.ID:sslVpnServer" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:sslVpnServer")
+func getNsxvSslVpnServerId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":sslVpnServer"
+}
@@ -0,0 +1,271 @@
+// +build gateway ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccVcdNsxvSslVpn tests SSL VPN-Plus server settings together with IP pool, private network, local user and
+// client install package
+func TestAccVcdNsxvSslVpn(t *testing.T) {
+
+	// String map to fill the template
+	var params = StringMap{
+		"Org":         testConfig.VCD.Org,
+		"Vdc":         testConfig.VCD.Vdc,
+		"EdgeGateway": testConfig.Networking.EdgeGateway,
+		"ExternalIp":  testConfig.Networking.ExternalIp,
+		"Name":        t.Name(),
+		"Tags":        "nsxv gateway",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdNsxvSslVpn, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 0: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcdNsxvSslVpnUpdate, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	if !edgeGatewayIsAdvanced() {
+		t.Skip(t.Name() + "requires advanced edge gateway to work")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVcdNsxvSslVpnDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_server.server", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_server.server", "port", "443"),
+					resource.TestCheckTypeSetElemAttr("vcd_nsxv_ssl_vpn_server.server", "ip_addresses.*", testConfig.Networking.ExternalIp),
+
+					resource.TestMatchResourceAttr("vcd_nsxv_ssl_vpn_ip_pool.pool", "id", regexp.MustCompile(`.+`)),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_ip_pool.pool", "ip_range", "10.10.50.10-10.10.50.100"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_ip_pool.pool", "primary_dns", "8.8.8.8"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_private_network.network", "network", "192.168.50.0/24"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_private_network.network", "send_over_tunnel", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_private_network.network", "ports", "443"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_user.user", "user_name", "sslvpn-user"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_user.user", "enabled", "true"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_user.user", "allow_change_password", "true"),
+
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "profile_name", t.Name()),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "gateway.#", "1"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "gateway.0.hostname", testConfig.Networking.ExternalIp),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "create_linux_client", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_server.server", "enabled", "false"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_server.server", "port", "8443"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_ip_pool.pool", "ip_range", "10.10.50.10-10.10.50.200"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_ip_pool.pool", "enabled", "false"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_private_network.network", "send_over_tunnel", "false"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_user.user", "enabled", "false"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_user.user", "allow_change_password", "false"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "gateway.#", "2"),
+					resource.TestCheckResourceAttr("vcd_nsxv_ssl_vpn_install_package.package", "create_linux_client", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_ssl_vpn_server.server",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgVdcObject(testConfig, testConfig.Networking.EdgeGateway),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_ssl_vpn_ip_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_ssl_vpn_ip_pool.pool"),
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_ssl_vpn_private_network.network",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_ssl_vpn_private_network.network"),
+			},
+			resource.TestStep{
+				ResourceName:            "vcd_nsxv_ssl_vpn_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_ssl_vpn_user.user"),
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			resource.TestStep{
+				ResourceName:      "vcd_nsxv_ssl_vpn_install_package.package",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdNsxvEdgeGatewayObject(testConfig, testConfig.Networking.EdgeGateway, "vcd_nsxv_ssl_vpn_install_package.package"),
+			},
+		},
+	})
+}
+
+// testAccCheckVcdNsxvSslVpnDestroy checks that SSL VPN-Plus service is disabled after the server resource is removed
+func testAccCheckVcdNsxvSslVpnDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*VCDClient)
+	edgeGateway, err := conn.GetEdgeGateway(testConfig.VCD.Org, testConfig.VCD.Vdc, testConfig.Networking.EdgeGateway)
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	enabled, err := getNsxvSslVpnEnabled(conn, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("could not read SSL VPN-Plus service state: %s", err)
+	}
+	if enabled {
+		return fmt.Errorf("SSL VPN-Plus service is still enabled")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vcd_nsxv_ssl_vpn_user" {
+			continue
+		}
+		_, err := getNsxvSslVpnUserById(conn, edgeGateway, rs.Primary.ID)
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("SSL VPN-Plus user %s was not removed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+const testAccVcdNsxvSslVpn = `
+resource "vcd_nsxv_ssl_vpn_server" "server" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  ip_addresses = ["{{.ExternalIp}}"]
+}
+
+resource "vcd_nsxv_ssl_vpn_ip_pool" "pool" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  ip_range    = "10.10.50.10-10.10.50.100"
+  netmask     = "255.255.255.0"
+  gateway     = "10.10.50.1"
+  primary_dns = "8.8.8.8"
+  description = "{{.Name}}"
+}
+
+resource "vcd_nsxv_ssl_vpn_private_network" "network" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  network = "192.168.50.0/24"
+  ports   = "443"
+}
+
+resource "vcd_nsxv_ssl_vpn_user" "user" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  user_name  = "sslvpn-user"
+  password   = "Secret-Passw0rd"
+  first_name = "First"
+  last_name  = "Last"
+}
+
+resource "vcd_nsxv_ssl_vpn_install_package" "package" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  profile_name = "{{.Name}}"
+
+  gateway {
+    hostname = "{{.ExternalIp}}"
+  }
+
+  create_linux_client = true
+}
+`
+
+const testAccVcdNsxvSslVpnUpdate = `
+resource "vcd_nsxv_ssl_vpn_server" "server" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  enabled      = false
+  ip_addresses = ["{{.ExternalIp}}"]
+  port         = 8443
+}
+
+resource "vcd_nsxv_ssl_vpn_ip_pool" "pool" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  ip_range    = "10.10.50.10-10.10.50.200"
+  netmask     = "255.255.255.0"
+  gateway     = "10.10.50.1"
+  primary_dns = "8.8.8.8"
+  description = "{{.Name}}"
+  enabled     = false
+}
+
+resource "vcd_nsxv_ssl_vpn_private_network" "network" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  network          = "192.168.50.0/24"
+  send_over_tunnel = false
+}
+
+resource "vcd_nsxv_ssl_vpn_user" "user" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  user_name             = "sslvpn-user"
+  password              = "Secret-Passw0rd"
+  enabled               = false
+  allow_change_password = false
+}
+
+resource "vcd_nsxv_ssl_vpn_install_package" "package" {
+  org          = "{{.Org}}"
+  vdc          = "{{.Vdc}}"
+  edge_gateway = "{{.EdgeGateway}}"
+
+  profile_name = "{{.Name}}"
+
+  gateway {
+    hostname = "{{.ExternalIp}}"
+  }
+
+  gateway {
+    hostname = "vpn.example.com"
+    port     = 8443
+  }
+}
+`
@@ -0,0 +1,248 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdNsxvSslVpnUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvSslVpnUserCreate,
+		Read:   resourceVcdNsxvSslVpnUserRead,
+		Update: resourceVcdNsxvSslVpnUserUpdate,
+		Delete: resourceVcdNsxvSslVpnUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvSslVpnUserImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name in which the SSL VPN-Plus user is located",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User name used to log in",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "User password",
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "First name of the user",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Last name of the user",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional description",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable the user account. Default 'true'",
+			},
+			"password_never_expires": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Password never expires. Default 'false'",
+			},
+			"allow_change_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Allow the user to change password. Default 'true'",
+			},
+			"change_password_on_next_login": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Require the user to change password on next login. Requires 'allow_change_password'. Default 'false'",
+			},
+		},
+	}
+}
+
+func resourceVcdNsxvSslVpnUserCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus user creation initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	userId, err := createNsxvSslVpnUser(vcdClient, edgeGateway, getNsxvSslVpnUserType(d))
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn user create] unable to create SSL VPN-Plus user: %s", err)
+	}
+
+	d.SetId(userId)
+
+	return resourceVcdNsxvSslVpnUserRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus user update initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	user := getNsxvSslVpnUserType(d)
+	user.ObjectId = d.Id()
+	err = updateNsxvSslVpnUser(vcdClient, edgeGateway, d.Id(), user)
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn user update] unable to update SSL VPN-Plus user with ID %s: %s", d.Id(), err)
+	}
+
+	return resourceVcdNsxvSslVpnUserRead(d, meta)
+}
+
+func resourceVcdNsxvSslVpnUserRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus user read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing SSL VPN-Plus user from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	user, err := getNsxvSslVpnUserById(vcdClient, edgeGateway, d.Id())
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] SSL VPN-Plus user %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn user read] unable to read SSL VPN-Plus user with ID %s: %s", d.Id(), err)
+	}
+
+	_ = d.Set("user_name", user.UserId)
+	_ = d.Set("first_name", user.FirstName)
+	_ = d.Set("last_name", user.LastName)
+	_ = d.Set("description", user.Description)
+	_ = d.Set("enabled", !user.DisableUserAccount)
+	_ = d.Set("password_never_expires", user.PasswordNeverExpires)
+	_ = d.Set("allow_change_password", user.AllowChangePassword != nil)
+	if user.AllowChangePassword != nil {
+		_ = d.Set("change_password_on_next_login", user.AllowChangePassword.ChangePasswordOnNextLogin)
+	} else {
+		_ = d.Set("change_password_on_next_login", false)
+	}
+
+	return nil
+}
+
+func resourceVcdNsxvSslVpnUserDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V SSL VPN-Plus user deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	err = deleteNsxvSslVpnUser(vcdClient, edgeGateway, d.Id())
+	if err != nil {
+		return fmt.Errorf("[nsxv ssl vpn user delete] error deleting SSL VPN-Plus user with ID %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceVcdNsxvSslVpnUserImport imports SSL VPN-Plus user by its ID which can be found in edge gateway SSL VPN-Plus
+// configuration in the NSX-V API (e.g. "user-1")
+func resourceVcdNsxvSslVpnUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 4 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name.user-id")
+	}
+	orgName, vdcName, edgeName, userId := resourceURI[0], resourceURI[1], resourceURI[2], resourceURI[3]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	user, err := getNsxvSslVpnUserById(vcdClient, edgeGateway, userId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find SSL VPN-Plus user with ID %s: %s", userId, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(user.ObjectId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvSslVpnUserType converts schema to SSL VPN-Plus user. Password is always sent because the API does not
+// return it
+func getNsxvSslVpnUserType(d *schema.ResourceData) *nsxvSslVpnUser {
+	user := &nsxvSslVpnUser{
+		UserId:               d.Get("user_name").(string),
+		Password:             d.Get("password").(string),
+		FirstName:            d.Get("first_name").(string),
+		LastName:             d.Get("last_name").(string),
+		Description:          d.Get("description").(string),
+		DisableUserAccount:   !d.Get("enabled").(bool),
+		PasswordNeverExpires: d.Get("password_never_expires").(bool),
+	}
+
+	if d.Get("allow_change_password").(bool) {
+		user.AllowChangePassword = &nsxvSslVpnAllowChangePassword{
+			ChangePasswordOnNextLogin: d.Get("change_password_on_next_login").(bool),
+		}
+	}
+
+	return user
+}
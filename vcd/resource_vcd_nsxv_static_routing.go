@@ -0,0 +1,371 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+var nsxvStaticRouteResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"network": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Destination network in CIDR format",
+			ValidateFunc: validation.IsCIDR,
+		},
+		"next_hop": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "IP address of next hop",
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"network_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of network attached to edge gateway interface through which next hop is reachable",
+		},
+		"mtu": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "MTU for the route. Defaults to MTU of the interface",
+		},
+		"admin_distance": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			Description:  "Administrative distance of the route. Default '1'",
+			ValidateFunc: validation.IntBetween(1, 255),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Description of the route",
+		},
+	},
+}
+
+func resourceVcdNsxvStaticRouting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdNsxvStaticRoutingCreate,
+		Read:   resourceVcdNsxvStaticRoutingRead,
+		Update: resourceVcdNsxvStaticRoutingUpdate,
+		Delete: resourceVcdNsxvStaticRoutingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdNsxvStaticRoutingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"vdc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of VDC to use, optional if defined at provider level",
+			},
+			"edge_gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge gateway name for static routing configuration",
+			},
+			"static_route": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Static route definition",
+				Elem:        nsxvStaticRouteResource,
+			},
+			"default_gateway": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Default gateway of edge gateway. Current default gateway is kept when not set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "IP address of default gateway",
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"network_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of network attached to edge gateway interface through which default gateway is reachable",
+						},
+						"mtu": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "MTU for the default route. Defaults to MTU of the interface",
+						},
+						"admin_distance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Administrative distance of the default route. Default '1'",
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the default route",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceVcdNsxvStaticRoutingCreate replaces static routes of edge gateway with the ones defined in schema
+func resourceVcdNsxvStaticRoutingCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V static routing creation initiated")
+
+	err := resourceVcdNsxvStaticRoutingUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing create] %s", err)
+	}
+
+	return resourceVcdNsxvStaticRoutingRead(d, meta)
+}
+
+// resourceVcdNsxvStaticRoutingUpdate is the same as create because complete configuration is always sent
+func resourceVcdNsxvStaticRoutingUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[TRACE] NSX-V static routing update initiated")
+
+	err := resourceVcdNsxvStaticRoutingUpdateConfig(d, meta)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing update] %s", err)
+	}
+
+	return resourceVcdNsxvStaticRoutingRead(d, meta)
+}
+
+func resourceVcdNsxvStaticRoutingUpdateConfig(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	staticRouting, err := getNsxvStaticRoutingType(d, edgeGateway)
+	if err != nil {
+		return err
+	}
+
+	// Current default gateway must be sent back when it is not managed by this resource, otherwise it is removed
+	if staticRouting.DefaultRoute == nil {
+		currentStaticRouting, err := getNsxvStaticRouting(vcdClient, edgeGateway)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve static routing configuration: %s", err)
+		}
+		staticRouting.DefaultRoute = currentStaticRouting.DefaultRoute
+	}
+
+	err = updateNsxvStaticRouting(vcdClient, edgeGateway, staticRouting)
+	if err != nil {
+		return fmt.Errorf("unable to update static routing configuration for edge gateway %s: %s",
+			edgeGateway.EdgeGateway.Name, err)
+	}
+
+	d.SetId(getNsxvStaticRoutingId(edgeGateway))
+
+	return nil
+}
+
+func resourceVcdNsxvStaticRoutingRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V static routing read initiated")
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] edge gateway %s no longer exists. Removing static routing from tfstate", d.Get("edge_gateway").(string))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	staticRouting, err := getNsxvStaticRouting(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing read] could not read static routing configuration: %s", err)
+	}
+
+	err = setNsxvStaticRoutingData(d, edgeGateway, staticRouting)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing read] %s", err)
+	}
+
+	d.SetId(getNsxvStaticRoutingId(edgeGateway))
+
+	return nil
+}
+
+// resourceVcdNsxvStaticRoutingDelete removes all static routes. The default gateway is left intact because it is also
+// a part of edge gateway uplink configuration and removing it would cut edge gateway external connectivity
+func resourceVcdNsxvStaticRoutingDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] NSX-V static routing deletion initiated")
+
+	vcdClient.lockParentEdgeGtw(d)
+	defer vcdClient.unLockParentEdgeGtw(d)
+
+	edgeGateway, err := vcdClient.GetEdgeGatewayFromResource(d, "edge_gateway")
+	if err != nil {
+		return fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	staticRouting, err := getNsxvStaticRouting(vcdClient, edgeGateway)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing delete] could not read static routing configuration: %s", err)
+	}
+
+	staticRouting.StaticRoutes = &nsxvStaticRoutes{}
+	err = updateNsxvStaticRouting(vcdClient, edgeGateway, staticRouting)
+	if err != nil {
+		return fmt.Errorf("[nsxv static routing delete] could not remove static routes: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVcdNsxvStaticRoutingImport imports static routing configuration. Because static routing is just a
+// configuration of edge gateway and not a separate object - the ID actually does not represent any object
+func resourceVcdNsxvStaticRoutingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified in such way org-name.vdc-name.edge-gw-name")
+	}
+	orgName, vdcName, edgeName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	vcdClient := meta.(*VCDClient)
+	edgeGateway, err := vcdClient.GetEdgeGateway(orgName, vdcName, edgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errorUnableToFindEdgeGateway, err)
+	}
+
+	_ = d.Set("org", orgName)
+	_ = d.Set("vdc", vdcName)
+	_ = d.Set("edge_gateway", edgeName)
+	d.SetId(getNsxvStaticRoutingId(edgeGateway))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getNsxvStaticRoutingType converts resource schema to *nsxvStaticRouting. DefaultRoute is nil when
+// 'default_gateway' is not set
+func getNsxvStaticRoutingType(d *schema.ResourceData, edge *govcd.EdgeGateway) (*nsxvStaticRouting, error) {
+	staticRouting := &nsxvStaticRouting{
+		StaticRoutes: &nsxvStaticRoutes{},
+	}
+
+	for _, routeItem := range d.Get("static_route").([]interface{}) {
+		route := routeItem.(map[string]interface{})
+
+		vNicIndex, err := getNsxvVnicIndexByNetworkName(edge, route["network_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		staticRouting.StaticRoutes.Routes = append(staticRouting.StaticRoutes.Routes, &nsxvStaticRouteEntry{
+			Description:   route["description"].(string),
+			Vnic:          vNicIndex,
+			Network:       route["network"].(string),
+			NextHop:       route["next_hop"].(string),
+			Mtu:           route["mtu"].(int),
+			AdminDistance: route["admin_distance"].(int),
+		})
+	}
+
+	defaultGateway := d.Get("default_gateway").([]interface{})
+	if len(defaultGateway) == 1 && defaultGateway[0] != nil {
+		gateway := defaultGateway[0].(map[string]interface{})
+
+		vNicIndex, err := getNsxvVnicIndexByNetworkName(edge, gateway["network_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		staticRouting.DefaultRoute = &nsxvStaticRouteEntry{
+			Description:    gateway["description"].(string),
+			Vnic:           vNicIndex,
+			GatewayAddress: gateway["gateway_address"].(string),
+			Mtu:            gateway["mtu"].(int),
+			AdminDistance:  gateway["admin_distance"].(int),
+		}
+	}
+
+	return staticRouting, nil
+}
+
+// setNsxvStaticRoutingData sets static routing configuration into statefile
+func setNsxvStaticRoutingData(d *schema.ResourceData, edge *govcd.EdgeGateway, staticRouting *nsxvStaticRouting) error {
+	var routes []interface{}
+	if staticRouting.StaticRoutes != nil {
+		for _, route := range staticRouting.StaticRoutes.Routes {
+			networkName, err := getNsxvNetworkNameByVnicIndex(edge, route.Vnic)
+			if err != nil {
+				return err
+			}
+
+			routes = append(routes, map[string]interface{}{
+				"network":        route.Network,
+				"next_hop":       route.NextHop,
+				"network_name":   networkName,
+				"mtu":            route.Mtu,
+				"admin_distance": route.AdminDistance,
+				"description":    route.Description,
+			})
+		}
+	}
+	err := d.Set("static_route", routes)
+	if err != nil {
+		return fmt.Errorf("error setting 'static_route': %s", err)
+	}
+
+	var defaultGateway []interface{}
+	if staticRouting.DefaultRoute != nil && staticRouting.DefaultRoute.GatewayAddress != "" {
+		networkName, err := getNsxvNetworkNameByVnicIndex(edge, staticRouting.DefaultRoute.Vnic)
+		if err != nil {
+			return err
+		}
+
+		defaultGateway = append(defaultGateway, map[string]interface{}{
+			"gateway_address": staticRouting.DefaultRoute.GatewayAddress,
+			"network_name":    networkName,
+			"mtu":             staticRouting.DefaultRoute.Mtu,
+			"admin_distance":  staticRouting.DefaultRoute.AdminDistance,
+			"description":     staticRouting.DefaultRoute.Description,
+		})
+	}
+	err = d.Set("default_gateway", defaultGateway)
+	if err != nil {
+		return fmt.Errorf("error setting 'default_gateway': %s", err)
+	}
+
+	return nil
+}
+
+// getNsxvStaticRoutingId constructs a fake static routing configuration ID which is needed for Terraform. The ID is
+// in format "edgeGateway.ID:staticRouting" (eg.: "urn:vcloud:gateway:77ccbdcd-ac04-4111-bf08-8ac294a3185b:staticRouting")
+func getNsxvStaticRoutingId(edge *govcd.EdgeGateway) string {
+	return edge.EdgeGateway.ID + ":staticRouting"
+}
@@ -0,0 +1,301 @@
+package vcd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+func resourceVcdVdcGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVcdVdcGroupCreate,
+		Read:   resourceVcdVdcGroupRead,
+		Update: resourceVcdVdcGroupUpdate,
+		Delete: resourceVcdVdcGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVcdVdcGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The name of organization to use, optional if defined at provider " +
+					"level. Useful when connected as sysadmin working across different organizations",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "VDC group name",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "VDC group description",
+			},
+			"starting_vdc_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Starting VDC ID. It is used to find VDCs which can participate in the group",
+				// Starting VDC is not stored in VCD therefore it is unknown after import
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"participating_vdc_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "A set of participating VDC IDs. It must include 'starting_vdc_id'",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dfw_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Defines if distributed firewall is enabled for the VDC group. Default 'false'",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the VDC group",
+			},
+			"network_provider_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network provider type of the VDC group",
+			},
+			"network_pool_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network pool ID used by the VDC group",
+			},
+		},
+	}
+}
+
+func resourceVcdVdcGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] VDC group creation initiated")
+
+	adminOrg, err := vcdClient.GetAdminOrgFromResource(d)
+	if err != nil {
+		return fmt.Errorf(errorRetrievingOrg, err)
+	}
+
+	vdcGroup := &nsxtVdcGroup{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		OrgId:               adminOrg.AdminOrg.ID,
+		NetworkProviderType: "NSX_T",
+		Type:                "LOCAL",
+	}
+
+	vdcGroup.ParticipatingOrgVdcs, err = getVdcGroupParticipatingVdcsType(vcdClient, d)
+	if err != nil {
+		return fmt.Errorf("[vdc group create] %s", err)
+	}
+
+	createdVdcGroup, err := createVdcGroup(vcdClient, vdcGroup)
+	if err != nil {
+		return fmt.Errorf("[vdc group create] error creating VDC group '%s': %s", vdcGroup.Name, err)
+	}
+
+	d.SetId(createdVdcGroup.ID)
+
+	if d.Get("dfw_enabled").(bool) {
+		err = updateVdcGroupDfw(vcdClient, createdVdcGroup.ID, true)
+		if err != nil {
+			return fmt.Errorf("[vdc group create] error enabling distributed firewall: %s", err)
+		}
+	}
+
+	return resourceVcdVdcGroupRead(d, meta)
+}
+
+func resourceVcdVdcGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] VDC group update initiated")
+
+	if d.HasChanges("name", "description", "participating_vdc_ids") {
+		vdcGroup, err := getVdcGroupById(vcdClient, d.Id())
+		if err != nil {
+			return fmt.Errorf("[vdc group update] error retrieving VDC group: %s", err)
+		}
+
+		vdcGroup.Name = d.Get("name").(string)
+		vdcGroup.Description = d.Get("description").(string)
+		vdcGroup.ParticipatingOrgVdcs, err = getVdcGroupParticipatingVdcsType(vcdClient, d)
+		if err != nil {
+			return fmt.Errorf("[vdc group update] %s", err)
+		}
+
+		_, err = updateVdcGroup(vcdClient, vdcGroup)
+		if err != nil {
+			return fmt.Errorf("[vdc group update] error updating VDC group '%s': %s", vdcGroup.Name, err)
+		}
+	}
+
+	if d.HasChange("dfw_enabled") {
+		err := updateVdcGroupDfw(vcdClient, d.Id(), d.Get("dfw_enabled").(bool))
+		if err != nil {
+			return fmt.Errorf("[vdc group update] error toggling distributed firewall: %s", err)
+		}
+	}
+
+	return resourceVcdVdcGroupRead(d, meta)
+}
+
+func resourceVcdVdcGroupRead(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] VDC group read initiated")
+
+	vdcGroup, err := getVdcGroupById(vcdClient, d.Id())
+	// If the VDC group is not found - remove it from statefile
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] VDC group with ID %s no longer exists. Removing from tfstate", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("[vdc group read] error retrieving VDC group: %s", err)
+	}
+
+	return setVdcGroupData(d, vdcGroup)
+}
+
+func resourceVcdVdcGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	vcdClient := meta.(*VCDClient)
+	log.Printf("[TRACE] VDC group deletion initiated")
+
+	vdcGroup, err := getVdcGroupById(vcdClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("[vdc group delete] error retrieving VDC group: %s", err)
+	}
+
+	// VDC group cannot be removed while distributed firewall is active
+	if vdcGroup.DfwEnabled {
+		err = updateVdcGroupDfw(vcdClient, vdcGroup.ID, false)
+		if err != nil {
+			return fmt.Errorf("[vdc group delete] error disabling distributed firewall: %s", err)
+		}
+	}
+
+	err = deleteVdcGroup(vcdClient, vdcGroup.ID)
+	if err != nil {
+		return fmt.Errorf("[vdc group delete] error deleting VDC group '%s': %s", vdcGroup.Name, err)
+	}
+
+	return nil
+}
+
+// resourceVcdVdcGroupImport is responsible for importing the resource.
+// The following steps happen as part of import
+// 1. The user supplies `terraform import _resource_name_ _the_id_string_` command
+// 2. `_the_id_string_` contains a dot formatted path to resource as in the example below
+// 3. The functions splits the dot-formatted path and tries to lookup the object
+// 4. If the lookup succeeds it sets the ID field for `_resource_name_` resource in statefile
+// (the resource must be already defined in .tf config otherwise `terraform import` will complain)
+// 5. `terraform refresh` is being implicitly launched. The Read method looks up all other fields
+// based on the known ID of object.
+//
+// Example resource name (_resource_name_): vcd_vdc_group.my-group
+// Example import path (_the_id_string_): org.vdc-group-name
+// Note: the separator can be changed using Provider.import_separator or variable VCD_IMPORT_SEPARATOR
+func resourceVcdVdcGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if len(resourceURI) != 2 {
+		return nil, fmt.Errorf("[vdc group import] resource name must be specified as org-name.vdc-group-name")
+	}
+	orgName, vdcGroupName := resourceURI[0], resourceURI[1]
+
+	vcdClient := meta.(*VCDClient)
+	adminOrg, err := vcdClient.GetAdminOrg(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("[vdc group import] unable to find Org %s: %s", orgName, err)
+	}
+
+	vdcGroup, err := getVdcGroupByName(vcdClient, adminOrg.AdminOrg.ID, vdcGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("[vdc group import] unable to find VDC group '%s': %s", vdcGroupName, err)
+	}
+
+	_ = d.Set("org", orgName)
+	d.SetId(vdcGroup.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getVdcGroupParticipatingVdcsType converts 'participating_vdc_ids' into participating VDC structures. VCD requires
+// full VDC details (fault domain, network provider scope, site) which are taken from candidate VDCs of the starting VDC.
+func getVdcGroupParticipatingVdcsType(vcdClient *VCDClient, d *schema.ResourceData) ([]nsxtVdcGroupParticipatingVdc, error) {
+	participatingVdcIds := convertSchemaSetToSliceOfStrings(d.Get("participating_vdc_ids").(*schema.Set))
+
+	// Starting VDC is unknown for imported VDC groups. Any participating VDC can be used to find candidates instead.
+	startingVdcId := d.Get("starting_vdc_id").(string)
+	if startingVdcId == "" {
+		startingVdcId = participatingVdcIds[0]
+	}
+	if !stringInSlice(startingVdcId, participatingVdcIds) {
+		return nil, fmt.Errorf("'participating_vdc_ids' must include starting VDC '%s'", startingVdcId)
+	}
+
+	candidates, err := getVdcGroupCandidateVdcs(vcdClient, startingVdcId)
+	if err != nil {
+		return nil, err
+	}
+
+	participatingVdcs := make([]nsxtVdcGroupParticipatingVdc, 0, len(participatingVdcIds))
+	for _, vdcId := range participatingVdcIds {
+		var candidate *nsxtVdcGroupCandidateVdc
+		for _, candidateVdc := range candidates {
+			if candidateVdc.ID == vdcId {
+				candidate = candidateVdc
+				break
+			}
+		}
+		if candidate == nil {
+			return nil, fmt.Errorf("VDC '%s' cannot participate in a VDC group with starting VDC '%s'", vdcId, startingVdcId)
+		}
+
+		siteRef := candidate.SiteRef
+		participatingVdcs = append(participatingVdcs, nsxtVdcGroupParticipatingVdc{
+			VdcRef:               openApiReference{ID: candidate.ID},
+			OrgRef:               candidate.OrgRef,
+			SiteRef:              &siteRef,
+			NetworkProviderScope: candidate.NetworkProviderScope,
+			FaultDomainTag:       candidate.FaultDomainTag,
+			RemoteOrg:            false,
+		})
+	}
+
+	return participatingVdcs, nil
+}
+
+// setVdcGroupData stores VDC group structure in Terraform schema
+func setVdcGroupData(d *schema.ResourceData, vdcGroup *nsxtVdcGroup) error {
+	_ = d.Set("name", vdcGroup.Name)
+	_ = d.Set("description", vdcGroup.Description)
+	_ = d.Set("dfw_enabled", vdcGroup.DfwEnabled)
+	_ = d.Set("status", vdcGroup.Status)
+	_ = d.Set("network_provider_type", vdcGroup.NetworkProviderType)
+	_ = d.Set("network_pool_id", vdcGroup.NetworkPoolId)
+
+	participatingVdcIds := make([]string, len(vdcGroup.ParticipatingOrgVdcs))
+	for index, participatingVdc := range vdcGroup.ParticipatingOrgVdcs {
+		participatingVdcIds[index] = participatingVdc.VdcRef.ID
+	}
+	err := d.Set("participating_vdc_ids", convertToTypeSet(participatingVdcIds))
+	if err != nil {
+		return fmt.Errorf("error setting 'participating_vdc_ids': %s", err)
+	}
+
+	return nil
+}
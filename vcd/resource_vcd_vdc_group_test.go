@@ -0,0 +1,188 @@
+// +build network nsxt ALL functional
+
+package vcd
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+)
+
+// TestAccVcdVdcGroupDfw tests VDC group with distributed firewall rules and their data sources
+func TestAccVcdVdcGroupDfw(t *testing.T) {
+	skipNoNsxtVdcConfiguration(t)
+
+	var params = StringMap{
+		"Org":          testConfig.VCD.Org,
+		"NsxtVdc":      testConfig.Nsxt.Vdc,
+		"VdcGroupName": t.Name(),
+		"Description":  "first",
+		"DfwEnabled":   "true",
+		"Tags":         "network nsxt",
+	}
+
+	params["FuncName"] = t.Name()
+	configText := templateFill(testAccVcdVdcGroupStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["Description"] = "updated"
+	configText2 := templateFill(testAccVcdVdcGroupStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText2)
+
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcdVdcGroupStep2+testAccVcdVdcGroupDataSources, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText3)
+
+	if vcdShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	groupName := "vcd_vdc_group.test"
+	dfwName := "vcd_nsxt_distributed_firewall.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVdcGroupDestroy(t.Name()),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: configText,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(groupName, "id", regexp.MustCompile(`^urn:vcloud:vdcGroup:`)),
+					resource.TestCheckResourceAttr(groupName, "name", t.Name()),
+					resource.TestCheckResourceAttr(groupName, "description", "first"),
+					resource.TestCheckResourceAttr(groupName, "dfw_enabled", "true"),
+					resource.TestCheckResourceAttr(groupName, "participating_vdc_ids.#", "1"),
+					resource.TestCheckResourceAttr(groupName, "network_provider_type", "NSX_T"),
+					resource.TestCheckResourceAttrPair(dfwName, "id", groupName, "id"),
+					resource.TestCheckResourceAttr(dfwName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dfwName, "rule.0.name", "test_rule"),
+					resource.TestCheckResourceAttr(dfwName, "rule.0.action", "DROP"),
+				),
+			},
+			resource.TestStep{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(groupName, "description", "updated"),
+					resource.TestCheckResourceAttr(dfwName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(dfwName, "rule.0.name", "test_rule-2"),
+					resource.TestCheckResourceAttr(dfwName, "rule.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(dfwName, "rule.0.logging", "true"),
+					resource.TestCheckResourceAttr(dfwName, "rule.1.name", "test_rule"),
+				),
+			},
+			resource.TestStep{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resourceFieldsEqual(groupName, "data.vcd_vdc_group.test", []string{"starting_vdc_id"}),
+					resourceFieldsEqual(dfwName, "data.vcd_nsxt_distributed_firewall.test", nil),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            groupName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdOrgObject(testConfig, t.Name()),
+				ImportStateVerifyIgnore: []string{"starting_vdc_id"},
+			},
+			resource.TestStep{
+				ResourceName:      dfwName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdOrgObject(testConfig, t.Name()),
+			},
+		},
+	})
+}
+
+// testAccCheckVdcGroupDestroy checks that VDC group with given name is removed
+func testAccCheckVdcGroupDestroy(vdcGroupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*VCDClient)
+
+		adminOrg, err := conn.GetAdminOrg(testConfig.VCD.Org)
+		if err != nil {
+			return fmt.Errorf(errorRetrievingOrg, err)
+		}
+
+		_, err = getVdcGroupByName(conn, adminOrg.AdminOrg.ID, vdcGroupName)
+		if err == nil {
+			return fmt.Errorf("VDC group '%s' still exists", vdcGroupName)
+		}
+		if !govcd.ContainsNotFound(err) {
+			return fmt.Errorf("unexpected error while checking VDC group '%s': %s", vdcGroupName, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccVcdVdcGroupPrereqs = `
+data "vcd_org_vdc" "starting" {
+  org  = "{{.Org}}"
+  name = "{{.NsxtVdc}}"
+}
+
+resource "vcd_vdc_group" "test" {
+  org         = "{{.Org}}"
+  name        = "{{.VdcGroupName}}"
+  description = "{{.Description}}"
+
+  starting_vdc_id       = data.vcd_org_vdc.starting.id
+  participating_vdc_ids = [data.vcd_org_vdc.starting.id]
+  dfw_enabled           = {{.DfwEnabled}}
+}
+`
+
+const testAccVcdVdcGroupStep1 = testAccVcdVdcGroupPrereqs + `
+resource "vcd_nsxt_distributed_firewall" "test" {
+  org          = "{{.Org}}"
+  vdc_group_id = vcd_vdc_group.test.id
+
+  rule {
+    name        = "test_rule"
+    direction   = "IN"
+    ip_protocol = "IPV4"
+    action      = "DROP"
+  }
+}
+`
+
+const testAccVcdVdcGroupStep2 = testAccVcdVdcGroupPrereqs + `
+resource "vcd_nsxt_distributed_firewall" "test" {
+  org          = "{{.Org}}"
+  vdc_group_id = vcd_vdc_group.test.id
+
+  rule {
+    name        = "test_rule-2"
+    direction   = "IN_OUT"
+    ip_protocol = "IPV4_IPV6"
+    action      = "ALLOW"
+    logging     = true
+  }
+
+  rule {
+    name        = "test_rule"
+    direction   = "IN"
+    ip_protocol = "IPV4"
+    action      = "DROP"
+  }
+}
+`
+
+const testAccVcdVdcGroupDataSources = `
+data "vcd_vdc_group" "test" {
+  org  = "{{.Org}}"
+  name = vcd_vdc_group.test.name
+}
+
+data "vcd_nsxt_distributed_firewall" "test" {
+  org          = "{{.Org}}"
+  vdc_group_id = vcd_nsxt_distributed_firewall.test.vdc_group_id
+}
+`
@@ -69,6 +69,16 @@ func convertSchemaSetToSliceOfStrings(param *schema.Set) []string {
 	return result
 }
 
+// convertTypeListToSliceOfStrings accepts Terraform's TypeList structure `[]interface{}` and converts it to slice of
+// strings.
+func convertTypeListToSliceOfStrings(param []interface{}) []string {
+	result := make([]string, len(param))
+	for index, value := range param {
+		result[index] = fmt.Sprint(value)
+	}
+	return result
+}
+
 func convertToTypeSet(param []string) []interface{} {
 	slice := make([]interface{}, len(param))
 	for index, value := range param {
@@ -77,6 +87,29 @@ func convertToTypeSet(param []string) []interface{} {
 	return slice
 }
 
+// convertSetToOpenApiReferences converts a set of IDs into a slice of OpenAPI references which only have ID set
+func convertSetToOpenApiReferences(param *schema.Set) []openApiReference {
+	ids := convertSchemaSetToSliceOfStrings(param)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	references := make([]openApiReference, len(ids))
+	for index, id := range ids {
+		references[index] = openApiReference{ID: id}
+	}
+	return references
+}
+
+// extractIdsFromOpenApiReferences returns IDs of all references in the given slice
+func extractIdsFromOpenApiReferences(refs []openApiReference) []string {
+	ids := make([]string, len(refs))
+	for index, ref := range refs {
+		ids[index] = ref.ID
+	}
+	return ids
+}
+
 // takeBoolPointer accepts a boolean and returns a pointer to this value.
 func takeBoolPointer(value bool) *bool {
 	return &value
@@ -0,0 +1,116 @@
+package vcd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	"github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// getVdcGroupById retrieves VDC group by its ID
+func getVdcGroupById(vcdClient *VCDClient, id string) (*nsxtVdcGroup, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty VDC group ID")
+	}
+
+	vdcGroup := &nsxtVdcGroup{}
+	err := vcdClient.openApiGetItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroups, id, vdcGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return vdcGroup, nil
+}
+
+// getVdcGroupByName retrieves VDC group of an Org by name
+func getVdcGroupByName(vcdClient *VCDClient, orgId, name string) (*nsxtVdcGroup, error) {
+	if orgId == "" || name == "" {
+		return nil, fmt.Errorf("empty Org ID or VDC group name")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", fmt.Sprintf("name==%s;orgId==%s", name, orgId))
+
+	vdcGroups := []*nsxtVdcGroup{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroups, queryParameters, &vdcGroups)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve VDC groups: %s", err)
+	}
+
+	if len(vdcGroups) == 0 {
+		return nil, fmt.Errorf("%s: could not find VDC group by name '%s'", govcd.ErrorEntityNotFound, name)
+	}
+
+	if len(vdcGroups) > 1 {
+		return nil, fmt.Errorf("expected exactly one VDC group with name '%s'. Got %d", name, len(vdcGroups))
+	}
+
+	return vdcGroups[0], nil
+}
+
+// getVdcGroupCandidateVdcs retrieves all VDCs which can participate in a local VDC group together with the starting
+// VDC
+func getVdcGroupCandidateVdcs(vcdClient *VCDClient, startingVdcId string) ([]*nsxtVdcGroupCandidateVdc, error) {
+	if startingVdcId == "" {
+		return nil, fmt.Errorf("empty starting VDC ID")
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Add("filter", fmt.Sprintf("_context==LOCAL;_context==%s", startingVdcId))
+	queryParameters.Add("filterEncoded", "true")
+
+	candidates := []*nsxtVdcGroupCandidateVdc{{}}
+	err := vcdClient.openApiGetAllItems(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroupCandidateVdcs, queryParameters, &candidates)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve VDC group candidate VDCs: %s", err)
+	}
+
+	return candidates, nil
+}
+
+// createVdcGroup creates VDC group and returns it
+func createVdcGroup(vcdClient *VCDClient, vdcGroup *nsxtVdcGroup) (*nsxtVdcGroup, error) {
+	createdVdcGroup := &nsxtVdcGroup{}
+	err := vcdClient.openApiPostItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroups, vdcGroup, createdVdcGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return createdVdcGroup, nil
+}
+
+// updateVdcGroup updates VDC group. vdcGroup.ID must be set.
+func updateVdcGroup(vcdClient *VCDClient, vdcGroup *nsxtVdcGroup) (*nsxtVdcGroup, error) {
+	if vdcGroup.ID == "" {
+		return nil, fmt.Errorf("cannot update VDC group without ID")
+	}
+
+	updatedVdcGroup := &nsxtVdcGroup{}
+	err := vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroups, vdcGroup.ID, vdcGroup, updatedVdcGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedVdcGroup, nil
+}
+
+// deleteVdcGroup deletes VDC group
+func deleteVdcGroup(vcdClient *VCDClient, id string) error {
+	if id == "" {
+		return fmt.Errorf("cannot delete VDC group without ID")
+	}
+
+	return vcdClient.openApiDeleteItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroups, id)
+}
+
+// updateVdcGroupDfw activates or deactivates distributed firewall of VDC group
+func updateVdcGroupDfw(vcdClient *VCDClient, vdcGroupId string, enabled bool) error {
+	if vdcGroupId == "" {
+		return fmt.Errorf("empty VDC group ID")
+	}
+
+	updatedPolicies := &nsxtDfwPolicies{}
+	return vcdClient.openApiPutItem(types.OpenApiPathVersion1_0_0+openApiEndpointVdcGroupDfwPolicies, "",
+		&nsxtDfwPolicies{Enabled: enabled}, updatedPolicies, vdcGroupId)
+}